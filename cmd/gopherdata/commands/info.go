@@ -21,13 +21,7 @@ func InfoCmd() *cobra.Command {
 			}
 
 			fmt.Printf("File: %s\n", args[0])
-			fmt.Printf("Rows: %d\n", df.Nrows())
-			fmt.Printf("Columns: %d\n", df.Ncols())
-			fmt.Printf("\nColumn Names:\n")
-			for i, col := range df.Columns() {
-				series, _ := df.Column(col)
-				fmt.Printf("  %d. %s (%s)\n", i+1, col, series.Dtype())
-			}
+			fmt.Print(df.Info())
 
 			return nil
 		},
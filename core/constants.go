@@ -4,7 +4,9 @@ package core
 const Version = "v1.0.0"
 
 // DefaultWorkers specifies the default number of parallel workers.
-// 0 means use runtime.NumCPU().
+// 0 means use Parallelism(); a larger value is capped at it.
+//
+// Deprecated: use SetParallelism, which also bounds nested operations.
 var DefaultWorkers = 0
 
 // DefaultNAValues is the default list of strings treated as null/NA values.
@@ -33,4 +33,7 @@ var (
 
 	// ErrInvalidArgument indicates an invalid argument was provided
 	ErrInvalidArgument = errors.New("invalid argument")
+
+	// ErrConstraintViolation indicates values failed a schema constraint
+	ErrConstraintViolation = errors.New("constraint violation")
 )
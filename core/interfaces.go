@@ -20,3 +20,36 @@ type Aggregator interface {
 	// Name returns the name of this aggregation function
 	Name() string
 }
+
+// Estimator is a model that learns from features X and a target y. F is the
+// feature type and T the target type; across GopherData they are
+// *dataframe.DataFrame and *series.Series[any], which core cannot name
+// without an import cycle.
+//
+// Fit may be called again to refit from scratch. It must not modify X or y.
+type Estimator[F, T any] interface {
+	// Fit trains the model on features X and target y
+	Fit(X F, y T) error
+}
+
+// Predictor is an Estimator that predicts the target for new data.
+//
+// Predict returns one value per row of X, in row order, and an error if the
+// model has not been fitted. It must not modify X.
+type Predictor[F, T any] interface {
+	Estimator[F, T]
+
+	// Predict predicts the target for each row of X
+	Predict(X F) (T, error)
+}
+
+// Transformer maps data to new data learned during fitting, such as scaled
+// features or principal components. How it is fitted differs (with or
+// without a target), so only Transform is part of the contract.
+//
+// Transform returns new data with one row per row of X and an error if the
+// transformer has not been fitted. It must not modify X.
+type Transformer[F any] interface {
+	// Transform applies the learned transformation to X
+	Transform(X F) (F, error)
+}
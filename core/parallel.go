@@ -0,0 +1,19 @@
+package core
+
+import "github.com/TIVerse/GopherData/internal/parallel"
+
+// SetParallelism bounds how many goroutines GopherData's parallel
+// operations, such as GroupBy and parallel CSV writing, keep busy at once
+// across the whole process, counting the goroutines that call them. Every
+// operation draws helpers from this one budget, so parallel calls nested
+// inside others, or running side by side, never multiply goroutines beyond
+// it. n <= 0 restores the default of runtime.NumCPU(); 1 makes every
+// operation serial.
+func SetParallelism(n int) {
+	parallel.SetLimit(n)
+}
+
+// Parallelism returns the limit set by SetParallelism.
+func Parallelism() int {
+	return parallel.Limit()
+}
@@ -41,6 +41,51 @@ func (d Dtype) String() string {
 	}
 }
 
+// IsNumeric reports whether dtype is a numeric dtype (Int64 or Float64).
+func IsNumeric(dtype Dtype) bool {
+	return dtype == DtypeInt64 || dtype == DtypeFloat64
+}
+
+// NumericDtypes returns the numeric dtypes (Int64 and Float64), for use as
+// a DataFrame.SelectDtypes filter.
+func NumericDtypes() []Dtype {
+	return []Dtype{DtypeInt64, DtypeFloat64}
+}
+
+// ToFloat64 converts a value of any Go integer or floating-point type to
+// float64. It returns 0 and false for nil and every other type, including
+// bool and numeric strings.
+func ToFloat64(val any) (float64, bool) {
+	switch v := val.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
 // NumericType is a constraint for numeric types.
 type NumericType interface {
 	int | int8 | int16 | int32 | int64 |
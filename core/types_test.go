@@ -37,3 +37,56 @@ func TestOrderString(t *testing.T) {
 		}
 	}
 }
+
+func TestIsNumeric(t *testing.T) {
+	tests := []struct {
+		dtype    Dtype
+		expected bool
+	}{
+		{DtypeInt64, true},
+		{DtypeFloat64, true},
+		{DtypeString, false},
+		{DtypeBool, false},
+		{DtypeTime, false},
+		{DtypeCategory, false},
+	}
+
+	for _, tt := range tests {
+		if got := IsNumeric(tt.dtype); got != tt.expected {
+			t.Errorf("IsNumeric(%v) = %v, want %v", tt.dtype, got, tt.expected)
+		}
+	}
+}
+
+func TestToFloat64(t *testing.T) {
+	tests := []struct {
+		name     string
+		val      any
+		expected float64
+		ok       bool
+	}{
+		{"int", int(-3), -3, true},
+		{"int8", int8(-8), -8, true},
+		{"int16", int16(-16), -16, true},
+		{"int32", int32(-32), -32, true},
+		{"int64", int64(-64), -64, true},
+		{"uint", uint(3), 3, true},
+		{"uint8", uint8(8), 8, true},
+		{"uint16", uint16(16), 16, true},
+		{"uint32", uint32(32), 32, true},
+		{"uint64", uint64(64), 64, true},
+		{"float32", float32(1.5), 1.5, true},
+		{"float64", 2.25, 2.25, true},
+		{"nil", nil, 0, false},
+		{"bool", true, 0, false},
+		{"string", "42", 0, false},
+		{"slice", []int{1}, 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ToFloat64(tt.val)
+		if got != tt.expected || ok != tt.ok {
+			t.Errorf("ToFloat64(%s) = (%v, %v), want (%v, %v)", tt.name, got, ok, tt.expected, tt.ok)
+		}
+	}
+}
@@ -42,7 +42,7 @@ func (df *DataFrame) Sum(cols ...string) (map[string]float64, error) {
 			return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
 		}
 
-		if !isNumericType(s.Dtype()) {
+		if !core.IsNumeric(s.Dtype()) {
 			return nil, fmt.Errorf("column %q: cannot sum non-numeric type %s", col, s.Dtype())
 		}
 
@@ -53,6 +53,23 @@ func (df *DataFrame) Sum(cols ...string) (map[string]float64, error) {
 	return result, nil
 }
 
+// PctOfTotal returns each value of col divided by the column's sum, so the
+// shares sum to 1. Nulls stay null, and every value is null when the sum is
+// zero. The result is named "<col>_pct" and has dtype Float64; see
+// GroupBy.PctOfTotal for shares within groups.
+func (df *DataFrame) PctOfTotal(col string) (*series.Series[any], error) {
+	s, err := df.Column(col)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]int, s.Len())
+	for i := range rows {
+		rows[i] = i
+	}
+	return pctOfTotal(col, s, [][]int{rows})
+}
+
 // Mean calculates the arithmetic mean of numeric columns.
 func (df *DataFrame) Mean(cols ...string) (map[string]float64, error) {
 	df.mu.RLock()
@@ -70,7 +87,7 @@ func (df *DataFrame) Mean(cols ...string) (map[string]float64, error) {
 			return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
 		}
 
-		if !isNumericType(s.Dtype()) {
+		if !core.IsNumeric(s.Dtype()) {
 			return nil, fmt.Errorf("column %q: cannot compute mean of non-numeric type %s", col, s.Dtype())
 		}
 
@@ -98,7 +115,7 @@ func (df *DataFrame) Median(cols ...string) (map[string]float64, error) {
 			return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
 		}
 
-		if !isNumericType(s.Dtype()) {
+		if !core.IsNumeric(s.Dtype()) {
 			return nil, fmt.Errorf("column %q: cannot compute median of non-numeric type %s", col, s.Dtype())
 		}
 
@@ -126,7 +143,7 @@ func (df *DataFrame) Std(cols ...string) (map[string]float64, error) {
 			return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
 		}
 
-		if !isNumericType(s.Dtype()) {
+		if !core.IsNumeric(s.Dtype()) {
 			return nil, fmt.Errorf("column %q: cannot compute std of non-numeric type %s", col, s.Dtype())
 		}
 
@@ -154,7 +171,7 @@ func (df *DataFrame) Var(cols ...string) (map[string]float64, error) {
 			return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
 		}
 
-		if !isNumericType(s.Dtype()) {
+		if !core.IsNumeric(s.Dtype()) {
 			return nil, fmt.Errorf("column %q: cannot compute variance of non-numeric type %s", col, s.Dtype())
 		}
 
@@ -237,6 +254,75 @@ func (df *DataFrame) Count(cols ...string) (map[string]int, error) {
 	return result, nil
 }
 
+// Nunique returns the number of distinct non-null values in each column.
+// If no columns are given, every column is counted. Columns that do not
+// exist are left out of the result.
+func (df *DataFrame) Nunique(cols ...string) map[string]int {
+	return df.NuniqueWith(cols)
+}
+
+// NuniqueWith is like Nunique but takes aggregation options. With
+// SkipNA(false), a column containing nulls counts them as one more distinct
+// value. Nil values count as null.
+func (df *DataFrame) NuniqueWith(cols []string, opts ...AggOption) map[string]int {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	aggOpts := &AggOptions{skipNA: true}
+	for _, opt := range opts {
+		opt(aggOpts)
+	}
+
+	if len(cols) == 0 {
+		cols = df.columns
+	}
+
+	result := make(map[string]int, len(cols))
+	for _, col := range cols {
+		s, exists := df.series[col]
+		if !exists {
+			continue
+		}
+
+		count, hasNull := nuniqueColumn(s)
+		if hasNull && !aggOpts.skipNA {
+			count++
+		}
+		result[col] = count
+	}
+
+	return result
+}
+
+// nuniqueColumn counts the distinct non-null values of s and reports whether
+// it holds any nulls.
+func nuniqueColumn(s *series.Series[any]) (int, bool) {
+	hasNull := false
+
+	if codes := s.Codes(); codes != nil {
+		seen := make(map[int32]bool)
+		for i, code := range codes {
+			if val, _ := s.Get(i); code < 0 || val == nil {
+				hasNull = true
+				continue
+			}
+			seen[code] = true
+		}
+		return len(seen), hasNull
+	}
+
+	seen := make(map[string]bool)
+	for i := 0; i < s.Len(); i++ {
+		val, ok := s.Get(i)
+		if !ok || val == nil {
+			hasNull = true
+			continue
+		}
+		seen[encodeKey([]any{val})] = true
+	}
+	return len(seen), hasNull
+}
+
 // Describe generates descriptive statistics for numeric columns.
 // Returns a DataFrame with statistics: count, mean, std, min, 25%, 50%, 75%, max.
 func (df *DataFrame) Describe() (*DataFrame, error) {
@@ -249,7 +335,7 @@ func (df *DataFrame) Describe() (*DataFrame, error) {
 	}
 
 	// Statistics to compute
-	statNames := []string{"count", "mean", "std", "min", "25%", "50%", "75%", "max"}
+	statNames := append([]string(nil), describeStats...)
 	statsData := make(map[string][]any)
 
 	// Initialize stat rows
@@ -299,53 +385,105 @@ func (df *DataFrame) Describe() (*DataFrame, error) {
 	return result, nil
 }
 
+// describeStats names the statistics computed by Describe, in order.
+var describeStats = []string{"count", "mean", "std", "min", "25%", "50%", "75%", "max"}
+
+// Describe returns the same statistics as DataFrame.Describe for a single
+// Series: count, mean, std, min, 25%, 50%, 75% and max, keyed by those
+// names. Nulls are skipped. If s is not numeric or has no non-null values,
+// every statistic but count is NaN.
+func Describe(s *series.Series[any]) map[string]float64 {
+	count := float64(s.Len() - s.NullCount())
+	result := map[string]float64{"count": count}
+	if count == 0 || !core.IsNumeric(s.Dtype()) {
+		for _, stat := range describeStats[1:] {
+			result[stat] = math.NaN()
+		}
+		return result
+	}
+
+	result["mean"] = meanColumn(s)
+	result["std"] = stdColumn(s)
+	result["min"], _ = core.ToFloat64(minColumn(s))
+	result["25%"] = quantileColumn(s, 0.25)
+	result["50%"] = medianColumn(s)
+	result["75%"] = quantileColumn(s, 0.75)
+	result["max"], _ = core.ToFloat64(maxColumn(s))
+	return result
+}
+
+// Quantile returns the q-th quantile (0 <= q <= 1) of the non-null values of
+// a numeric Series, interpolating linearly between the closest ranks as
+// DataFrame.Describe does. It returns NaN if s is not numeric, has no
+// non-null values or q is out of range.
+func Quantile(s *series.Series[any], q float64) float64 {
+	if q < 0 || q > 1 || !core.IsNumeric(s.Dtype()) {
+		return math.NaN()
+	}
+	return quantileColumn(s, q)
+}
+
 // Helper functions
 
 func (df *DataFrame) getNumericColumns() []string {
-	numericCols := make([]string, 0)
-	for _, col := range df.columns {
-		s := df.series[col]
-		if isNumericType(s.Dtype()) {
-			numericCols = append(numericCols, col)
-		}
-	}
-	return numericCols
+	return df.columnsOfDtypes(core.NumericDtypes(), nil)
 }
 
-func isNumericType(dtype core.Dtype) bool {
-	return dtype == core.DtypeInt64 || dtype == core.DtypeFloat64
+// neumaierSum accumulates a float64 sum with Neumaier's compensated
+// summation, carrying the low-order bits lost by each addition so that
+// long sums, and sums mixing large and small values, do not drift. Once the
+// sum is infinite or NaN it is returned as is, without compensation.
+type neumaierSum struct {
+	sum, c float64
 }
 
-func sumColumn(s *series.Series[any]) float64 {
-	var sum float64
-	for i := 0; i < s.Len(); i++ {
-		if s.IsNull(i) {
-			continue
-		}
-		val := s.GetUnsafe(i)
-		sum += toFloat64(val)
+// add adds v to the sum.
+func (n *neumaierSum) add(v float64) {
+	t := n.sum + v
+	if math.IsInf(t, 0) || math.IsNaN(t) {
+		// Inf - Inf in the compensation would turn the sum into NaN
+		n.sum = t
+		return
 	}
-	return sum
+	if math.Abs(n.sum) >= math.Abs(v) {
+		n.c += (n.sum - t) + v
+	} else {
+		n.c += (v - t) + n.sum
+	}
+	n.sum = t
 }
 
-func meanColumn(s *series.Series[any]) float64 {
-	var sum float64
-	count := 0
+// value returns the compensated sum.
+func (n *neumaierSum) value() float64 {
+	if math.IsInf(n.sum, 0) || math.IsNaN(n.sum) {
+		return n.sum
+	}
+	return n.sum + n.c
+}
 
-	for i := 0; i < s.Len(); i++ {
-		if s.IsNull(i) {
-			continue
-		}
-		val := s.GetUnsafe(i)
-		sum += toFloat64(val)
-		count++
+// sumFloat64s returns the compensated sum of values.
+func sumFloat64s(values []float64) float64 {
+	var sum neumaierSum
+	for _, v := range values {
+		sum.add(v)
 	}
+	return sum.value()
+}
 
-	if count == 0 {
+func sumColumn(s *series.Series[any]) float64 {
+	return sumFloat64s(s.Float64s())
+}
+
+func meanColumn(s *series.Series[any]) float64 {
+	return meanFloat64s(s.Float64s())
+}
+
+func meanFloat64s(values []float64) float64 {
+	if len(values) == 0 {
 		return math.NaN()
 	}
 
-	return sum / float64(count)
+	return sumFloat64s(values) / float64(len(values))
 }
 
 func medianColumn(s *series.Series[any]) float64 {
@@ -358,30 +496,25 @@ func stdColumn(s *series.Series[any]) float64 {
 }
 
 func varColumn(s *series.Series[any]) float64 {
-	// Two-pass algorithm for numerical stability
-	mean := meanColumn(s)
+	// Two-pass algorithm for numerical stability, with both passes summed
+	// with compensation
+	values := s.Float64s()
+	mean := meanFloat64s(values)
 	if math.IsNaN(mean) {
 		return math.NaN()
 	}
 
-	var sumSq float64
-	count := 0
-
-	for i := 0; i < s.Len(); i++ {
-		if s.IsNull(i) {
-			continue
-		}
-		val := s.GetUnsafe(i)
-		diff := toFloat64(val) - mean
-		sumSq += diff * diff
-		count++
+	var sumSq neumaierSum
+	for _, v := range values {
+		diff := v - mean
+		sumSq.add(diff * diff)
 	}
 
-	if count < 2 {
+	if len(values) < 2 {
 		return math.NaN()
 	}
 
-	return sumSq / float64(count-1) // Bessel's correction
+	return sumSq.value() / float64(len(values)-1) // Bessel's correction
 }
 
 func minColumn(s *series.Series[any]) any {
@@ -436,13 +569,7 @@ func maxColumn(s *series.Series[any]) any {
 
 func quantileColumn(s *series.Series[any], q float64) float64 {
 	// Collect non-null values
-	values := make([]float64, 0, s.Len())
-	for i := 0; i < s.Len(); i++ {
-		if !s.IsNull(i) {
-			val := s.GetUnsafe(i)
-			values = append(values, toFloat64(val))
-		}
-	}
+	values := s.Float64s()
 
 	if len(values) == 0 {
 		return math.NaN()
@@ -464,21 +591,6 @@ func quantileColumn(s *series.Series[any], q float64) float64 {
 	return values[lower]*(1-fraction) + values[upper]*fraction
 }
 
-func toFloat64(val any) float64 {
-	switch v := val.(type) {
-	case int64:
-		return float64(v)
-	case float64:
-		return v
-	case int:
-		return float64(v)
-	case float32:
-		return float64(v)
-	default:
-		return 0
-	}
-}
-
 func compareAny(a, b any) int {
 	switch va := a.(type) {
 	case int64:
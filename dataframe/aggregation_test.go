@@ -0,0 +1,163 @@
+package dataframe
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/series"
+)
+
+func TestDescribeSeriesMatchesFrame(t *testing.T) {
+	df, _ := New(map[string]any{
+		"x": []float64{3, 1, 4, 1, 5, 9, 2, 6},
+		"n": []int64{10, 20, 30, 40, 50, 60, 70, 80},
+	})
+	x, _ := df.Column("x")
+	x.SetNull(2)
+
+	frame, err := df.Describe()
+	if err != nil {
+		t.Fatalf("Describe failed: %v", err)
+	}
+
+	// Describe has a column per statistic and a row per numeric column.
+	for row, col := range df.SelectDtypes(core.NumericDtypes(), nil).Columns() {
+		s, _ := df.Column(col)
+		got := Describe(s)
+		if len(got) != len(describeStats) {
+			t.Errorf("%s: got %d statistics, want %d", col, len(got), len(describeStats))
+		}
+
+		for _, stat := range describeStats {
+			stats, _ := frame.Column(stat)
+			val, _ := stats.Get(row)
+			if want, _ := core.ToFloat64(val); math.Abs(got[stat]-want) > 1e-12 {
+				t.Errorf("%s %s = %v, want %v", col, stat, got[stat], want)
+			}
+		}
+	}
+}
+
+func TestDescribeSeriesNonNumeric(t *testing.T) {
+	s := series.New("name", []any{"a", "b"}, core.DtypeString)
+	got := Describe(s)
+	if got["count"] != 2 {
+		t.Errorf("count = %v, want 2", got["count"])
+	}
+	if !math.IsNaN(got["mean"]) {
+		t.Errorf("mean = %v, want NaN", got["mean"])
+	}
+}
+
+func TestQuantileSeries(t *testing.T) {
+	s := series.New("x", []any{1.0, 2.0, 3.0, 4.0, nil}, core.DtypeFloat64)
+	s.SetNull(4)
+
+	tests := []struct {
+		q    float64
+		want float64
+	}{
+		{0, 1},
+		{0.25, 1.75},
+		{0.5, 2.5},
+		{1, 4},
+	}
+	for _, tt := range tests {
+		if got := Quantile(s, tt.q); math.Abs(got-tt.want) > 1e-12 {
+			t.Errorf("Quantile(%v) = %v, want %v", tt.q, got, tt.want)
+		}
+	}
+	if got := Quantile(s, 1.5); !math.IsNaN(got) {
+		t.Errorf("Quantile(1.5) = %v, want NaN", got)
+	}
+}
+
+func TestSumCompensated(t *testing.T) {
+	// A large value, many small ones lost to rounding in a naive sum, and
+	// the large value cancelled again
+	values := []float64{1e16}
+	for i := 0; i < 100000; i++ {
+		values = append(values, 1.1)
+	}
+	values = append(values, -1e16, 3.3e15, 0.7)
+
+	exact := new(big.Float).SetPrec(256)
+	for _, v := range values {
+		exact.Add(exact, new(big.Float).SetPrec(256).SetFloat64(v))
+	}
+	want, _ := exact.Float64()
+
+	groups := make([]string, len(values))
+	for i := range groups {
+		groups[i] = "g"
+	}
+	df, _ := New(map[string]any{"x": values, "g": groups})
+
+	sums, err := df.Sum("x")
+	if err != nil {
+		t.Fatalf("Sum failed: %v", err)
+	}
+	if math.Abs(sums["x"]-want) > 1 {
+		t.Errorf("Sum = %v, want %v", sums["x"], want)
+	}
+
+	grouped, _ := df.GroupBy("g")
+	result, err := grouped.Agg(map[string]string{"x": AggSum})
+	if err != nil {
+		t.Fatalf("Agg failed: %v", err)
+	}
+	x, _ := result.Column("x")
+	if got, _ := x.Get(0); math.Abs(got.(float64)-want) > 1 {
+		t.Errorf("GroupBy sum = %v, want %v", got, want)
+	}
+
+	means, _ := df.Mean("x")
+	if wantMean := want / float64(len(values)); math.Abs(means["x"]-wantMean) > 1e-9 {
+		t.Errorf("Mean = %v, want %v", means["x"], wantMean)
+	}
+}
+
+func TestSumCompensatedInf(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   float64
+	}{
+		{"+Inf", []float64{1, math.Inf(1), 2}, math.Inf(1)},
+		{"-Inf", []float64{1, math.Inf(-1), 2}, math.Inf(-1)},
+		{"both", []float64{math.Inf(1), 1, math.Inf(-1)}, math.NaN()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			df, _ := New(map[string]any{
+				"x": tt.values,
+				"g": []string{"a", "a", "a"},
+			})
+			same := func(got float64) bool {
+				return got == tt.want || (math.IsNaN(got) && math.IsNaN(tt.want))
+			}
+
+			sums, _ := df.Sum("x")
+			if !same(sums["x"]) {
+				t.Errorf("Sum = %v, want %v", sums["x"], tt.want)
+			}
+			means, _ := df.Mean("x")
+			if !same(means["x"]) {
+				t.Errorf("Mean = %v, want %v", means["x"], tt.want)
+			}
+
+			grouped, _ := df.GroupBy("g")
+			result, err := grouped.Agg(map[string]string{"x": AggSum})
+			if err != nil {
+				t.Fatalf("Agg failed: %v", err)
+			}
+			x, _ := result.Column("x")
+			if got, _ := x.Get(0); !same(got.(float64)) {
+				t.Errorf("GroupBy sum = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package dataframe
 
 import (
+	"fmt"
+	"reflect"
 
 	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/series"
@@ -102,6 +104,156 @@ func (df *DataFrame) ApplyColumn(col string, fn func(any) any) *DataFrame {
 	}
 }
 
+// MapColumns applies fn to every value of the given columns in one pass and
+// returns a single new DataFrame. If cols is empty, all numeric columns are
+// transformed. Null values stay null and are not passed to fn; a nil result
+// from fn is stored as null. Each transformed column's dtype is inferred from
+// its first non-nil result, and the other columns are shared with df.
+func (df *DataFrame) MapColumns(cols []string, fn func(any) any) (*DataFrame, error) {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	if len(cols) == 0 {
+		for _, col := range df.columns {
+			if core.IsNumeric(df.series[col].Dtype()) {
+				cols = append(cols, col)
+			}
+		}
+	}
+
+	for _, col := range cols {
+		if _, exists := df.series[col]; !exists {
+			return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
+		}
+	}
+
+	newSeries := make(map[string]*series.Series[any], len(df.series))
+	for col, s := range df.series {
+		newSeries[col] = s
+	}
+
+	for _, col := range cols {
+		s := df.series[col]
+		if newSeries[col] != s {
+			continue // Listed twice
+		}
+
+		newData := make([]any, s.Len())
+		nulls := make([]bool, s.Len())
+		dtype := s.Dtype()
+		inferred := false
+		for i := 0; i < s.Len(); i++ {
+			val, ok := s.Get(i)
+			if !ok {
+				nulls[i] = true
+				continue
+			}
+			result := fn(val)
+			if result == nil {
+				nulls[i] = true
+				continue
+			}
+			if !inferred {
+				dtype = inferDtypeFromValue(result)
+				inferred = true
+			}
+			newData[i] = result
+		}
+
+		newS := series.New(col, newData, dtype)
+		for i, isNull := range nulls {
+			if isNull {
+				newS.SetNull(i)
+			}
+		}
+		newSeries[col] = newS
+	}
+
+	return &DataFrame{
+		columns: df.columns,
+		series:  newSeries,
+		index:   df.index,
+		nrows:   df.nrows,
+	}, nil
+}
+
+// WhereCond keeps the cells of cols for which cond returns true and replaces
+// the rest with replacement, or with null if replacement is nil. It is the
+// element-wise pandas where. cond is called once per cell with the cell's
+// row, so it can test the row as a whole or, through Row.Value, the cell
+// itself. If cols is empty every column is considered; columns that do not
+// exist are ignored. A column whose dtype cannot hold replacement is
+// widened as in Melt: Int64 to Float64 for a float, anything else to
+// String. The other columns are shared with df.
+func (df *DataFrame) WhereCond(cond func(*Row) bool, replacement any, cols ...string) *DataFrame {
+	return df.replaceWhere(cond, true, replacement, cols)
+}
+
+// MaskCond is the inverse of WhereCond: it replaces the cells of cols for
+// which cond returns true and keeps the rest, like the pandas mask.
+func (df *DataFrame) MaskCond(cond func(*Row) bool, replacement any, cols ...string) *DataFrame {
+	return df.replaceWhere(cond, false, replacement, cols)
+}
+
+// replaceWhere replaces the cells of cols for which cond does not return
+// keep.
+func (df *DataFrame) replaceWhere(cond func(*Row) bool, keep bool, replacement any, cols []string) *DataFrame {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	if len(cols) == 0 {
+		cols = df.columns
+	}
+
+	replDtype := inferDtypeFromValue(replacement)
+	if replDtype == core.DtypeInt64 {
+		replacement = reflect.ValueOf(replacement).Convert(reflect.TypeOf(int64(0))).Interface()
+	}
+
+	newSeries := make(map[string]*series.Series[any], len(df.series))
+	for col, s := range df.series {
+		newSeries[col] = s
+	}
+
+	row := &Row{df: df}
+	for _, col := range cols {
+		s, exists := df.series[col]
+		if !exists || newSeries[col] != s {
+			continue // Missing, or listed twice
+		}
+
+		row.col = col
+		values := make([]any, df.nrows)
+		for i := range values {
+			row.idx = i
+			if cond(row) == keep {
+				if val, ok := s.Get(i); ok {
+					values[i] = val
+				}
+			} else {
+				values[i] = replacement
+			}
+		}
+
+		dtype := s.Dtype()
+		if replacement != nil {
+			dtype = widenDtype(dtype, replDtype)
+		}
+		newS := widenedSeries(col, values, dtype)
+		if s.IsCategorical() && dtype == core.DtypeCategory {
+			newS = newS.AsCategorical()
+		}
+		newSeries[col] = newS
+	}
+
+	return &DataFrame{
+		columns: df.columns,
+		series:  newSeries,
+		index:   df.index,
+		nrows:   df.nrows,
+	}
+}
+
 // ApplyElement applies a function to selected columns element-wise.
 // The function receives a map of column values for the current row.
 func (df *DataFrame) ApplyElement(cols []string, fn func(map[string]any) map[string]any) *DataFrame {
@@ -243,6 +395,42 @@ func (df *DataFrame) WithColumn(name string, s *series.Series[any]) *DataFrame {
 	}
 }
 
+// Insert returns a new DataFrame with s added as column name at position pos,
+// shifting later columns right. pos must be in [0, Ncols()]; Ncols() appends.
+// Returns an error if name already exists or s has the wrong length.
+func (df *DataFrame) Insert(pos int, name string, s *series.Series[any]) (*DataFrame, error) {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	if pos < 0 || pos > len(df.columns) {
+		return nil, fmt.Errorf("insert position %d for %d columns: %w", pos, len(df.columns), core.ErrIndexOutOfBounds)
+	}
+	if _, exists := df.series[name]; exists {
+		return nil, fmt.Errorf("column %q: %w", name, core.ErrDuplicateColumn)
+	}
+	if s.Len() != df.nrows {
+		return nil, fmt.Errorf("column %q has length %d, expected %d: %w", name, s.Len(), df.nrows, core.ErrInvalidShape)
+	}
+
+	newSeries := make(map[string]*series.Series[any], len(df.series)+1)
+	for col, ser := range df.series {
+		newSeries[col] = ser
+	}
+	newSeries[name] = s
+
+	newColumns := make([]string, 0, len(df.columns)+1)
+	newColumns = append(newColumns, df.columns[:pos]...)
+	newColumns = append(newColumns, name)
+	newColumns = append(newColumns, df.columns[pos:]...)
+
+	return &DataFrame{
+		columns: newColumns,
+		series:  newSeries,
+		index:   df.index,
+		nrows:   df.nrows,
+	}, nil
+}
+
 // Rename renames columns in the DataFrame.
 func (df *DataFrame) Rename(mapping map[string]string) *DataFrame {
 	df.mu.RLock()
@@ -269,6 +457,19 @@ func (df *DataFrame) Rename(mapping map[string]string) *DataFrame {
 	}
 }
 
+// Pipe passes the DataFrame to fn and returns its result.
+// It lets user-defined steps sit in a chain of built-in operations:
+//
+//	result, err := df.Select("a", "b").Pipe(clean)
+//
+// The DataFrame is not locked while fn runs, so fn may call any method on it.
+func (df *DataFrame) Pipe(fn func(*DataFrame) (*DataFrame, error)) (*DataFrame, error) {
+	if fn == nil {
+		return nil, fmt.Errorf("pipe function is nil: %w", core.ErrInvalidArgument)
+	}
+	return fn(df)
+}
+
 // Helper function to infer dtype from a value.
 func inferDtypeFromValue(val any) core.Dtype {
 	switch val.(type) {
@@ -0,0 +1,279 @@
+package dataframe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/series"
+)
+
+func TestPipe(t *testing.T) {
+	df, _ := New(map[string]any{
+		"a": []float64{1, 2, 3},
+		"b": []string{"x", "y", "z"},
+	})
+
+	double := func(d *DataFrame) (*DataFrame, error) {
+		return d.ApplyColumn("a", func(v any) any { return v.(float64) * 2 }), nil
+	}
+	tag := func(d *DataFrame) (*DataFrame, error) {
+		return d.Apply(func(r *Row) any {
+			b, _ := r.Get("b")
+			return b.(string) + "!"
+		}, "tagged"), nil
+	}
+
+	result, err := df.Select("a", "b").Pipe(double)
+	if err == nil {
+		result, err = result.Pipe(tag)
+	}
+	if err != nil {
+		t.Fatalf("Pipe failed: %v", err)
+	}
+
+	a, _ := result.Column("a")
+	if val, _ := a.Get(2); val != 6.0 {
+		t.Errorf("Expected a[2] = 6, got %v", val)
+	}
+	tagged, err := result.Column("tagged")
+	if err != nil {
+		t.Fatalf("Expected column added by second step: %v", err)
+	}
+	if val, _ := tagged.Get(0); val != "x!" {
+		t.Errorf("Expected tagged[0] = x!, got %v", val)
+	}
+
+	// A failing step returns its error and later steps never run
+	errBoom := errors.New("boom")
+	called := false
+	fail := func(d *DataFrame) (*DataFrame, error) { return nil, errBoom }
+	after := func(d *DataFrame) (*DataFrame, error) {
+		called = true
+		return d, nil
+	}
+	chain := func(d *DataFrame) (*DataFrame, error) {
+		r, err := d.Pipe(fail)
+		if err != nil {
+			return nil, err
+		}
+		return r.Pipe(after)
+	}
+
+	if _, err := df.Pipe(chain); !errors.Is(err, errBoom) {
+		t.Errorf("Expected error from failing step, got %v", err)
+	}
+	if called {
+		t.Error("Steps after a failure should not run")
+	}
+
+	if _, err := df.Pipe(nil); err == nil {
+		t.Error("Expected error for nil function")
+	}
+}
+
+func TestInsert(t *testing.T) {
+	df, _ := New(map[string]any{
+		"a": []int64{1, 2},
+		"b": []int64{3, 4},
+	})
+	df = df.Select("a", "b")
+	s := series.New("x", []any{int64(9), int64(8)}, core.DtypeInt64)
+
+	tests := []struct {
+		pos      int
+		expected []string
+	}{
+		{0, []string{"x", "a", "b"}},
+		{1, []string{"a", "x", "b"}},
+		{2, []string{"a", "b", "x"}},
+	}
+	for _, tt := range tests {
+		result, err := df.Insert(tt.pos, "x", s)
+		if err != nil {
+			t.Fatalf("Insert at %d failed: %v", tt.pos, err)
+		}
+		cols := result.Columns()
+		for i := range tt.expected {
+			if cols[i] != tt.expected[i] {
+				t.Errorf("Insert at %d: expected columns %v, got %v", tt.pos, tt.expected, cols)
+				break
+			}
+		}
+	}
+
+	if df.Ncols() != 2 {
+		t.Errorf("Original DataFrame should be unchanged, got %d columns", df.Ncols())
+	}
+
+	if _, err := df.Insert(3, "x", s); !errors.Is(err, core.ErrIndexOutOfBounds) {
+		t.Errorf("Expected ErrIndexOutOfBounds, got %v", err)
+	}
+	if _, err := df.Insert(-1, "x", s); !errors.Is(err, core.ErrIndexOutOfBounds) {
+		t.Errorf("Expected ErrIndexOutOfBounds, got %v", err)
+	}
+	if _, err := df.Insert(0, "a", s); !errors.Is(err, core.ErrDuplicateColumn) {
+		t.Errorf("Expected ErrDuplicateColumn, got %v", err)
+	}
+	short := series.New("x", []any{int64(1)}, core.DtypeInt64)
+	if _, err := df.Insert(0, "x", short); !errors.Is(err, core.ErrInvalidShape) {
+		t.Errorf("Expected ErrInvalidShape, got %v", err)
+	}
+}
+
+func TestMapColumns(t *testing.T) {
+	df, _ := New(map[string]any{
+		"a":     []float64{1, 2, 3},
+		"b":     []int64{10, 20, 30},
+		"c":     []float64{4, 5, 6},
+		"d":     []float64{7, 8, 9},
+		"label": []string{"x", "y", "z"},
+	})
+	df = df.Select("a", "b", "c", "d", "label")
+	a, _ := df.Column("a")
+	a.SetNull(1)
+
+	calls := 0
+	double := func(v any) any {
+		calls++
+		f, _ := core.ToFloat64(v)
+		return f * 2
+	}
+
+	result, err := df.MapColumns([]string{"a", "b", "c"}, double)
+	if err != nil {
+		t.Fatalf("MapColumns() error = %v", err)
+	}
+
+	if calls != 8 {
+		t.Errorf("fn called %d times, want 8 (nulls skipped)", calls)
+	}
+
+	expected := map[string][]any{
+		"a": {2.0, nil, 6.0},
+		"b": {20.0, 40.0, 60.0},
+		"c": {8.0, 10.0, 12.0},
+	}
+	for col, want := range expected {
+		s, _ := result.Column(col)
+		if s.Dtype() != core.DtypeFloat64 {
+			t.Errorf("column %q dtype = %v, want float64", col, s.Dtype())
+		}
+		for i, w := range want {
+			got, ok := s.Get(i)
+			if w == nil {
+				if ok {
+					t.Errorf("%s[%d] = %v, want null", col, i, got)
+				}
+				continue
+			}
+			if got != w {
+				t.Errorf("%s[%d] = %v, want %v", col, i, got, w)
+			}
+		}
+	}
+
+	// Untouched columns are shared, not copied
+	for _, col := range []string{"d", "label"} {
+		orig, _ := df.Column(col)
+		got, _ := result.Column(col)
+		if orig != got {
+			t.Errorf("column %q was copied, want it shared", col)
+		}
+	}
+
+	// The source is unchanged
+	if v, _ := df.Column("c"); v.GetUnsafe(0) != 4.0 {
+		t.Error("MapColumns modified the original DataFrame")
+	}
+
+	if _, err := df.MapColumns([]string{"a", "missing"}, double); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("missing column error = %v, want ErrColumnNotFound", err)
+	}
+}
+
+func TestMapColumnsDefaultNumeric(t *testing.T) {
+	df, _ := New(map[string]any{
+		"x":     []float64{1, 2},
+		"n":     []int64{3, 4},
+		"label": []string{"p", "q"},
+	})
+
+	result, err := df.MapColumns(nil, func(v any) any {
+		f, _ := core.ToFloat64(v)
+		return f + 1
+	})
+	if err != nil {
+		t.Fatalf("MapColumns() error = %v", err)
+	}
+
+	if x, _ := result.Column("x"); x.GetUnsafe(0) != 2.0 {
+		t.Errorf("x[0] = %v, want 2", x.GetUnsafe(0))
+	}
+	if n, _ := result.Column("n"); n.GetUnsafe(1) != 5.0 {
+		t.Errorf("n[1] = %v, want 5", n.GetUnsafe(1))
+	}
+	if label, _ := result.Column("label"); label.GetUnsafe(0) != "p" {
+		t.Errorf("label[0] = %v, want p", label.GetUnsafe(0))
+	}
+}
+
+func TestWhereCond(t *testing.T) {
+	df, _ := New(map[string]any{
+		"a":    []float64{1.5, -2, 3, -0.5},
+		"b":    []int64{-1, 5, -7, 2},
+		"keep": []float64{-9, -9, -9, -9},
+	})
+	df = df.Select("a", "b", "keep")
+
+	nonNegative := func(r *Row) bool {
+		v, ok := r.Value()
+		f, _ := core.ToFloat64(v)
+		return ok && f >= 0
+	}
+	result := df.WhereCond(nonNegative, nil, "a", "b")
+
+	want := map[string][]any{
+		"a":    {1.5, nil, 3.0, nil},
+		"b":    {nil, int64(5), nil, int64(2)},
+		"keep": {-9.0, -9.0, -9.0, -9.0},
+	}
+	for col, values := range want {
+		s, _ := result.Column(col)
+		for i, w := range values {
+			got, ok := s.Get(i)
+			if w == nil {
+				if ok {
+					t.Errorf("%s[%d] = %v, want null", col, i, got)
+				}
+			} else if got != w {
+				t.Errorf("%s[%d] = %v, want %v", col, i, got, w)
+			}
+		}
+	}
+	if b, _ := result.Column("b"); b.Dtype() != core.DtypeInt64 {
+		t.Errorf("expected b to stay Int64, got %v", b.Dtype())
+	}
+
+	// MaskCond replaces where the condition holds; a row-level condition
+	// replaces across the row, and a float replacement widens Int64.
+	masked := df.MaskCond(func(r *Row) bool {
+		a, _ := r.Get("a")
+		return a.(float64) < 0
+	}, 0.5, "a", "b")
+	a, _ := masked.Column("a")
+	b, _ := masked.Column("b")
+	if b.Dtype() != core.DtypeFloat64 {
+		t.Errorf("expected b to widen to Float64, got %v", b.Dtype())
+	}
+	for i, w := range []float64{1.5, 0.5, 3, 0.5} {
+		if got, _ := a.Get(i); got != w {
+			t.Errorf("masked a[%d] = %v, want %v", i, got, w)
+		}
+	}
+	for i, w := range []float64{-1, 0.5, -7, 0.5} {
+		if got, _ := b.Get(i); got != w {
+			t.Errorf("masked b[%d] = %v, want %v", i, got, w)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package dataframe
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/series"
+)
+
+// CombineFirst patches df with other: it returns df with each null filled by
+// other's value at the same row label and column, plus the columns and rows
+// that only other has, appended after df's own. Rows are matched by index
+// label; where other repeats a label, its first row is used. A cell null in
+// both frames stays null.
+//
+// A column in both frames with different dtypes is widened as
+// FillNAWithSeries widens it: numbers to float64, anything else to string.
+//
+// The indexes must be of the same type; otherwise the result is an error
+// wrapping core.ErrTypeMismatch. Rows added from a RangeIndex must extend it
+// to a new range, or the result is an error wrapping
+// core.ErrInvalidArgument.
+func (df *DataFrame) CombineFirst(other *DataFrame) (*DataFrame, error) {
+	if other == nil {
+		return nil, fmt.Errorf("other is nil: %w", core.ErrInvalidArgument)
+	}
+	if df == other {
+		return df.Copy(), nil
+	}
+
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+	other.mu.RLock()
+	defer other.mu.RUnlock()
+
+	dfIndex, otherIndex := df.rowIndex(), other.rowIndex()
+	if fmt.Sprintf("%T", dfIndex) != fmt.Sprintf("%T", otherIndex) {
+		return nil, fmt.Errorf("cannot combine a %T with a %T: %w", dfIndex, otherIndex, core.ErrTypeMismatch)
+	}
+
+	// Match rows by label: fromOther[r] is other's row for result row r, or -1
+	dfRows := make(map[string]bool, df.nrows)
+	for i := 0; i < df.nrows; i++ {
+		dfRows[labelKey(dfIndex.Get(i))] = true
+	}
+	otherRows := make(map[string]int, other.nrows)
+	var added []int
+	for i := 0; i < other.nrows; i++ {
+		key := labelKey(otherIndex.Get(i))
+		if _, seen := otherRows[key]; seen {
+			continue
+		}
+		otherRows[key] = i
+		if !dfRows[key] {
+			added = append(added, i)
+		}
+	}
+
+	nrows := df.nrows + len(added)
+	fromOther := make([]int, nrows)
+	for r := 0; r < df.nrows; r++ {
+		if pos, ok := otherRows[labelKey(dfIndex.Get(r))]; ok {
+			fromOther[r] = pos
+		} else {
+			fromOther[r] = -1
+		}
+	}
+	copy(fromOther[df.nrows:], added)
+
+	index, err := combinedIndex(dfIndex, otherIndex, added)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := append([]string(nil), df.columns...)
+	for _, col := range other.columns {
+		if _, exists := df.series[col]; !exists {
+			columns = append(columns, col)
+		}
+	}
+
+	seriesMap := make(map[string]*series.Series[any], len(columns))
+	for _, col := range columns {
+		a, inDf := df.series[col]
+		b, inOther := other.series[col]
+
+		values := make([]any, nrows)
+		for r := range values {
+			if inDf && r < df.nrows {
+				if val, ok := a.Get(r); ok {
+					values[r] = val
+					continue
+				}
+			}
+			if inOther && fromOther[r] >= 0 {
+				if val, ok := b.Get(fromOther[r]); ok {
+					values[r] = val
+				}
+			}
+		}
+
+		var dtype core.Dtype
+		categorical := true
+		switch {
+		case inDf && inOther:
+			dtype = widenDtype(a.Dtype(), b.Dtype())
+			categorical = a.IsCategorical() && b.IsCategorical()
+		case inDf:
+			dtype, categorical = a.Dtype(), a.IsCategorical()
+		default:
+			dtype, categorical = b.Dtype(), b.IsCategorical()
+		}
+
+		s := widenedSeries(col, values, dtype)
+		if categorical && dtype == core.DtypeCategory {
+			s = s.AsCategorical()
+		}
+		seriesMap[col] = s
+	}
+
+	return &DataFrame{
+		columns: columns,
+		series:  seriesMap,
+		index:   index,
+		nrows:   nrows,
+	}, nil
+}
+
+// rowIndex returns the index of df, or a RangeIndex if it has none.
+func (df *DataFrame) rowIndex() core.Index {
+	if df.index == nil {
+		return NewRangeIndex(0, df.nrows, 1)
+	}
+	return df.index
+}
+
+// labelKey returns a key that is equal for equal index labels.
+func labelKey(label any) string {
+	if t, ok := label.(time.Time); ok {
+		return t.UTC().Format(time.RFC3339Nano)
+	}
+	return fmt.Sprintf("%v", label)
+}
+
+// combinedIndex returns base followed by the labels of other at positions
+// added. base and other are indexes of the same type.
+func combinedIndex(base, other core.Index, added []int) (core.Index, error) {
+	if len(added) == 0 {
+		return base, nil
+	}
+
+	switch ix := base.(type) {
+	case *StringIndex:
+		labels := append([]string(nil), ix.labels...)
+		for _, pos := range added {
+			labels = append(labels, other.(*StringIndex).labels[pos])
+		}
+		return NewStringIndex(labels), nil
+	case *DatetimeIndex:
+		times := append([]time.Time(nil), ix.times...)
+		for _, pos := range added {
+			times = append(times, other.(*DatetimeIndex).times[pos])
+		}
+		return NewDatetimeIndex(times, ix.tz), nil
+	case *RangeIndex:
+		labels := make([]int, 0, ix.Len()+len(added))
+		for i := 0; i < ix.Len(); i++ {
+			labels = append(labels, ix.Get(i).(int))
+		}
+		for _, pos := range added {
+			labels = append(labels, other.Get(pos).(int))
+		}
+		step := 1
+		if len(labels) > 1 {
+			step = labels[1] - labels[0]
+		}
+		for i := 1; i < len(labels); i++ {
+			if step == 0 || labels[i]-labels[i-1] != step {
+				return nil, fmt.Errorf("combined row labels do not form a range: %w", core.ErrInvalidArgument)
+			}
+		}
+		return NewRangeIndex(labels[0], labels[len(labels)-1]+step, step), nil
+	default:
+		return nil, fmt.Errorf("cannot add rows to a %T: %w", base, core.ErrTypeMismatch)
+	}
+}
@@ -0,0 +1,95 @@
+package dataframe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func TestCombineFirstFillsNulls(t *testing.T) {
+	primary, _ := New(map[string]any{
+		"price": []any{int64(10), nil, int64(30)},
+		"name":  []any{"a", "b", nil},
+	})
+	primary = primary.Select("price", "name")
+	price, _ := primary.Column("price")
+	price.SetNull(1)
+	name, _ := primary.Column("name")
+	name.SetNull(2)
+
+	fallback, _ := New(map[string]any{
+		"price": []float64{99, 20.5, 99, 40},
+		"name":  []string{"z", "z", "c", "d"},
+	})
+	fallback = fallback.Select("price", "name")
+
+	result, err := primary.CombineFirst(fallback)
+	if err != nil {
+		t.Fatalf("CombineFirst failed: %v", err)
+	}
+	if result.Nrows() != 4 {
+		t.Fatalf("Expected 4 rows, got %d", result.Nrows())
+	}
+
+	prices, _ := result.Column("price")
+	if prices.Dtype() != core.DtypeFloat64 {
+		t.Errorf("Expected price widened to float64, got %v", prices.Dtype())
+	}
+	names, _ := result.Column("name")
+	for i, want := range []float64{10, 20.5, 30, 40} {
+		if got, ok := prices.Get(i); !ok || got != want {
+			t.Errorf("price[%d]: expected %v, got %v", i, want, got)
+		}
+	}
+	for i, want := range []string{"a", "b", "c", "d"} {
+		if got, ok := names.Get(i); !ok || got != want {
+			t.Errorf("name[%d]: expected %v, got %v", i, want, got)
+		}
+	}
+	if got := result.Index().Get(3); got != 3 {
+		t.Errorf("Expected the added row to have label 3, got %v", got)
+	}
+	if !price.IsNull(1) {
+		t.Error("CombineFirst should not modify df")
+	}
+}
+
+func TestCombineFirstAddsColumns(t *testing.T) {
+	primary, _ := New(map[string]any{"qty": []int64{1, 2}})
+	_ = primary.SetIndex(NewStringIndex([]string{"x", "y"}))
+
+	extra, _ := New(map[string]any{
+		"qty":   []int64{7, 8},
+		"color": []string{"blue", "red"},
+	})
+	_ = extra.SetIndex(NewStringIndex([]string{"y", "x"}))
+
+	result, err := primary.CombineFirst(extra)
+	if err != nil {
+		t.Fatalf("CombineFirst failed: %v", err)
+	}
+	cols := result.Columns()
+	if len(cols) != 2 || cols[0] != "qty" || cols[1] != "color" {
+		t.Fatalf("Expected columns [qty color], got %v", cols)
+	}
+
+	qty, _ := result.Column("qty")
+	color, _ := result.Column("color")
+	for i, want := range []int64{1, 2} {
+		if got, _ := qty.Get(i); got != want {
+			t.Errorf("qty[%d]: expected %d, got %v", i, want, got)
+		}
+	}
+	// Rows are matched by label, not position
+	for i, want := range []string{"red", "blue"} {
+		if got, _ := color.Get(i); got != want {
+			t.Errorf("color[%d]: expected %s, got %v", i, want, got)
+		}
+	}
+
+	ranged, _ := New(map[string]any{"qty": []int64{1}})
+	if _, err := primary.CombineFirst(ranged); !errors.Is(err, core.ErrTypeMismatch) {
+		t.Errorf("Expected ErrTypeMismatch for mismatched indexes, got %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+package dataframe
+
+import (
+	"fmt"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/series"
+)
+
+// CompareOption is a functional option for Compare. Compare takes the same
+// options as Equals: WithTolerance, IgnoreColumnOrder and NaNEqual.
+type CompareOption = EqualsOption
+
+// Compare lists the cells that differ between df and other, which must
+// have the same number of rows and the same columns with the same dtypes,
+// in the same order unless IgnoreColumnOrder is set. Cells are compared
+// as Equals compares them, so WithTolerance allows float differences, and
+// a null differs from any value but not from another null.
+//
+// The result has a row per differing cell, in row then column order of df,
+// with columns "row" (the row position), "column", "self" (the value in
+// df) and "other" (the value in other). Nulls stay null in "self" and
+// "other". Their dtype is the common dtype of the differing columns, as in
+// Melt: mixed Int64/Float64 widen to Float64 and other mixes are
+// formatted as strings.
+func (df *DataFrame) Compare(other *DataFrame, opts ...CompareOption) (*DataFrame, error) {
+	if other == nil {
+		return nil, fmt.Errorf("other is nil: %w", core.ErrInvalidArgument)
+	}
+
+	eqOpts := &EqualsOptions{
+		nanEqual: true,
+	}
+	for _, opt := range opts {
+		opt(eqOpts)
+	}
+
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+	if other != df {
+		other.mu.RLock()
+		defer other.mu.RUnlock()
+	}
+
+	if df.nrows != other.nrows || len(df.columns) != len(other.columns) {
+		return nil, fmt.Errorf("shapes (%d, %d) and (%d, %d) differ: %w",
+			df.nrows, len(df.columns), other.nrows, len(other.columns), core.ErrInvalidShape)
+	}
+	for i, col := range df.columns {
+		b, exists := other.series[col]
+		if !exists {
+			return nil, fmt.Errorf("column %q missing from other: %w", col, core.ErrColumnNotFound)
+		}
+		if !eqOpts.ignoreColumnOrder && other.columns[i] != col {
+			return nil, fmt.Errorf("column %d is %q here and %q in other: %w",
+				i, col, other.columns[i], core.ErrInvalidShape)
+		}
+		if a := df.series[col]; a.Dtype() != b.Dtype() {
+			return nil, fmt.Errorf("column %q has dtype %v here and %v in other: %w",
+				col, a.Dtype(), b.Dtype(), core.ErrTypeMismatch)
+		}
+	}
+
+	seriesOpts := eqOpts.seriesOptions()
+	var rows []int64
+	var cols []string
+	var selfVals, otherVals []any
+	var dtype core.Dtype
+	for row := 0; row < df.nrows; row++ {
+		for _, col := range df.columns {
+			va, okA := df.series[col].Get(row)
+			vb, okB := other.series[col].Get(row)
+			if !okA {
+				va = nil
+			}
+			if !okB {
+				vb = nil
+			}
+			if (va == nil) == (vb == nil) && (va == nil || series.ValuesEqual(va, vb, seriesOpts...)) {
+				continue
+			}
+
+			if len(rows) == 0 {
+				dtype = df.series[col].Dtype()
+			} else {
+				dtype = widenDtype(dtype, df.series[col].Dtype())
+			}
+			rows = append(rows, int64(row))
+			cols = append(cols, col)
+			selfVals = append(selfVals, va)
+			otherVals = append(otherVals, vb)
+		}
+	}
+	if len(rows) == 0 {
+		dtype = core.DtypeString
+	}
+
+	return &DataFrame{
+		columns: []string{"row", "column", "self", "other"},
+		series: map[string]*series.Series[any]{
+			"row":    convertToAnySeries("row", rows, core.DtypeInt64),
+			"column": convertToAnySeries("column", cols, core.DtypeString),
+			"self":   widenedSeries("self", selfVals, dtype),
+			"other":  widenedSeries("other", otherVals, dtype),
+		},
+		index: NewRangeIndex(0, len(rows), 1),
+		nrows: len(rows),
+	}, nil
+}
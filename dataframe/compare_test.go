@@ -0,0 +1,122 @@
+package dataframe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func TestCompare(t *testing.T) {
+	before, _ := New(map[string]any{
+		"id":    []int64{1, 2, 3, 4},
+		"score": []float64{0.5, 1.25, 2.0, 3.0},
+		"name":  []string{"a", "b", "c", "d"},
+	})
+	before = before.Select("id", "score", "name")
+
+	after := before.Copy()
+	score, _ := after.Column("score")
+	score.Set(1, 1.25+1e-12) // within tolerance
+	score.Set(2, 2.5)
+	name, _ := after.Column("name")
+	name.Set(3, "z")
+	score.SetNull(3)
+
+	diff, err := before.Compare(after, WithTolerance(1e-9))
+	if err != nil {
+		t.Fatalf("Compare failed: %v", err)
+	}
+
+	want := []struct {
+		row         int64
+		column      string
+		self, other any
+	}{
+		{2, "score", "2", "2.5"},
+		{3, "score", "3", nil},
+		{3, "name", "d", "z"},
+	}
+	if diff.Nrows() != len(want) {
+		t.Fatalf("expected %d differences, got %d:\n%v", len(want), diff.Nrows(), diff)
+	}
+	rows, _ := diff.Column("row")
+	cols, _ := diff.Column("column")
+	selfs, _ := diff.Column("self")
+	others, _ := diff.Column("other")
+	for i, w := range want {
+		row, _ := rows.Get(i)
+		col, _ := cols.Get(i)
+		self, _ := selfs.Get(i)
+		other, okOther := others.Get(i)
+		if row != w.row || col != w.column || self != w.self {
+			t.Errorf("difference %d = (%v, %v, %v), want (%v, %v, %v)", i, row, col, self, w.row, w.column, w.self)
+		}
+		if w.other == nil {
+			if okOther {
+				t.Errorf("difference %d: other should be null, got %v", i, other)
+			}
+		} else if other != w.other {
+			t.Errorf("difference %d: other = %v, want %v", i, other, w.other)
+		}
+	}
+
+	// Without a tolerance the tiny float change shows up too.
+	diff, _ = before.Compare(after)
+	if diff.Nrows() != len(want)+1 {
+		t.Errorf("expected %d differences without tolerance, got %d", len(want)+1, diff.Nrows())
+	}
+
+	same, err := before.Compare(before.Copy())
+	if err != nil || same.Nrows() != 0 {
+		t.Errorf("expected no differences, got %v, %v", same, err)
+	}
+}
+
+func TestCompareFloat32Tolerance(t *testing.T) {
+	before, _ := FromRecords([]map[string]any{{"v": float32(1.5)}, {"v": float32(2)}})
+	after := before.Copy()
+	v, _ := after.Column("v")
+	v.Set(0, float32(1.5001))
+
+	diff, err := before.Compare(after, WithTolerance(1e-3))
+	if err != nil {
+		t.Fatalf("Compare failed: %v", err)
+	}
+	if diff.Nrows() != 0 {
+		t.Errorf("expected float32 values within tolerance to match, got:\n%v", diff)
+	}
+	if !before.Equals(after, WithTolerance(1e-3)) {
+		t.Error("Equals and Compare should agree on float32 values")
+	}
+}
+
+func TestCompareErrors(t *testing.T) {
+	df, _ := New(map[string]any{
+		"a": []int64{1, 2},
+		"b": []float64{1, 2},
+	})
+	df = df.Select("a", "b")
+
+	if _, err := df.Compare(df.SliceRows(0, 1)); !errors.Is(err, core.ErrInvalidShape) {
+		t.Errorf("expected ErrInvalidShape for different rows, got %v", err)
+	}
+	if _, err := df.Compare(df.Rename(map[string]string{"b": "c"})); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("expected ErrColumnNotFound, got %v", err)
+	}
+	reordered := df.Select("b", "a")
+	if _, err := df.Compare(reordered); !errors.Is(err, core.ErrInvalidShape) {
+		t.Errorf("expected ErrInvalidShape for reordered columns, got %v", err)
+	}
+	if _, err := df.Compare(reordered, IgnoreColumnOrder(true)); err != nil {
+		t.Errorf("IgnoreColumnOrder should allow reordered columns, got %v", err)
+	}
+
+	floats, _ := New(map[string]any{
+		"a": []float64{1, 2},
+		"b": []float64{1, 2},
+	})
+	if _, err := df.Compare(floats.Select("a", "b")); !errors.Is(err, core.ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch, got %v", err)
+	}
+}
@@ -87,6 +87,35 @@ func New(data map[string]any) (*DataFrame, error) {
 	return df, nil
 }
 
+// NewWithNulls creates a DataFrame like New, then marks the positions
+// listed in nulls null in each named column. This lets typed slices such as
+// []float64 or []int64 carry nulls, which New can only express through
+// []any columns. A column in nulls that is not in data is an error wrapping
+// core.ErrColumnNotFound, and a position outside the rows is an error
+// wrapping core.ErrIndexOutOfBounds.
+func NewWithNulls(data map[string]any, nulls map[string][]int) (*DataFrame, error) {
+	df, err := New(data)
+	if err != nil {
+		return nil, err
+	}
+
+	for col, positions := range nulls {
+		s, ok := df.series[col]
+		if !ok {
+			return nil, fmt.Errorf("null positions for column %q: %w", col, core.ErrColumnNotFound)
+		}
+		for _, i := range positions {
+			if i < 0 || i >= df.nrows {
+				return nil, fmt.Errorf("column %q: null position %d outside %d rows: %w",
+					col, i, df.nrows, core.ErrIndexOutOfBounds)
+			}
+			s.SetNull(i)
+		}
+	}
+
+	return df, nil
+}
+
 // FromRecords creates a DataFrame from a slice of maps (records).
 // Each map represents a row with column names as keys.
 func FromRecords(records []map[string]any) (*DataFrame, error) {
@@ -0,0 +1,161 @@
+package dataframe
+
+import (
+	"fmt"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/series"
+)
+
+// CrosstabOptions configures Crosstab.
+type CrosstabOptions struct {
+	normalize string
+	margins   bool
+}
+
+// CrosstabOption is a functional option for Crosstab.
+type CrosstabOption func(*CrosstabOptions)
+
+// Normalize turns counts into proportions: "all" divides every cell by the
+// grand total, "index" each row by its row total and "columns" each column
+// by its column total. Margins are normalized the same way.
+func Normalize(how string) CrosstabOption {
+	return func(opts *CrosstabOptions) {
+		opts.normalize = how
+	}
+}
+
+// Margins adds an "All" row and an "All" column holding the row, column and
+// grand totals.
+func Margins(margins bool) CrosstabOption {
+	return func(opts *CrosstabOptions) {
+		opts.margins = margins
+	}
+}
+
+// Crosstab counts how often each pair of values of a and b occurs together.
+// The result has one row per distinct value of a, in sorted order, labelled
+// in a first column named after a, and one column per distinct value of b,
+// also sorted. Rows where either value is null are not counted.
+//
+// Cells are int64 counts, or float64 proportions with Normalize. With
+// Margins the row labels are strings, so the total row can be labelled
+// "All".
+func Crosstab(a, b *series.Series[any], opts ...CrosstabOption) (*DataFrame, error) {
+	options := CrosstabOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if a == nil || b == nil {
+		return nil, fmt.Errorf("series is nil: %w", core.ErrInvalidArgument)
+	}
+	if a.Len() != b.Len() {
+		return nil, fmt.Errorf("series have lengths %d and %d: %w", a.Len(), b.Len(), core.ErrInvalidShape)
+	}
+	switch options.normalize {
+	case "", "all", "index", "columns":
+	default:
+		return nil, fmt.Errorf("normalize %q must be 'all', 'index' or 'columns': %w",
+			options.normalize, core.ErrInvalidArgument)
+	}
+
+	rowVals := sortedValues(nonNilValues(uniqueSeriesValues(a)))
+	colNames := pivotColumnNames(nonNilValues(uniqueSeriesValues(b)))
+
+	rowLookup := make(map[string]int, len(rowVals))
+	for i, val := range rowVals {
+		rowLookup[fmt.Sprintf("%v", val)] = i
+	}
+	colLookup := make(map[string]int, len(colNames))
+	for j, name := range colNames {
+		colLookup[name] = j
+	}
+
+	// counts has an extra row and column for the margins
+	counts := make([][]float64, len(rowVals)+1)
+	for i := range counts {
+		counts[i] = make([]float64, len(colNames)+1)
+	}
+	for i := 0; i < a.Len(); i++ {
+		aVal, aOK := a.Get(i)
+		bVal, bOK := b.Get(i)
+		if !aOK || !bOK || aVal == nil || bVal == nil {
+			continue
+		}
+		r := rowLookup[fmt.Sprintf("%v", aVal)]
+		c := colLookup[fmt.Sprintf("%v", bVal)]
+		counts[r][c]++
+	}
+
+	// The margins sit just past the last row and column, so adding them to
+	// the output is a matter of reading one more row and column.
+	nr, nc := len(rowVals), len(colNames)
+	for r := 0; r < nr; r++ {
+		for c := 0; c < nc; c++ {
+			counts[r][nc] += counts[r][c]
+			counts[nr][c] += counts[r][c]
+			counts[nr][nc] += counts[r][c]
+		}
+	}
+	totalRow, totalCol := nr, nc
+
+	if options.margins {
+		labels := make([]any, 0, nr+1)
+		for _, val := range rowVals {
+			labels = append(labels, fmt.Sprintf("%v", val))
+		}
+		rowVals = append(labels, "All")
+		colNames = append(colNames, "All")
+		nr++
+		nc++
+	}
+
+	pivotData := make(map[string][]any, nc)
+	for c := 0; c < nc; c++ {
+		columnData := make([]any, nr)
+		for r := 0; r < nr; r++ {
+			count := counts[r][c]
+			switch options.normalize {
+			case "":
+				columnData[r] = int64(count)
+			case "all":
+				columnData[r] = proportion(count, counts[totalRow][totalCol])
+			case "index":
+				columnData[r] = proportion(count, counts[r][totalCol])
+			case "columns":
+				columnData[r] = proportion(count, counts[totalRow][c])
+			}
+		}
+		pivotData[colNames[c]] = columnData
+	}
+
+	return buildPivotFrame(crosstabIndexName(a), rowVals, colNames, pivotData)
+}
+
+// proportion returns count/total, or 0 when total is 0.
+func proportion(count, total float64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return count / total
+}
+
+// crosstabIndexName names the row label column of a Crosstab after a.
+func crosstabIndexName(a *series.Series[any]) string {
+	if a.Name() == "" {
+		return "row"
+	}
+	return a.Name()
+}
+
+// nonNilValues returns vals without nil entries.
+func nonNilValues(vals []any) []any {
+	out := vals[:0:0]
+	for _, val := range vals {
+		if val != nil {
+			out = append(out, val)
+		}
+	}
+	return out
+}
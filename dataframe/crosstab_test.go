@@ -0,0 +1,81 @@
+package dataframe
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/series"
+)
+
+func TestCrosstabMargins(t *testing.T) {
+	sex := series.New("sex", []any{"m", "f", "m", "f", "m", "m", nil}, core.DtypeString)
+	smoker := series.New("smoker", []any{"yes", "no", "no", "no", "yes", "no", "yes"}, core.DtypeString)
+	sex.SetNull(6)
+
+	table, err := Crosstab(sex, smoker, Margins(true))
+	if err != nil {
+		t.Fatalf("Crosstab failed: %v", err)
+	}
+
+	cols := table.Columns()
+	wantCols := []string{"sex", "no", "yes", "All"}
+	if len(cols) != len(wantCols) {
+		t.Fatalf("columns = %v, want %v", cols, wantCols)
+	}
+	for i, c := range wantCols {
+		if cols[i] != c {
+			t.Fatalf("columns = %v, want %v", cols, wantCols)
+		}
+	}
+
+	want := map[string][]any{
+		"sex": {"f", "m", "All"},
+		"no":  {int64(2), int64(2), int64(4)},
+		"yes": {int64(0), int64(2), int64(2)},
+		"All": {int64(2), int64(4), int64(6)},
+	}
+	for col, values := range want {
+		s, _ := table.Column(col)
+		for i, w := range values {
+			if got, _ := s.Get(i); got != w {
+				t.Errorf("%s[%d] = %v, want %v", col, i, got, w)
+			}
+		}
+	}
+}
+
+func TestCrosstabNormalize(t *testing.T) {
+	a := series.New("a", []any{int64(1), int64(1), int64(2), int64(2)}, core.DtypeInt64)
+	b := series.New("b", []any{"x", "y", "x", "x"}, core.DtypeString)
+
+	cases := map[string]map[string][]float64{
+		"all":     {"x": {0.25, 0.5}, "y": {0.25, 0}},
+		"index":   {"x": {0.5, 1}, "y": {0.5, 0}},
+		"columns": {"x": {1.0 / 3, 2.0 / 3}, "y": {1, 0}},
+	}
+	for how, want := range cases {
+		table, err := Crosstab(a, b, Normalize(how))
+		if err != nil {
+			t.Fatalf("%s: Crosstab failed: %v", how, err)
+		}
+		for col, values := range want {
+			s, _ := table.Column(col)
+			for i, w := range values {
+				got, _ := s.Get(i)
+				if math.Abs(got.(float64)-w) > 1e-12 {
+					t.Errorf("%s: %s[%d] = %v, want %v", how, col, i, got, w)
+				}
+			}
+		}
+	}
+
+	if _, err := Crosstab(a, b, Normalize("rows")); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument, got %v", err)
+	}
+	short := series.New("b", []any{"x"}, core.DtypeString)
+	if _, err := Crosstab(a, short); !errors.Is(err, core.ErrInvalidShape) {
+		t.Errorf("expected ErrInvalidShape, got %v", err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/series"
@@ -94,6 +95,125 @@ func (df *DataFrame) SetIndex(idx core.Index) error {
 	return nil
 }
 
+// SetIndexCol returns a new DataFrame using column col as its index.
+// String columns become a StringIndex and time.Time columns a DatetimeIndex; other
+// dtypes become a StringIndex of their formatted values. The column is removed
+// from the result. Index labels cannot be null.
+func (df *DataFrame) SetIndexCol(col string) (*DataFrame, error) {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	s, exists := df.series[col]
+	if !exists {
+		return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
+	}
+	if s.NullCount() > 0 {
+		return nil, fmt.Errorf("column %q contains nulls and cannot be an index: %w", col, core.ErrNullValue)
+	}
+
+	isTime := s.Dtype() == core.DtypeTime
+	if df.nrows > 0 {
+		first, _ := s.Get(0)
+		_, isTime = first.(time.Time)
+	}
+
+	var idx core.Index
+	if isTime {
+		times := make([]time.Time, df.nrows)
+		for i := 0; i < df.nrows; i++ {
+			val, _ := s.Get(i)
+			t, ok := val.(time.Time)
+			if !ok {
+				return nil, fmt.Errorf("column %q row %d: expected time.Time, got %T: %w", col, i, val, core.ErrTypeMismatch)
+			}
+			times[i] = t
+		}
+		var tz *time.Location
+		if len(times) > 0 {
+			tz = times[0].Location()
+		}
+		idx = NewDatetimeIndex(times, tz)
+	} else {
+		labels := make([]string, df.nrows)
+		for i := 0; i < df.nrows; i++ {
+			val, _ := s.Get(i)
+			labels[i] = fmt.Sprintf("%v", val)
+		}
+		idx = NewStringIndex(labels)
+	}
+
+	newColumns := make([]string, 0, len(df.columns)-1)
+	newSeries := make(map[string]*series.Series[any], len(df.columns)-1)
+	for _, c := range df.columns {
+		if c != col {
+			newColumns = append(newColumns, c)
+			newSeries[c] = df.series[c]
+		}
+	}
+
+	return &DataFrame{
+		columns: newColumns,
+		series:  newSeries,
+		index:   idx,
+		nrows:   df.nrows,
+	}, nil
+}
+
+// ResetIndex returns a new DataFrame with the current index inserted as the
+// first column, named "index" (or the first free name of "level_0",
+// "level_1", ... if "index" is already taken), and a fresh RangeIndex.
+func (df *DataFrame) ResetIndex() *DataFrame {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	name := "index"
+	for level := 0; df.series[name] != nil; level++ {
+		name = fmt.Sprintf("level_%d", level)
+	}
+
+	data := make([]any, df.nrows)
+	for i := 0; i < df.nrows; i++ {
+		if df.index != nil {
+			data[i] = df.index.Get(i)
+		} else {
+			data[i] = int64(i)
+		}
+	}
+
+	var dtype core.Dtype
+	switch df.index.(type) {
+	case *DatetimeIndex:
+		dtype = core.DtypeTime
+	case *StringIndex:
+		dtype = core.DtypeString
+	default:
+		// RangeIndex labels are ints; store them as int64
+		for i, val := range data {
+			if v, ok := val.(int); ok {
+				data[i] = int64(v)
+			}
+		}
+		dtype = core.DtypeInt64
+	}
+
+	newColumns := make([]string, 0, len(df.columns)+1)
+	newColumns = append(newColumns, name)
+	newColumns = append(newColumns, df.columns...)
+
+	newSeries := make(map[string]*series.Series[any], len(df.columns)+1)
+	for col, s := range df.series {
+		newSeries[col] = s
+	}
+	newSeries[name] = series.New(name, data, dtype)
+
+	return &DataFrame{
+		columns: newColumns,
+		series:  newSeries,
+		index:   NewRangeIndex(0, df.nrows, 1),
+		nrows:   df.nrows,
+	}
+}
+
 // String returns a string representation of the DataFrame.
 func (df *DataFrame) String() string {
 	df.mu.RLock()
@@ -222,6 +342,86 @@ func (df *DataFrame) Copy() *DataFrame {
 	return newDf
 }
 
+// EqualsOptions configures Equals.
+type EqualsOptions struct {
+	tolerance         float64
+	ignoreColumnOrder bool
+	nanEqual          bool
+}
+
+// EqualsOption is a functional option for Equals.
+type EqualsOption func(*EqualsOptions)
+
+// WithTolerance sets the absolute tolerance for comparing Float64 values (default: 0).
+func WithTolerance(tol float64) EqualsOption {
+	return func(opts *EqualsOptions) {
+		opts.tolerance = tol
+	}
+}
+
+// IgnoreColumnOrder sets whether columns may appear in a different order (default: false).
+func IgnoreColumnOrder(ignore bool) EqualsOption {
+	return func(opts *EqualsOptions) {
+		opts.ignoreColumnOrder = ignore
+	}
+}
+
+// NaNEqual sets whether NaN compares equal to NaN (default: true).
+func NaNEqual(equal bool) EqualsOption {
+	return func(opts *EqualsOptions) {
+		opts.nanEqual = equal
+	}
+}
+
+// Equals reports whether two DataFrames have the same columns, dtypes,
+// number of rows, null positions, and values. The index is not compared.
+func (df *DataFrame) Equals(other *DataFrame, opts ...EqualsOption) bool {
+	if df == other {
+		return true
+	}
+	if df == nil || other == nil {
+		return false
+	}
+
+	eqOpts := &EqualsOptions{
+		nanEqual: true,
+	}
+	for _, opt := range opts {
+		opt(eqOpts)
+	}
+
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+	other.mu.RLock()
+	defer other.mu.RUnlock()
+
+	if df.nrows != other.nrows || len(df.columns) != len(other.columns) {
+		return false
+	}
+
+	seriesOpts := eqOpts.seriesOptions()
+	for i, col := range df.columns {
+		if !eqOpts.ignoreColumnOrder && other.columns[i] != col {
+			return false
+		}
+
+		b, exists := other.series[col]
+		if !exists || !df.series[col].Equals(b, seriesOpts...) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// seriesOptions returns the series.EqualsOption values matching opts.
+func (opts *EqualsOptions) seriesOptions() []series.EqualsOption {
+	return []series.EqualsOption{
+		series.WithTolerance(opts.tolerance),
+		series.NaNEqual(opts.nanEqual),
+	}
+}
+
 // Empty returns true if the DataFrame has no rows.
 func (df *DataFrame) Empty() bool {
 	df.mu.RLock()
@@ -0,0 +1,320 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func TestSetIndexColRoundTrip(t *testing.T) {
+	base, _ := New(map[string]any{
+		"city": []string{"paris", "rome", "oslo"},
+		"temp": []float64{18.5, 24.0, 9.2},
+	})
+	df := base.Select("city", "temp")
+
+	indexed, err := df.SetIndexCol("city")
+	if err != nil {
+		t.Fatalf("SetIndexCol failed: %v", err)
+	}
+
+	if indexed.HasColumn("city") {
+		t.Error("Index column should be removed from columns")
+	}
+	idx, ok := indexed.Index().(*StringIndex)
+	if !ok {
+		t.Fatalf("Expected StringIndex, got %T", indexed.Index())
+	}
+	if pos, err := idx.Loc("rome"); err != nil || pos[0] != 1 {
+		t.Errorf("Expected rome at position 1, got %v (%v)", pos, err)
+	}
+
+	reset := indexed.ResetIndex()
+	cols := reset.Columns()
+	if len(cols) != 2 || cols[0] != "index" || cols[1] != "temp" {
+		t.Fatalf("Expected columns [index temp], got %v", cols)
+	}
+	if _, ok := reset.Index().(*RangeIndex); !ok {
+		t.Errorf("Expected RangeIndex after reset, got %T", reset.Index())
+	}
+
+	restored, _ := reset.Column("index")
+	if restored.Dtype() != core.DtypeString {
+		t.Errorf("Expected string dtype, got %v", restored.Dtype())
+	}
+	for i, want := range []string{"paris", "rome", "oslo"} {
+		if val, _ := restored.Get(i); val != want {
+			t.Errorf("Row %d: expected %s, got %v", i, want, val)
+		}
+	}
+}
+
+func TestResetIndexNameCollision(t *testing.T) {
+	df, _ := New(map[string]any{
+		"index":   []int64{7, 8},
+		"level_0": []string{"a", "b"},
+	})
+	df = df.Select("index", "level_0")
+
+	reset := df.ResetIndex()
+	if cols := reset.Columns(); fmt.Sprint(cols) != "[level_1 index level_0]" {
+		t.Fatalf("Expected columns [level_1 index level_0], got %v", cols)
+	}
+	level0, _ := reset.Column("level_0")
+	if got, _ := level0.Get(0); got != "a" {
+		t.Errorf("level_0 was overwritten: got %v", got)
+	}
+}
+
+func TestSetIndexColDatetime(t *testing.T) {
+	times := []any{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	df, _ := New(map[string]any{
+		"ts": times,
+		"v":  []float64{1, 2},
+	})
+
+	indexed, err := df.SetIndexCol("ts")
+	if err != nil {
+		t.Fatalf("SetIndexCol failed: %v", err)
+	}
+	if _, ok := indexed.Index().(*DatetimeIndex); !ok {
+		t.Fatalf("Expected DatetimeIndex, got %T", indexed.Index())
+	}
+
+	restored, _ := indexed.ResetIndex().Column("index")
+	if restored.Dtype() != core.DtypeTime {
+		t.Errorf("Expected time dtype, got %v", restored.Dtype())
+	}
+
+	if _, err := df.SetIndexCol("missing"); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("Expected ErrColumnNotFound, got %v", err)
+	}
+}
+
+func TestEquals(t *testing.T) {
+	build := func(a []float64, b []string) *DataFrame {
+		df, _ := New(map[string]any{"a": a, "b": b})
+		return df.Select("a", "b")
+	}
+
+	t.Run("EqualFrames", func(t *testing.T) {
+		df1 := build([]float64{1, 2, math.NaN()}, []string{"x", "y", "z"})
+		df2 := build([]float64{1, 2, math.NaN()}, []string{"x", "y", "z"})
+		if !df1.Equals(df2) {
+			t.Error("Expected identical frames to be equal")
+		}
+		if df1.Equals(df2, NaNEqual(false)) {
+			t.Error("Expected NaN cells to differ when NaNEqual is false")
+		}
+	})
+
+	t.Run("NullPositions", func(t *testing.T) {
+		df1 := build([]float64{1, 2, 3}, []string{"x", "y", "z"})
+		df2 := build([]float64{1, 2, 3}, []string{"x", "y", "z"})
+		df3 := build([]float64{1, 2, 3}, []string{"x", "y", "z"})
+
+		s2, _ := df2.Column("a")
+		s2.SetNull(1)
+		s3, _ := df3.Column("a")
+		s3.SetNull(2)
+
+		if df1.Equals(df2) {
+			t.Error("Expected frames with different null masks to differ")
+		}
+		if df2.Equals(df3) {
+			t.Error("Expected frames with nulls in different positions to differ")
+		}
+	})
+
+	t.Run("FloatTolerance", func(t *testing.T) {
+		df1 := build([]float64{1.0, 2.0}, []string{"x", "y"})
+		df2 := build([]float64{1.0 + 1e-9, 2.0 - 1e-9}, []string{"x", "y"})
+		if df1.Equals(df2) {
+			t.Error("Expected exact comparison to fail")
+		}
+		if !df1.Equals(df2, WithTolerance(1e-6)) {
+			t.Error("Expected frames to match within tolerance")
+		}
+	})
+
+	t.Run("ColumnOrderAndDtype", func(t *testing.T) {
+		df1 := build([]float64{1}, []string{"x"})
+		reordered := df1.Select("b", "a")
+		if df1.Equals(reordered) {
+			t.Error("Expected different column order to differ by default")
+		}
+		if !df1.Equals(reordered, IgnoreColumnOrder(true)) {
+			t.Error("Expected frames to match when ignoring column order")
+		}
+
+		ints, _ := New(map[string]any{"a": []int64{1}, "b": []string{"x"}})
+		if df1.Equals(ints.Select("a", "b")) {
+			t.Error("Expected different dtypes to differ")
+		}
+	})
+}
+
+func TestInfo(t *testing.T) {
+	df, _ := New(map[string]any{
+		"id":    []int64{1, 2, 3, 4},
+		"name":  []any{"a", nil, "ccc", nil},
+		"score": []any{1.5, 2.5, nil, 4.5},
+	})
+	df = df.Select("id", "name", "score")
+	name, _ := df.Column("name")
+	name.SetNull(1)
+
+	info := df.Info()
+	if info.Rows != 4 || info.Cols != 3 {
+		t.Errorf("Expected shape (4, 3), got (%d, %d)", info.Rows, info.Cols)
+	}
+
+	expected := []struct {
+		name    string
+		dtype   core.Dtype
+		nonNull int
+		null    int
+	}{
+		{"id", core.DtypeInt64, 4, 0},
+		{"name", core.DtypeString, 2, 2},
+		{"score", core.DtypeFloat64, 3, 1},
+	}
+	for i, want := range expected {
+		got := info.Columns[i]
+		if got.Name != want.name || got.Dtype != want.dtype || got.NonNull != want.nonNull || got.Null != want.null {
+			t.Errorf("Column %d: expected %+v, got %+v", i, want, got)
+		}
+		if got.Memory <= 0 {
+			t.Errorf("Column %s: expected positive memory estimate", got.Name)
+		}
+	}
+
+	var total int64
+	for _, col := range info.Columns {
+		total += col.Memory
+	}
+	if info.Memory != total {
+		t.Errorf("Expected total memory %d, got %d", total, info.Memory)
+	}
+
+	if s := info.String(); !strings.Contains(s, "Rows: 4") || !strings.Contains(s, "score") {
+		t.Errorf("Unexpected summary:\n%s", s)
+	}
+}
+
+func TestNunique(t *testing.T) {
+	df, _ := New(map[string]any{
+		"id":    []int64{1, 2, 3, 4, 5},
+		"color": []string{"red", "blue", "red", "green", "blue"},
+		"score": []float64{1.5, 0, 1.5, 0, 2.5},
+	})
+	score, _ := df.Column("score")
+	score.SetNull(1)
+	score.SetNull(3)
+
+	counts := df.Nunique()
+	expected := map[string]int{"id": 5, "color": 3, "score": 2}
+	for col, want := range expected {
+		if counts[col] != want {
+			t.Errorf("Nunique[%s]: expected %d, got %d", col, want, counts[col])
+		}
+	}
+
+	withNulls := df.NuniqueWith([]string{"score", "color"}, SkipNA(false))
+	if withNulls["score"] != 3 || withNulls["color"] != 3 {
+		t.Errorf("Expected nulls to count once in score only, got %v", withNulls)
+	}
+	if _, ok := withNulls["id"]; ok {
+		t.Error("Expected only the requested columns")
+	}
+
+	// Categorical columns count their codes
+	color, _ := df.Column("color")
+	catDf := df.WithColumn("color", color.AsCategorical())
+	if got := catDf.Nunique("color")["color"]; got != 3 {
+		t.Errorf("Categorical Nunique: expected 3, got %d", got)
+	}
+
+	if got := df.Nunique("missing"); len(got) != 0 {
+		t.Errorf("Expected missing columns to be skipped, got %v", got)
+	}
+}
+
+func TestNewWithNulls(t *testing.T) {
+	df, err := NewWithNulls(map[string]any{
+		"price": []float64{1.5, 2.5, 3.5, 4.5},
+		"qty":   []int64{1, 2, 3, 4},
+	}, map[string][]int{
+		"price": {0, 2},
+	})
+	if err != nil {
+		t.Fatalf("NewWithNulls failed: %v", err)
+	}
+
+	price, _ := df.Column("price")
+	if price.Dtype() != core.DtypeFloat64 {
+		t.Errorf("expected Float64 dtype, got %v", price.Dtype())
+	}
+	for i, want := range []bool{true, false, true, false} {
+		if price.IsNull(i) != want {
+			t.Errorf("price.IsNull(%d) = %v, want %v", i, price.IsNull(i), want)
+		}
+	}
+	if got, _ := price.Get(3); got != 4.5 {
+		t.Errorf("price[3] = %v, want 4.5", got)
+	}
+	qty, _ := df.Column("qty")
+	if qty.NullCount() != 0 {
+		t.Errorf("expected no nulls in qty, got %d", qty.NullCount())
+	}
+
+	if _, err := NewWithNulls(map[string]any{"a": []int64{1}}, map[string][]int{"b": {0}}); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("expected ErrColumnNotFound, got %v", err)
+	}
+	if _, err := NewWithNulls(map[string]any{"a": []int64{1}}, map[string][]int{"a": {1}}); !errors.Is(err, core.ErrIndexOutOfBounds) {
+		t.Errorf("expected ErrIndexOutOfBounds, got %v", err)
+	}
+}
+
+func TestOptimizeMemory(t *testing.T) {
+	n := 1000
+	regions := make([]string, n)
+	ids := make([]string, n)
+	for i := range regions {
+		regions[i] = []string{"north-east", "south-west", "central"}[i%3]
+		ids[i] = fmt.Sprintf("id-%04d", i)
+	}
+	df, _ := New(map[string]any{"region": regions, "id": ids})
+	df = df.Select("region", "id")
+
+	optimized := df.OptimizeMemory(0.5)
+
+	region, _ := optimized.Column("region")
+	if !region.IsCategorical() {
+		t.Error("Expected the low-cardinality region column to become categorical")
+	}
+	if id, _ := optimized.Column("id"); id.IsCategorical() {
+		t.Error("Expected the all-distinct id column to stay a string column")
+	}
+	for i := 0; i < n; i += 97 {
+		if got, _ := region.Get(i); got != regions[i] {
+			t.Errorf("region[%d]: expected %s, got %v", i, regions[i], got)
+		}
+	}
+
+	before, after := df.Info().Memory, optimized.Info().Memory
+	if after >= before {
+		t.Errorf("Expected memory to drop, got %d bytes before and %d after", before, after)
+	}
+	if original, _ := df.Column("region"); original.IsCategorical() {
+		t.Error("OptimizeMemory should not modify df")
+	}
+}
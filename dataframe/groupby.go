@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"math"
 	"sort"
-	"strings"
 
 	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/internal/bitset"
+	"github.com/TIVerse/GopherData/internal/parallel"
+	"github.com/TIVerse/GopherData/series"
 )
 
 // Aggregation function names
@@ -24,16 +26,30 @@ const (
 	AggLast   = "last"   // Last value in group
 )
 
+// parallelGroupByThreshold is the minimum number of rows before group
+// construction and aggregation are split across workers.
+const parallelGroupByThreshold = 50000
+
 // GroupBy represents a grouped DataFrame for aggregation operations.
+// Groups are ordered by the first appearance of their key in the DataFrame.
 type GroupBy struct {
-	df      *DataFrame
-	keys    []string          // Group-by column names
-	groups  map[string][]int  // Group key hash → row indices
-	groupKeys [][]any         // Original group key values for each group
+	df          *DataFrame
+	keys        []string         // Group-by column names
+	groups      map[string][]int // Group key hash → row indices
+	groupKeys   [][]any          // Original group key values for each group
+	groupHashes []string         // Group key hash for each group (same order as groupKeys)
+	workers     int              // Number of workers used for aggregation
 }
 
 // GroupBy creates a GroupBy object for aggregation operations.
+// Large DataFrames are partitioned across core.Parallelism() workers;
+// the resulting group order is identical to the serial path.
 func (df *DataFrame) GroupBy(cols ...string) (*GroupBy, error) {
+	return df.groupBy(cols, groupByWorkers(df.Nrows()))
+}
+
+// groupBy is the implementation of GroupBy with an explicit worker count.
+func (df *DataFrame) groupBy(cols []string, workers int) (*GroupBy, error) {
 	if len(cols) == 0 {
 		return nil, fmt.Errorf("at least one column required for groupby: %w", core.ErrInvalidArgument)
 	}
@@ -42,21 +58,72 @@ func (df *DataFrame) GroupBy(cols ...string) (*GroupBy, error) {
 	defer df.mu.RUnlock()
 
 	// Validate columns exist
-	for _, col := range cols {
-		if !df.HasColumn(col) {
+	keySeries := make([]*series.Series[any], len(cols))
+	for i, col := range cols {
+		s, exists := df.series[col]
+		if !exists {
 			return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
 		}
+		keySeries[i] = s
 	}
 
-	// Build groups using hash map
-	groups := make(map[string][]int)
-	groupKeys := make(map[string][]any)
+	if workers < 1 {
+		workers = 1
+	}
+
+	// Categorical key columns are grouped by code
+	codes := keyCodes(keySeries)
+
+	var partials []*partialGroups
+	if workers == 1 || df.nrows < workers {
+		partials = []*partialGroups{buildPartialGroups(keySeries, codes, 0, df.nrows)}
+	} else {
+		// Partition rows into contiguous chunks so that merging the chunks in
+		// order preserves first-appearance ordering and ascending row indices.
+		chunkSize := (df.nrows + workers - 1) / workers
+		partials = make([]*partialGroups, 0, workers)
+		for start := 0; start < df.nrows; start += chunkSize {
+			partials = append(partials, nil)
+		}
+
+		parallel.Run(len(partials), workers, func(w int) {
+			start := w * chunkSize
+			end := min(start+chunkSize, df.nrows)
+			partials[w] = buildPartialGroups(keySeries, codes, start, end)
+		})
+	}
+
+	groups, groupHashes, groupKeys := mergePartialGroups(partials)
 
-	for i := 0; i < df.nrows; i++ {
+	return &GroupBy{
+		df:          df,
+		keys:        cols,
+		groups:      groups,
+		groupKeys:   groupKeys,
+		groupHashes: groupHashes,
+		workers:     workers,
+	}, nil
+}
+
+// partialGroups holds the groups found in a contiguous range of rows.
+type partialGroups struct {
+	groups map[string][]int
+	order  []string // Hashes in order of first appearance
+	keys   map[string][]any
+}
+
+// buildPartialGroups groups the rows in [start, end) by their key values.
+// codes holds the category codes of categorical key columns (see keyCodes).
+func buildPartialGroups(keySeries []*series.Series[any], codes [][]int32, start, end int) *partialGroups {
+	pg := &partialGroups{
+		groups: make(map[string][]int),
+		keys:   make(map[string][]any),
+	}
+
+	for i := start; i < end; i++ {
 		// Extract key values for this row
-		keyValues := make([]any, len(cols))
-		for j, col := range cols {
-			s := df.series[col]
+		keyValues := make([]any, len(keySeries))
+		for j, s := range keySeries {
 			val, ok := s.Get(i)
 			if !ok {
 				keyValues[j] = nil
@@ -65,35 +132,81 @@ func (df *DataFrame) GroupBy(cols ...string) (*GroupBy, error) {
 			}
 		}
 
-		// Hash the key
-		keyHash := hashGroupKey(keyValues)
+		keyHash := encodeRowKey(keyValues, codes, i)
 
-		// Add row index to group
-		groups[keyHash] = append(groups[keyHash], i)
-		
 		// Store key values for first occurrence
-		if _, exists := groupKeys[keyHash]; !exists {
-			groupKeys[keyHash] = keyValues
+		if _, exists := pg.groups[keyHash]; !exists {
+			pg.order = append(pg.order, keyHash)
+			pg.keys[keyHash] = keyValues
 		}
+		pg.groups[keyHash] = append(pg.groups[keyHash], i)
 	}
 
-	// Convert map to slice for stable ordering
-	groupKeySlice := make([][]any, 0, len(groups))
-	for hash := range groups {
-		groupKeySlice = append(groupKeySlice, groupKeys[hash])
+	return pg
+}
+
+// mergePartialGroups merges partial groups in chunk order.
+func mergePartialGroups(partials []*partialGroups) (map[string][]int, []string, [][]any) {
+	if len(partials) == 1 {
+		pg := partials[0]
+		groupKeys := make([][]any, len(pg.order))
+		for i, hash := range pg.order {
+			groupKeys[i] = pg.keys[hash]
+		}
+		return pg.groups, pg.order, groupKeys
 	}
 
-	return &GroupBy{
-		df:        df,
-		keys:      cols,
-		groups:    groups,
-		groupKeys: groupKeySlice,
-	}, nil
+	groups := make(map[string][]int)
+	groupHashes := make([]string, 0)
+	groupKeys := make([][]any, 0)
+
+	for _, pg := range partials {
+		for _, hash := range pg.order {
+			if _, exists := groups[hash]; !exists {
+				groupHashes = append(groupHashes, hash)
+				groupKeys = append(groupKeys, pg.keys[hash])
+			}
+			groups[hash] = append(groups[hash], pg.groups[hash]...)
+		}
+	}
+
+	return groups, groupHashes, groupKeys
+}
+
+// groupByWorkers returns the number of workers to use for a frame of nrows rows.
+func groupByWorkers(nrows int) int {
+	if nrows < parallelGroupByThreshold {
+		return 1
+	}
+	return parallel.Workers(core.DefaultWorkers)
+}
+
+// forEachGroup calls fn for every group index, in parallel when the GroupBy
+// was built with more than one worker. fn must only write to index i of its outputs.
+func (gb *GroupBy) forEachGroup(fn func(i int)) {
+	n := len(gb.groupKeys)
+	if gb.workers <= 1 || n < gb.workers {
+		for i := 0; i < n; i++ {
+			fn(i)
+		}
+		return
+	}
+
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	parallel.ParallelForEach(indices, fn, gb.workers)
 }
 
 // Agg performs single aggregation per column.
 // ops maps column names to aggregation function names.
 // Example: {"sales": "sum", "qty": "mean"}
+//
+// The result has the group key columns, with their source dtypes, then the
+// aggregated columns in the DataFrame's column order. Sum, mean, median,
+// std and var give Float64 columns, count and size Int64, and min, max,
+// first and last keep the source column's dtype.
 func (gb *GroupBy) Agg(ops map[string]string) (*DataFrame, error) {
 	if len(ops) == 0 {
 		return nil, fmt.Errorf("at least one aggregation required: %w", core.ErrInvalidArgument)
@@ -109,56 +222,24 @@ func (gb *GroupBy) Agg(ops map[string]string) (*DataFrame, error) {
 		}
 	}
 
-	nGroups := len(gb.groups)
-	
-	// Build result data
-	resultData := make(map[string]any)
-
-	// Add group key columns
-	for i, keyCol := range gb.keys {
-		keyData := make([]any, nGroups)
-		for j, keyValues := range gb.groupKeys {
-			keyData[j] = keyValues[i]
-		}
-		resultData[keyCol] = keyData
-	}
-
 	// Add aggregated columns
-	for col, aggFunc := range ops {
-		aggData := make([]any, nGroups)
-		
-		for i, keyValues := range gb.groupKeys {
-			keyHash := hashGroupKey(keyValues)
-			rowIndices := gb.groups[keyHash]
-			
-			// Extract values for this group
-			values := make([]any, 0, len(rowIndices))
-			s := gb.df.series[col]
-			
-			for _, idx := range rowIndices {
-				val, ok := s.Get(idx)
-				if ok {
-					values = append(values, val)
-				} else if aggFunc == AggSize {
-					values = append(values, nil)
-				}
-			}
-
-			// Apply aggregation
-			aggResult := applyAggregation(aggFunc, values, s.Dtype())
-			aggData[i] = aggResult
+	aggCols := make([]*series.Series[any], 0, len(ops))
+	for _, col := range gb.df.columns {
+		if aggFunc, ok := ops[col]; ok {
+			aggCols = append(aggCols, gb.aggColumn(col, gb.df.series[col], aggFunc))
 		}
-
-		resultData[col] = aggData
 	}
 
-	return New(resultData)
+	return gb.aggFrame(aggCols), nil
 }
 
 // AggMultiple performs multiple aggregations per column.
 // ops maps column names to slices of aggregation function names.
 // Example: {"sales": ["sum", "mean", "std"], "qty": ["min", "max"]}
 // Result columns: [group_keys..., sales_sum, sales_mean, sales_std, qty_min, qty_max]
+//
+// Columns follow the DataFrame's column order, and each column's
+// aggregations the order given. Dtypes are as for Agg.
 func (gb *GroupBy) AggMultiple(ops map[string][]string) (*DataFrame, error) {
 	if len(ops) == 0 {
 		return nil, fmt.Errorf("at least one aggregation required: %w", core.ErrInvalidArgument)
@@ -176,84 +257,361 @@ func (gb *GroupBy) AggMultiple(ops map[string][]string) (*DataFrame, error) {
 		}
 	}
 
-	nGroups := len(gb.groups)
-	resultData := make(map[string]any)
-
-	// Add group key columns
-	for i, keyCol := range gb.keys {
-		keyData := make([]any, nGroups)
-		for j, keyValues := range gb.groupKeys {
-			keyData[j] = keyValues[i]
-		}
-		resultData[keyCol] = keyData
-	}
-
 	// Add aggregated columns
-	for col, aggFuncs := range ops {
+	var aggCols []*series.Series[any]
+	for _, col := range gb.df.columns {
 		s := gb.df.series[col]
-
-		for _, aggFunc := range aggFuncs {
+		for _, aggFunc := range ops[col] {
 			resultCol := fmt.Sprintf("%s_%s", col, aggFunc)
-			aggData := make([]any, nGroups)
-
-			for i, keyValues := range gb.groupKeys {
-				keyHash := hashGroupKey(keyValues)
-				rowIndices := gb.groups[keyHash]
-
-				// Extract values for this group
-				values := make([]any, 0, len(rowIndices))
-				for _, idx := range rowIndices {
-					val, ok := s.Get(idx)
-					if ok {
-						values = append(values, val)
-					} else if aggFunc == AggSize {
-						values = append(values, nil)
-					}
-				}
-
-				// Apply aggregation
-				aggResult := applyAggregation(aggFunc, values, s.Dtype())
-				aggData[i] = aggResult
-			}
-
-			resultData[resultCol] = aggData
+			aggCols = append(aggCols, gb.aggColumn(resultCol, s, aggFunc))
 		}
 	}
 
-	return New(resultData)
+	return gb.aggFrame(aggCols), nil
 }
 
-// Apply applies a custom function to each group and returns a DataFrame.
+// Apply applies a custom function to each group and returns a DataFrame
+// with the group key columns and a "result" column of fn's return values.
 func (gb *GroupBy) Apply(fn func(*DataFrame) any) (*DataFrame, error) {
-	resultData := make(map[string]any)
+	// Apply function to each group
+	resultValues := make([]any, len(gb.groupKeys))
+
+	for i, keyHash := range gb.groupHashes {
+		rowIndices := gb.groups[keyHash]
+
+		// Create sub-DataFrame for this group
+		groupDf := gb.df.Iloc(rowIndices...)
+
+		// Apply function
+		resultValues[i] = fn(groupDf)
+	}
+
+	result := widenedSeries("result", resultValues, inferDtype(resultValues))
+	return gb.aggFrame([]*series.Series[any]{result}), nil
+}
 
-	// Add group key columns
+// keyColumns returns a Series per group key column holding each group's
+// key, with the source column's dtype. A null key is null.
+func (gb *GroupBy) keyColumns() []*series.Series[any] {
+	cols := make([]*series.Series[any], len(gb.keys))
 	for i, keyCol := range gb.keys {
+		src := gb.df.series[keyCol]
 		keyData := make([]any, len(gb.groupKeys))
+		var nulls []int
 		for j, keyValues := range gb.groupKeys {
+			if keyValues[i] == nil {
+				nulls = append(nulls, j)
+				continue
+			}
 			keyData[j] = keyValues[i]
 		}
-		resultData[keyCol] = keyData
+
+		s := newSeriesWithNulls(keyCol, keyData, src.Dtype(), nulls)
+		if src.IsCategorical() {
+			s = s.AsCategorical()
+		}
+		cols[i] = s
 	}
+	return cols
+}
 
-	// Apply function to each group
-	resultCol := "result"
-	resultValues := make([]any, len(gb.groupKeys))
+// aggColumn aggregates s within every group into a Series named name,
+// with the dtype aggDtype gives. Groups with nothing to aggregate are null.
+func (gb *GroupBy) aggColumn(name string, s *series.Series[any], aggFunc string) *series.Series[any] {
+	aggData := make([]any, len(gb.groupKeys))
+	gb.forEachGroup(func(i int) {
+		aggData[i] = gb.aggregateGroup(s, gb.groups[gb.groupHashes[i]], aggFunc)
+	})
+
+	dtype := aggDtype(aggFunc, s.Dtype())
+	result := widenedSeries(name, aggData, dtype)
+	if s.IsCategorical() && dtype == core.DtypeCategory {
+		result = result.AsCategorical()
+	}
+	return result
+}
 
-	for i, keyValues := range gb.groupKeys {
-		keyHash := hashGroupKey(keyValues)
+// aggDtype returns the dtype of aggFunc's results over a column of dtype.
+func aggDtype(aggFunc string, dtype core.Dtype) core.Dtype {
+	switch aggFunc {
+	case AggSum, AggMean, AggMedian, AggStd, AggVar:
+		return core.DtypeFloat64
+	case AggCount, AggSize:
+		return core.DtypeInt64
+	default:
+		return dtype
+	}
+}
+
+// aggFrame builds a result with a row per group: the key columns, then
+// cols. A column named like a key column takes that column's place.
+func (gb *GroupBy) aggFrame(cols []*series.Series[any]) *DataFrame {
+	keys := gb.keyColumns()
+	names := make([]string, 0, len(keys)+len(cols))
+	seriesMap := make(map[string]*series.Series[any], len(keys)+len(cols))
+	for _, s := range append(keys, cols...) {
+		if _, exists := seriesMap[s.Name()]; !exists {
+			names = append(names, s.Name())
+		}
+		seriesMap[s.Name()] = s
+	}
+
+	return &DataFrame{
+		columns: names,
+		series:  seriesMap,
+		index:   NewRangeIndex(0, len(gb.groupKeys), 1),
+		nrows:   len(gb.groupKeys),
+	}
+}
+
+// Filter returns the rows of every group for which fn returns true, in their
+// original order. fn receives each group as a sub-DataFrame, like Apply.
+func (gb *GroupBy) Filter(fn func(*DataFrame) bool) (*DataFrame, error) {
+	keep := make([]int, 0)
+	for _, keyHash := range gb.groupHashes {
 		rowIndices := gb.groups[keyHash]
+		if fn(gb.df.Iloc(rowIndices...)) {
+			keep = append(keep, rowIndices...)
+		}
+	}
+	sort.Ints(keep)
 
-		// Create sub-DataFrame for this group
-		groupDf := gb.df.Iloc(rowIndices...)
+	return gb.df.Iloc(keep...), nil
+}
 
-		// Apply function
-		resultValues[i] = fn(groupDf)
+// Head returns the first n rows of each group, in their original order.
+// Groups with fewer than n rows are returned whole.
+func (gb *GroupBy) Head(n int) (*DataFrame, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("head size %d: %w", n, core.ErrInvalidArgument)
+	}
+	return gb.takeRows(func(rows []int) []int {
+		if len(rows) > n {
+			return rows[:n]
+		}
+		return rows
+	}), nil
+}
+
+// Tail returns the last n rows of each group, in their original order.
+// Groups with fewer than n rows are returned whole.
+func (gb *GroupBy) Tail(n int) (*DataFrame, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("tail size %d: %w", n, core.ErrInvalidArgument)
+	}
+	return gb.takeRows(func(rows []int) []int {
+		if len(rows) > n {
+			return rows[len(rows)-n:]
+		}
+		return rows
+	}), nil
+}
+
+// takeRows returns the rows picked from each group's row indices, in their
+// original order.
+func (gb *GroupBy) takeRows(pick func(rows []int) []int) *DataFrame {
+	keep := make([]int, 0)
+	for _, keyHash := range gb.groupHashes {
+		keep = append(keep, pick(gb.groups[keyHash])...)
 	}
+	sort.Ints(keep)
 
-	resultData[resultCol] = resultValues
+	return gb.df.Iloc(keep...)
+}
+
+// CumSum returns the running sum of col within each row's group, aligned to
+// the rows of the original DataFrame. Null values are skipped by the running
+// sum and produce null at their own row. The result has dtype Float64.
+func (gb *GroupBy) CumSum(col string) (*series.Series[any], error) {
+	return gb.cumulative(col, "cumsum", func(acc, val any) any {
+		f, _ := core.ToFloat64(val)
+		if acc == nil {
+			return f
+		}
+		return acc.(float64) + f
+	}, core.DtypeFloat64)
+}
+
+// CumMax returns the running maximum of col within each row's group, aligned
+// to the rows of the original DataFrame. Nulls are handled as in CumSum. The
+// result keeps the dtype of col.
+func (gb *GroupBy) CumMax(col string) (*series.Series[any], error) {
+	return gb.cumulative(col, "cummax", func(acc, val any) any {
+		if acc == nil {
+			return val
+		}
+		v, _ := core.ToFloat64(val)
+		a, _ := core.ToFloat64(acc)
+		if v > a {
+			return val
+		}
+		return acc
+	}, -1)
+}
 
-	return New(resultData)
+// CumMin returns the running minimum of col within each row's group, aligned
+// to the rows of the original DataFrame. Nulls are handled as in CumSum. The
+// result keeps the dtype of col.
+func (gb *GroupBy) CumMin(col string) (*series.Series[any], error) {
+	return gb.cumulative(col, "cummin", func(acc, val any) any {
+		if acc == nil {
+			return val
+		}
+		v, _ := core.ToFloat64(val)
+		a, _ := core.ToFloat64(acc)
+		if v < a {
+			return val
+		}
+		return acc
+	}, -1)
+}
+
+// CumCount numbers the rows of each group from 0 in their original order,
+// aligned to the rows of the original DataFrame. The result is named
+// "cumcount" and has dtype Int64.
+func (gb *GroupBy) CumCount() *series.Series[any] {
+	data := make([]any, gb.df.Nrows())
+	for _, keyHash := range gb.groupHashes {
+		for pos, row := range gb.groups[keyHash] {
+			data[row] = int64(pos)
+		}
+	}
+	return series.New("cumcount", data, core.DtypeInt64)
+}
+
+// cumulative folds step over the non-null values of col within each group,
+// storing the running result at each row. A negative dtype keeps col's dtype.
+func (gb *GroupBy) cumulative(col, suffix string, step func(acc, val any) any, dtype core.Dtype) (*series.Series[any], error) {
+	s, err := gb.df.Column(col)
+	if err != nil {
+		return nil, err
+	}
+	if !core.IsNumeric(s.Dtype()) {
+		return nil, fmt.Errorf("column %q has dtype %s: %w", col, s.Dtype(), core.ErrTypeMismatch)
+	}
+	if dtype < 0 {
+		dtype = s.Dtype()
+	}
+
+	data := make([]any, s.Len())
+	var nulls []int
+	for _, keyHash := range gb.groupHashes {
+		var acc any
+		for _, row := range gb.groups[keyHash] {
+			val, ok := s.Get(row)
+			if !ok || val == nil {
+				nulls = append(nulls, row)
+				continue
+			}
+			acc = step(acc, val)
+			data[row] = acc
+		}
+	}
+
+	result := series.New(col+"_"+suffix, data, dtype)
+	for _, row := range nulls {
+		result.SetNull(row)
+	}
+	return result, nil
+}
+
+// PctOfTotal returns each row's value of col divided by the sum of col over
+// its group, aligned to the rows of the original DataFrame, so the shares
+// within a group sum to 1. Null values, and every row of a group whose sum
+// is zero, are null. The result is named "<col>_pct" and has dtype Float64.
+func (gb *GroupBy) PctOfTotal(col string) (*series.Series[any], error) {
+	s, err := gb.df.Column(col)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([][]int, len(gb.groupHashes))
+	for i, keyHash := range gb.groupHashes {
+		groups[i] = gb.groups[keyHash]
+	}
+	return pctOfTotal(col, s, groups)
+}
+
+// pctOfTotal divides each value of s by the sum of its group's values.
+// Rows in no group are null.
+func pctOfTotal(col string, s *series.Series[any], groups [][]int) (*series.Series[any], error) {
+	if !core.IsNumeric(s.Dtype()) {
+		return nil, fmt.Errorf("column %q has dtype %s: %w", col, s.Dtype(), core.ErrTypeMismatch)
+	}
+
+	n := s.Len()
+	data := make([]any, n)
+	nulls := bitset.New(n)
+	nulls.SetAll()
+	for _, rows := range groups {
+		var sum neumaierSum
+		for _, row := range rows {
+			if val, ok := s.Get(row); ok && val != nil {
+				f, _ := core.ToFloat64(val)
+				sum.add(f)
+			}
+		}
+		total := sum.value()
+		if total == 0 {
+			continue
+		}
+		for _, row := range rows {
+			if val, ok := s.Get(row); ok && val != nil {
+				f, _ := core.ToFloat64(val)
+				data[row] = f / total
+				nulls.Clear(row)
+			}
+		}
+	}
+
+	if !nulls.Any() {
+		nulls = nil
+	}
+	return series.NewWithNulls(col+"_pct", data, core.DtypeFloat64, nulls), nil
+}
+
+// Rank returns the ascending rank of each row's value of col within its
+// group, aligned to the rows of the original DataFrame. method decides the
+// rank of ties as for DataFrame.Rank. Nulls stay null. The result is named
+// "<col>_rank" and has dtype Float64.
+func (gb *GroupBy) Rank(col, method string) (*series.Series[any], error) {
+	if err := checkRankMethod(method); err != nil {
+		return nil, err
+	}
+	s, err := gb.df.Column(col)
+	if err != nil {
+		return nil, err
+	}
+	if !rankable(s.Dtype()) {
+		return nil, fmt.Errorf("column %q has dtype %s: %w", col, s.Dtype(), core.ErrTypeMismatch)
+	}
+
+	n := s.Len()
+	data := make([]any, n)
+	nulls := bitset.New(n)
+	nulls.SetAll()
+	for _, keyHash := range gb.groupHashes {
+		rows := gb.groups[keyHash]
+		values := make([]any, len(rows))
+		for i, row := range rows {
+			if val, ok := s.Get(row); ok {
+				values[i] = val
+			}
+		}
+
+		ranks := series.Rank(widenedSeries(col, values, s.Dtype()), method, true)
+		for i, row := range rows {
+			if rank, ok := ranks.Get(i); ok {
+				data[row] = rank
+				nulls.Clear(row)
+			}
+		}
+	}
+
+	if !nulls.Any() {
+		nulls = nil
+	}
+	return series.NewWithNulls(col+"_rank", data, core.DtypeFloat64, nulls), nil
 }
 
 // Size returns the size of each group (including nulls).
@@ -286,17 +644,23 @@ func (gb *GroupBy) Count() (*DataFrame, error) {
 
 // Helper functions
 
-// hashGroupKey creates a hash string from group key values.
-func hashGroupKey(values []any) string {
-	parts := make([]string, len(values))
-	for i, val := range values {
-		if val == nil {
-			parts[i] = "<null>"
-		} else {
-			parts[i] = fmt.Sprintf("%v", val)
+// aggregateGroup applies an aggregation function to the given rows of a Series.
+func (gb *GroupBy) aggregateGroup(s *series.Series[any], rowIndices []int, aggFunc string) any {
+	// Extract values for this group
+	values := make([]any, 0, len(rowIndices))
+	for _, idx := range rowIndices {
+		val, ok := s.Get(idx)
+		if ok {
+			values = append(values, val)
+		} else if aggFunc == AggSize {
+			values = append(values, nil)
 		}
 	}
-	return strings.Join(parts, "|")
+
+	if len(values) == 0 && aggFunc == AggCount {
+		return int64(0) // Every value in the group is null
+	}
+	return applyAggregation(aggFunc, values, s.Dtype())
 }
 
 // isValidAggFunc checks if an aggregation function name is valid.
@@ -370,40 +734,43 @@ func applyAggregation(aggFunc string, values []any, dtype core.Dtype) any {
 // Aggregation implementations
 
 func aggSum(values []any) any {
-	var sum float64
+	var sum neumaierSum
 	count := 0
 	for _, v := range values {
 		if v != nil {
-			sum += toFloat64(v)
+			f, _ := core.ToFloat64(v)
+			sum.add(f)
 			count++
 		}
 	}
 	if count == 0 {
 		return nil
 	}
-	return sum
+	return sum.value()
 }
 
 func aggMean(values []any) any {
-	var sum float64
+	var sum neumaierSum
 	count := 0
 	for _, v := range values {
 		if v != nil {
-			sum += toFloat64(v)
+			f, _ := core.ToFloat64(v)
+			sum.add(f)
 			count++
 		}
 	}
 	if count == 0 {
 		return nil
 	}
-	return sum / float64(count)
+	return sum.value() / float64(count)
 }
 
 func aggMedian(values []any) any {
 	floats := make([]float64, 0, len(values))
 	for _, v := range values {
 		if v != nil {
-			floats = append(floats, toFloat64(v))
+			f, _ := core.ToFloat64(v)
+			floats = append(floats, f)
 		}
 	}
 	if len(floats) == 0 {
@@ -434,12 +801,13 @@ func aggVar(values []any) any {
 	}
 	meanVal := mean.(float64)
 
-	var sumSq float64
+	var sumSq neumaierSum
 	count := 0
 	for _, v := range values {
 		if v != nil {
-			diff := toFloat64(v) - meanVal
-			sumSq += diff * diff
+			f, _ := core.ToFloat64(v)
+			diff := f - meanVal
+			sumSq.add(diff * diff)
 			count++
 		}
 	}
@@ -448,7 +816,7 @@ func aggVar(values []any) any {
 		return nil
 	}
 
-	return sumSq / float64(count-1) // Bessel's correction
+	return sumSq.value() / float64(count-1) // Bessel's correction
 }
 
 func aggMin(values []any) any {
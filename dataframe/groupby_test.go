@@ -0,0 +1,593 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+// TestGroupByParallelMatchesSerial verifies that the partitioned group
+// construction produces exactly the same groups, in the same order, as the serial path.
+func TestGroupByParallelMatchesSerial(t *testing.T) {
+	// Leave room for workers even on a single CPU
+	core.SetParallelism(8)
+	defer core.SetParallelism(0)
+
+	df := generateTestData(20000, 7)
+
+	serial, err := df.groupBy([]string{"group", "category"}, 1)
+	if err != nil {
+		t.Fatalf("serial GroupBy failed: %v", err)
+	}
+
+	for _, workers := range []int{2, 3, 8} {
+		t.Run(fmt.Sprintf("workers_%d", workers), func(t *testing.T) {
+			par, err := df.groupBy([]string{"group", "category"}, workers)
+			if err != nil {
+				t.Fatalf("parallel GroupBy failed: %v", err)
+			}
+
+			if len(par.groupHashes) != len(serial.groupHashes) {
+				t.Fatalf("Expected %d groups, got %d", len(serial.groupHashes), len(par.groupHashes))
+			}
+
+			for i, hash := range serial.groupHashes {
+				if par.groupHashes[i] != hash {
+					t.Fatalf("Group %d: expected key %q, got %q", i, hash, par.groupHashes[i])
+				}
+				want, got := serial.groups[hash], par.groups[hash]
+				if len(want) != len(got) {
+					t.Fatalf("Group %q: expected %d rows, got %d", hash, len(want), len(got))
+				}
+				for j := range want {
+					if want[j] != got[j] {
+						t.Fatalf("Group %q row %d: expected %d, got %d", hash, j, want[j], got[j])
+					}
+				}
+			}
+
+			ops := map[string][]string{"value1": {"sum", "mean", "max"}, "value2": {"count", "std"}}
+			want, err := serial.AggMultiple(ops)
+			if err != nil {
+				t.Fatalf("serial AggMultiple failed: %v", err)
+			}
+			got, err := par.AggMultiple(ops)
+			if err != nil {
+				t.Fatalf("parallel AggMultiple failed: %v", err)
+			}
+
+			for _, col := range want.Columns() {
+				ws, _ := want.Column(col)
+				gs, err := got.Column(col)
+				if err != nil {
+					t.Fatalf("column %q missing from parallel result", col)
+				}
+				for i := 0; i < ws.Len(); i++ {
+					wv, _ := ws.Get(i)
+					gv, _ := gs.Get(i)
+					if wv != gv {
+						t.Fatalf("column %q row %d: expected %v, got %v", col, i, wv, gv)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestGroupByFirstAppearanceOrder verifies groups are ordered by first appearance.
+func TestGroupByFirstAppearanceOrder(t *testing.T) {
+	df, _ := New(map[string]any{
+		"key": []string{"c", "a", "c", "b", "a"},
+		"val": []int64{1, 2, 3, 4, 5},
+	})
+
+	grouped, err := df.GroupBy("key")
+	if err != nil {
+		t.Fatalf("GroupBy failed: %v", err)
+	}
+
+	result, err := grouped.Agg(map[string]string{"val": "sum"})
+	if err != nil {
+		t.Fatalf("Agg failed: %v", err)
+	}
+
+	keys, _ := result.Column("key")
+	sums, _ := result.Column("val")
+	expectedKeys := []string{"c", "a", "b"}
+	expectedSums := []float64{4, 7, 4}
+	for i := range expectedKeys {
+		k, _ := keys.Get(i)
+		v, _ := sums.Get(i)
+		if k != expectedKeys[i] || v != expectedSums[i] {
+			t.Errorf("Group %d: expected (%s, %v), got (%v, %v)", i, expectedKeys[i], expectedSums[i], k, v)
+		}
+	}
+}
+
+// TestGroupByKeysWithSeparators verifies that key values containing the
+// characters used to build composite keys never merge distinct groups.
+func TestGroupByKeysWithSeparators(t *testing.T) {
+	df, _ := New(map[string]any{
+		"k1": []string{"a|b", "a", "a|b", ""},
+		"k2": []string{"c", "b|c", "c", "a|b|c"},
+		"v":  []float64{1, 2, 3, 4},
+	})
+
+	gb, err := df.GroupBy("k1", "k2")
+	if err != nil {
+		t.Fatalf("GroupBy failed: %v", err)
+	}
+
+	if len(gb.groups) != 3 {
+		t.Fatalf("Expected 3 distinct groups, got %d", len(gb.groups))
+	}
+
+	result, err := gb.Agg(map[string]string{"v": AggSum})
+	if err != nil {
+		t.Fatalf("Agg failed: %v", err)
+	}
+
+	k1, _ := result.Column("k1")
+	k2, _ := result.Column("k2")
+	sums, _ := result.Column("v")
+	expected := map[[2]string]float64{
+		{"a|b", "c"}:  4,
+		{"a", "b|c"}:  2,
+		{"", "a|b|c"}: 4,
+	}
+	for i := 0; i < result.Nrows(); i++ {
+		a, _ := k1.Get(i)
+		b, _ := k2.Get(i)
+		sum, _ := sums.Get(i)
+		key := [2]string{a.(string), b.(string)}
+		if want, ok := expected[key]; !ok || sum != want {
+			t.Errorf("Group %v: expected sum %v, got %v", key, want, sum)
+		}
+	}
+
+	// A null key stays distinct from any string value
+	if encodeKey([]any{nil}) == encodeKey([]any{"<null>"}) {
+		t.Error("Expected null key to differ from the string \"<null>\"")
+	}
+
+	// Values of different kinds stay distinct even if they format alike,
+	// but integers and floats of the same value match
+	if encodeKey([]any{int64(1)}) == encodeKey([]any{"1"}) {
+		t.Error("Expected int64(1) and \"1\" to produce different keys")
+	}
+	if encodeKey([]any{true}) == encodeKey([]any{"true"}) {
+		t.Error("Expected true and \"true\" to produce different keys")
+	}
+	if encodeKey([]any{int64(1)}) != encodeKey([]any{1.0}) {
+		t.Error("Expected int64(1) and float64(1) to produce the same key")
+	}
+}
+
+func TestGroupByCategorical(t *testing.T) {
+	n := 1000
+	countries := []string{"us", "de", "jp", "br", "in"}
+	data := make([]any, n)
+	values := make([]float64, n)
+	for i := range data {
+		data[i] = countries[(i*7)%len(countries)]
+		values[i] = float64(i)
+	}
+
+	plain, _ := New(map[string]any{"country": data, "v": values})
+	col, _ := plain.Column("country")
+	cat := plain.WithColumn("country", col.AsCategorical())
+	cat.series["country"].SetNull(0)
+	plain.series["country"].SetNull(0)
+
+	for _, workers := range []int{1, 4} {
+		gbPlain, err := plain.groupBy([]string{"country"}, workers)
+		if err != nil {
+			t.Fatalf("GroupBy failed: %v", err)
+		}
+		gbCat, err := cat.groupBy([]string{"country"}, workers)
+		if err != nil {
+			t.Fatalf("GroupBy failed: %v", err)
+		}
+
+		if len(gbCat.groupKeys) != len(gbPlain.groupKeys) {
+			t.Fatalf("workers=%d: expected %d groups, got %d", workers, len(gbPlain.groupKeys), len(gbCat.groupKeys))
+		}
+		for g := range gbPlain.groupKeys {
+			if gbCat.groupKeys[g][0] != gbPlain.groupKeys[g][0] {
+				t.Errorf("workers=%d group %d: expected key %v, got %v", workers, g, gbPlain.groupKeys[g][0], gbCat.groupKeys[g][0])
+			}
+			plainRows := gbPlain.groups[gbPlain.groupHashes[g]]
+			catRows := gbCat.groups[gbCat.groupHashes[g]]
+			if len(catRows) != len(plainRows) {
+				t.Errorf("workers=%d group %v: expected %d rows, got %d", workers, gbPlain.groupKeys[g][0], len(plainRows), len(catRows))
+			}
+		}
+	}
+}
+
+func TestJoinCategorical(t *testing.T) {
+	left, _ := New(map[string]any{
+		"k": []any{"a", "b", "c", "a"},
+		"x": []int64{1, 2, 3, 4},
+	})
+	right, _ := New(map[string]any{
+		"k": []any{"d", "a", "b"},
+		"y": []int64{10, 20, 30},
+	})
+	lk, _ := left.Column("k")
+	rk, _ := right.Column("k")
+	left = left.WithColumn("k", lk.AsCategorical())
+	right = right.WithColumn("k", rk.AsCategorical())
+
+	inner, err := left.Join(right, JoinInner, "k")
+	if err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+	if inner.Nrows() != 3 {
+		t.Fatalf("Expected 3 inner rows, got %d", inner.Nrows())
+	}
+	xs, _ := inner.Column("x")
+	ys, _ := inner.Column("y")
+	expected := map[int64]int64{1: 20, 2: 30, 4: 20}
+	for i := 0; i < inner.Nrows(); i++ {
+		x, _ := xs.Get(i)
+		y, _ := ys.Get(i)
+		if expected[x.(int64)] != y {
+			t.Errorf("Row with x=%v: expected y=%v, got %v", x, expected[x.(int64)], y)
+		}
+	}
+
+	outer, err := left.Join(right, JoinOuter, "k")
+	if err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+	// 3 matched rows, unmatched left "c", unmatched right "d"
+	if outer.Nrows() != 5 {
+		t.Errorf("Expected 5 outer rows, got %d", outer.Nrows())
+	}
+}
+
+func TestGroupByFilter(t *testing.T) {
+	df, _ := New(map[string]any{
+		"key": []string{"a", "b", "a", "c", "b", "a", "c", "b"},
+		"val": []int64{1, 2, 3, 4, 5, 6, 7, 8},
+	})
+
+	grouped, err := df.GroupBy("key")
+	if err != nil {
+		t.Fatalf("GroupBy failed: %v", err)
+	}
+
+	// Keep groups with more than two rows: a (1,3,6) and b (2,5,8)
+	result, err := grouped.Filter(func(g *DataFrame) bool { return g.Nrows() > 2 })
+	if err != nil {
+		t.Fatalf("Filter failed: %v", err)
+	}
+
+	keys, _ := result.Column("key")
+	vals, _ := result.Column("val")
+	expectedKeys := []string{"a", "b", "a", "b", "a", "b"}
+	expectedVals := []int64{1, 2, 3, 5, 6, 8}
+	if result.Nrows() != len(expectedVals) {
+		t.Fatalf("Expected %d rows, got %d", len(expectedVals), result.Nrows())
+	}
+	for i := range expectedVals {
+		k, _ := keys.Get(i)
+		v, _ := vals.Get(i)
+		if k != expectedKeys[i] || v != expectedVals[i] {
+			t.Errorf("Row %d: expected (%s, %d), got (%v, %v)", i, expectedKeys[i], expectedVals[i], k, v)
+		}
+	}
+
+	none, err := grouped.Filter(func(g *DataFrame) bool { return false })
+	if err != nil {
+		t.Fatalf("Filter failed: %v", err)
+	}
+	if none.Nrows() != 0 || none.Ncols() != 2 {
+		t.Errorf("Expected an empty frame with 2 columns, got shape (%d, %d)", none.Nrows(), none.Ncols())
+	}
+}
+
+func TestGroupByHeadTail(t *testing.T) {
+	df, _ := New(map[string]any{
+		"key": []string{"a", "b", "a", "c", "b", "a", "b", "a"},
+		"val": []int64{1, 2, 3, 4, 5, 6, 7, 8},
+	})
+
+	grouped, err := df.GroupBy("key")
+	if err != nil {
+		t.Fatalf("GroupBy failed: %v", err)
+	}
+
+	check := func(name string, result *DataFrame, expectedKeys []string, expectedVals []int64) {
+		t.Helper()
+		if result.Nrows() != len(expectedVals) {
+			t.Fatalf("%s: expected %d rows, got %d", name, len(expectedVals), result.Nrows())
+		}
+		keys, _ := result.Column("key")
+		vals, _ := result.Column("val")
+		for i := range expectedVals {
+			k, _ := keys.Get(i)
+			v, _ := vals.Get(i)
+			if k != expectedKeys[i] || v != expectedVals[i] {
+				t.Errorf("%s row %d: expected (%s, %d), got (%v, %v)", name, i, expectedKeys[i], expectedVals[i], k, v)
+			}
+		}
+	}
+
+	head, err := grouped.Head(2)
+	if err != nil {
+		t.Fatalf("Head failed: %v", err)
+	}
+	check("Head", head, []string{"a", "b", "a", "c", "b"}, []int64{1, 2, 3, 4, 5})
+
+	tail, err := grouped.Tail(2)
+	if err != nil {
+		t.Fatalf("Tail failed: %v", err)
+	}
+	check("Tail", tail, []string{"c", "b", "a", "b", "a"}, []int64{4, 5, 6, 7, 8})
+
+	if _, err := grouped.Head(-1); err == nil {
+		t.Error("Expected an error for a negative size")
+	}
+}
+
+func TestGroupByCumulative(t *testing.T) {
+	df, _ := New(map[string]any{
+		"customer": []string{"a", "b", "a", "b", "a", "b"},
+		"sales":    []int64{10, 1, 5, 3, 20, 2},
+	})
+	sales, _ := df.Column("sales")
+	sales.SetNull(3)
+
+	grouped, err := df.GroupBy("customer")
+	if err != nil {
+		t.Fatalf("GroupBy failed: %v", err)
+	}
+
+	check := func(name string, s interface{ Get(int) (any, bool) }, expected []any) {
+		t.Helper()
+		for i, want := range expected {
+			got, ok := s.Get(i)
+			if want == nil {
+				if ok {
+					t.Errorf("%s[%d]: expected null, got %v", name, i, got)
+				}
+				continue
+			}
+			if !ok || got != want {
+				t.Errorf("%s[%d]: expected %v, got %v", name, i, want, got)
+			}
+		}
+	}
+
+	cumsum, err := grouped.CumSum("sales")
+	if err != nil {
+		t.Fatalf("CumSum failed: %v", err)
+	}
+	if cumsum.Len() != 6 || cumsum.Name() != "sales_cumsum" {
+		t.Errorf("Expected sales_cumsum of length 6, got %s of length %d", cumsum.Name(), cumsum.Len())
+	}
+	check("CumSum", cumsum, []any{10.0, 1.0, 15.0, nil, 35.0, 3.0})
+
+	cummax, err := grouped.CumMax("sales")
+	if err != nil {
+		t.Fatalf("CumMax failed: %v", err)
+	}
+	check("CumMax", cummax, []any{int64(10), int64(1), int64(10), nil, int64(20), int64(2)})
+
+	cummin, err := grouped.CumMin("sales")
+	if err != nil {
+		t.Fatalf("CumMin failed: %v", err)
+	}
+	check("CumMin", cummin, []any{int64(10), int64(1), int64(5), nil, int64(5), int64(1)})
+
+	check("CumCount", grouped.CumCount(), []any{int64(0), int64(0), int64(1), int64(1), int64(2), int64(2)})
+
+	if _, err := grouped.CumSum("customer"); err == nil {
+		t.Error("Expected an error for a non-numeric column")
+	}
+	if _, err := grouped.CumSum("missing"); err == nil {
+		t.Error("Expected an error for a missing column")
+	}
+}
+
+func TestGroupByPctOfTotal(t *testing.T) {
+	df, _ := New(map[string]any{
+		"store": []string{"a", "b", "a", "b", "a", "c", "c"},
+		"sales": []int64{10, 1, 30, 3, 60, 0, 0},
+	})
+	sales, _ := df.Column("sales")
+	sales.SetNull(3)
+
+	grouped, err := df.GroupBy("store")
+	if err != nil {
+		t.Fatalf("GroupBy failed: %v", err)
+	}
+	pct, err := grouped.PctOfTotal("sales")
+	if err != nil {
+		t.Fatalf("PctOfTotal failed: %v", err)
+	}
+	if pct.Name() != "sales_pct" || pct.Len() != 7 {
+		t.Fatalf("Expected sales_pct of length 7, got %s of length %d", pct.Name(), pct.Len())
+	}
+
+	// Group b's null stays null and group c's zero total makes it all null.
+	expected := []float64{0.1, 1, 0.3, math.NaN(), 0.6, math.NaN(), math.NaN()}
+	groupSums := make(map[string]float64)
+	for i, want := range expected {
+		got, ok := pct.Get(i)
+		if math.IsNaN(want) {
+			if ok {
+				t.Errorf("row %d: expected null, got %v", i, got)
+			}
+			continue
+		}
+		if !ok || math.Abs(got.(float64)-want) > 1e-12 {
+			t.Errorf("row %d: expected %v, got %v", i, want, got)
+		}
+		store, _ := df.series["store"].Get(i)
+		groupSums[store.(string)] += got.(float64)
+	}
+	for store, sum := range groupSums {
+		if math.Abs(sum-1) > 1e-12 {
+			t.Errorf("group %s: shares sum to %v, want 1", store, sum)
+		}
+	}
+
+	total, err := df.PctOfTotal("sales")
+	if err != nil {
+		t.Fatalf("DataFrame.PctOfTotal failed: %v", err)
+	}
+	if got, ok := total.Get(4); !ok || math.Abs(got.(float64)-60.0/101) > 1e-12 {
+		t.Errorf("row 4: expected %v, got %v", 60.0/101, got)
+	}
+	if !total.IsNull(3) {
+		t.Error("Expected row 3 to stay null")
+	}
+
+	// The shares go straight back into the frame
+	withPct := df.WithColumn(pct.Name(), pct)
+	if s, _ := withPct.Column("sales_pct"); s.Dtype() != core.DtypeFloat64 {
+		t.Errorf("Expected sales_pct dtype float64, got %v", s.Dtype())
+	}
+
+	if _, err := grouped.PctOfTotal("store"); !errors.Is(err, core.ErrTypeMismatch) {
+		t.Errorf("Expected ErrTypeMismatch, got %v", err)
+	}
+	if _, err := df.PctOfTotal("missing"); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("Expected ErrColumnNotFound, got %v", err)
+	}
+}
+
+func TestGroupByAggDtypes(t *testing.T) {
+	df, _ := New(map[string]any{
+		"store": []int64{7, 3, 7, 3, 9},
+		"name":  []string{"x", "y", "z", "w", "v"},
+		"sales": []int64{10, 1, 30, 3, 60},
+	})
+	df = df.Select("store", "name", "sales")
+	sales, _ := df.Column("sales")
+	sales.SetNull(4)
+
+	grouped, err := df.GroupBy("store")
+	if err != nil {
+		t.Fatalf("GroupBy failed: %v", err)
+	}
+	result, err := grouped.AggMultiple(map[string][]string{
+		"sales": {AggSum, AggCount, AggMax},
+		"name":  {AggFirst},
+	})
+	if err != nil {
+		t.Fatalf("AggMultiple failed: %v", err)
+	}
+
+	wantCols := []string{"store", "name_first", "sales_sum", "sales_count", "sales_max"}
+	if fmt.Sprint(result.Columns()) != fmt.Sprint(wantCols) {
+		t.Fatalf("Expected columns %v, got %v", wantCols, result.Columns())
+	}
+	wantDtypes := map[string]core.Dtype{
+		"store":       core.DtypeInt64,
+		"name_first":  core.DtypeString,
+		"sales_sum":   core.DtypeFloat64,
+		"sales_count": core.DtypeInt64,
+		"sales_max":   core.DtypeInt64,
+	}
+	for col, want := range wantDtypes {
+		s, _ := result.Column(col)
+		if s.Dtype() != want {
+			t.Errorf("%s: expected dtype %v, got %v", col, want, s.Dtype())
+		}
+	}
+
+	store, _ := result.Column("store")
+	count, _ := result.Column("sales_count")
+	sum, _ := result.Column("sales_sum")
+	for i, want := range []int64{7, 3, 9} {
+		if got, _ := store.Get(i); got != want {
+			t.Errorf("store[%d]: expected %d, got %v (%T)", i, want, got, got)
+		}
+	}
+	for i, want := range []int64{2, 2, 0} {
+		if got, _ := count.Get(i); got != want {
+			t.Errorf("sales_count[%d]: expected %d, got %v (%T)", i, want, got, got)
+		}
+	}
+	if got, _ := sum.Get(0); got != 40.0 {
+		t.Errorf("sales_sum[0]: expected 40, got %v (%T)", got, got)
+	}
+	if !sum.IsNull(2) {
+		t.Error("Expected a null sum for a group of nulls")
+	}
+}
+
+func TestGroupByParallelismOne(t *testing.T) {
+	df := generateTestData(parallelGroupByThreshold+1000, 7)
+	ops := map[string][]string{"value1": {"sum", "mean", "max"}, "value2": {"count", "std"}}
+
+	aggregate := func(parallelism int) (*GroupBy, *DataFrame) {
+		t.Helper()
+		core.SetParallelism(parallelism)
+		defer core.SetParallelism(0)
+
+		grouped, err := df.GroupBy("group", "category")
+		if err != nil {
+			t.Fatalf("GroupBy failed: %v", err)
+		}
+		result, err := grouped.AggMultiple(ops)
+		if err != nil {
+			t.Fatalf("AggMultiple failed: %v", err)
+		}
+		return grouped, result
+	}
+
+	serial, want := aggregate(1)
+	if serial.workers != 1 {
+		t.Errorf("Expected 1 worker with a parallelism of 1, got %d", serial.workers)
+	}
+	_, got := aggregate(8)
+	if !got.Equals(want) {
+		t.Error("Results with a parallelism of 1 differ from the parallel results")
+	}
+}
+
+func TestGroupByRank(t *testing.T) {
+	df, _ := New(map[string]any{
+		"region": []string{"east", "west", "east", "west", "east", "west"},
+		"sales":  []float64{200, 50, 100, 75, 200, 0},
+	})
+	sales, _ := df.Column("sales")
+	sales.SetNull(5)
+
+	grouped, err := df.GroupBy("region")
+	if err != nil {
+		t.Fatalf("GroupBy failed: %v", err)
+	}
+	ranks, err := grouped.Rank("sales", "min")
+	if err != nil {
+		t.Fatalf("Rank failed: %v", err)
+	}
+	if ranks.Name() != "sales_rank" || ranks.Len() != 6 {
+		t.Fatalf("Expected sales_rank of length 6, got %s of length %d", ranks.Name(), ranks.Len())
+	}
+
+	// east: 200, 100, 200 → 2, 1, 2; west: 50, 75, null → 1, 2, null
+	for i, want := range []float64{2, 1, 1, 2, 2} {
+		if got, ok := ranks.Get(i); !ok || got != want {
+			t.Errorf("rank[%d]: expected %v, got %v", i, want, got)
+		}
+	}
+	if !ranks.IsNull(5) {
+		t.Error("Expected a null rank for a null value")
+	}
+	if withRank := df.WithColumn(ranks.Name(), ranks); !withRank.HasColumn("sales_rank") {
+		t.Error("Expected the ranks to go back into the frame with WithColumn")
+	}
+
+	if _, err := grouped.Rank("sales", "median"); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument, got %v", err)
+	}
+}
@@ -264,3 +264,24 @@ func (di *DatetimeIndex) Copy() core.Index {
 	copy(newTimes, di.times)
 	return NewDatetimeIndex(newTimes, di.tz)
 }
+
+// takeIndex returns the labels of idx at positions as a new index, or nil if
+// the index type cannot hold arbitrary labels (RangeIndex).
+func takeIndex(idx core.Index, positions []int) core.Index {
+	switch ix := idx.(type) {
+	case *StringIndex:
+		labels := make([]string, len(positions))
+		for i, pos := range positions {
+			labels[i] = ix.labels[pos]
+		}
+		return NewStringIndex(labels)
+	case *DatetimeIndex:
+		times := make([]time.Time, len(positions))
+		for i, pos := range positions {
+			times[i] = ix.times[pos]
+		}
+		return NewDatetimeIndex(times, ix.tz)
+	default:
+		return nil
+	}
+}
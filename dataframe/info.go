@@ -0,0 +1,191 @@
+package dataframe
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/series"
+)
+
+// interfaceSize is the size of an interface value holding a Series element.
+const interfaceSize = 16
+
+// ColumnInfo summarizes one column of a DataFrame.
+type ColumnInfo struct {
+	Name    string
+	Dtype   core.Dtype
+	NonNull int   // Number of non-null values
+	Null    int   // Number of null values
+	Memory  int64 // Estimated memory use in bytes
+}
+
+// FrameInfo summarizes the shape and columns of a DataFrame.
+type FrameInfo struct {
+	Rows    int
+	Cols    int
+	Columns []ColumnInfo
+	Memory  int64 // Estimated memory use of all columns in bytes
+}
+
+// Info returns a per-column summary of the DataFrame: dtype, non-null and
+// null counts, and estimated memory, plus the overall shape.
+// Nil values count as null. Memory is an estimate of the element storage
+// (values, string bytes, and null masks), not an exact heap measurement.
+func (df *DataFrame) Info() *FrameInfo {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	info := &FrameInfo{
+		Rows:    df.nrows,
+		Cols:    len(df.columns),
+		Columns: make([]ColumnInfo, len(df.columns)),
+	}
+
+	for i, col := range df.columns {
+		s := df.series[col]
+		colInfo := ColumnInfo{
+			Name:   col,
+			Dtype:  s.Dtype(),
+			Memory: estimateMemory(s),
+		}
+		for j := 0; j < s.Len(); j++ {
+			if val, ok := s.Get(j); ok && val != nil {
+				colInfo.NonNull++
+			}
+		}
+		colInfo.Null = s.Len() - colInfo.NonNull
+
+		info.Columns[i] = colInfo
+		info.Memory += colInfo.Memory
+	}
+
+	return info
+}
+
+// String formats the summary like pandas' DataFrame.info().
+func (fi *FrameInfo) String() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Rows: %d\n", fi.Rows)
+	fmt.Fprintf(&sb, "Columns: %d\n", fi.Cols)
+
+	width := len("Column")
+	for _, col := range fi.Columns {
+		if len(col.Name) > width {
+			width = len(col.Name)
+		}
+	}
+
+	fmt.Fprintf(&sb, " #  %-*s  %-8s  %8s  %8s\n", width, "Column", "Dtype", "Non-Null", "Null")
+	for i, col := range fi.Columns {
+		fmt.Fprintf(&sb, "%2d  %-*s  %-8s  %8d  %8d\n", i, width, col.Name, col.Dtype, col.NonNull, col.Null)
+	}
+	fmt.Fprintf(&sb, "Memory usage: %s\n", formatBytes(fi.Memory))
+
+	return sb.String()
+}
+
+// estimateMemory estimates the bytes held by a Series' elements.
+// Categorical elements share their category's value, so a categorical
+// Series is counted as an int32 code per element plus its dictionary.
+func estimateMemory(s *series.Series[any]) int64 {
+	n := s.Len()
+	total := int64(n) * interfaceSize
+
+	// Null mask, one bit per element
+	if s.HasNulls() {
+		total += int64((n + 63) / 64 * 8)
+	}
+
+	if s.IsCategorical() {
+		total += int64(n) * 4
+		for _, val := range s.Categories() {
+			total += valueMemory(val)
+		}
+		return total
+	}
+
+	for i := 0; i < n; i++ {
+		val, ok := s.Get(i)
+		if !ok || val == nil {
+			continue
+		}
+		total += valueMemory(val)
+	}
+
+	return total
+}
+
+// valueMemory estimates the bytes a non-nil element holds beyond its
+// interface value.
+func valueMemory(val any) int64 {
+	switch v := val.(type) {
+	case string:
+		return 16 + int64(len(v))
+	case time.Time:
+		return 24
+	case bool, int8, uint8:
+		return 1
+	case int16, uint16:
+		return 2
+	case int32, uint32, float32:
+		return 4
+	default:
+		return 8
+	}
+}
+
+// OptimizeMemory returns a DataFrame in which every string column whose
+// ratio of distinct values to non-null values is below threshold, such as
+// 0.5, is dictionary encoded as a categorical column, so each distinct
+// string is stored once. Other columns are shared with df unchanged;
+// numeric columns are not downcast, as every column stores its values
+// boxed. Compare Info().Memory before and after to see the saving.
+func (df *DataFrame) OptimizeMemory(threshold float64) *DataFrame {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	newSeries := make(map[string]*series.Series[any], len(df.series))
+	for _, col := range df.columns {
+		s := df.series[col]
+		newSeries[col] = s
+		if s.Dtype() != core.DtypeString || s.IsCategorical() {
+			continue
+		}
+
+		distinct := make(map[string]struct{})
+		nonNull := 0
+		for i := 0; i < s.Len(); i++ {
+			if val, ok := s.Get(i); ok && val != nil {
+				distinct[fmt.Sprintf("%v", val)] = struct{}{}
+				nonNull++
+			}
+		}
+		if nonNull > 0 && float64(len(distinct))/float64(nonNull) < threshold {
+			newSeries[col] = s.AsCategorical()
+		}
+	}
+
+	return &DataFrame{
+		columns: df.columns,
+		series:  newSeries,
+		index:   df.index,
+		nrows:   df.nrows,
+	}
+}
+
+// formatBytes formats a byte count with a binary unit suffix.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
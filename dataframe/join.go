@@ -2,9 +2,10 @@ package dataframe
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/internal/bitset"
+	"github.com/TIVerse/GopherData/series"
 )
 
 // Join types
@@ -21,6 +22,13 @@ type JoinOptions struct {
 	suffixLeft  string
 	suffixRight string
 	indicator   string
+	validate    string
+
+	// uniqueLeft and uniqueRight require unique keys on that side; swapped
+	// is set when the sides were exchanged to find a right join's rows.
+	uniqueLeft  bool
+	uniqueRight bool
+	swapped     bool
 }
 
 // JoinOption is a functional option for joins.
@@ -41,6 +49,19 @@ func WithIndicator(colName string) JoinOption {
 	}
 }
 
+// Validate checks the relationship between the keys of the two sides while
+// joining: "1:1" requires unique keys on both sides, "1:m" on the left and
+// "m:1" on the right, while "m:m" checks nothing. The pandas spellings
+// "one_to_one", "one_to_many", "many_to_one" and "many_to_many" are also
+// accepted. A duplicate key fails the join with an error wrapping
+// core.ErrConstraintViolation. Null keys never match, so they are not
+// checked, and neither are cross joins, which have no keys.
+func Validate(relationship string) JoinOption {
+	return func(opts *JoinOptions) {
+		opts.validate = relationship
+	}
+}
+
 // Join performs a join operation on a single column.
 func (df *DataFrame) Join(other *DataFrame, joinType, onCol string, opts ...JoinOption) (*DataFrame, error) {
 	return df.Merge(other, joinType, []string{onCol}, []string{onCol}, opts...)
@@ -88,6 +109,18 @@ func (df *DataFrame) Merge(other *DataFrame, joinType string, leftOn, rightOn []
 	for _, opt := range opts {
 		opt(joinOpts)
 	}
+	switch joinOpts.validate {
+	case "", "m:m", "many_to_many":
+	case "1:1", "one_to_one":
+		joinOpts.uniqueLeft, joinOpts.uniqueRight = true, true
+	case "1:m", "one_to_many":
+		joinOpts.uniqueLeft = true
+	case "m:1", "many_to_one":
+		joinOpts.uniqueRight = true
+	default:
+		return nil, fmt.Errorf("validate %q must be '1:1', '1:m', 'm:1' or 'm:m': %w",
+			joinOpts.validate, core.ErrInvalidArgument)
+	}
 
 	// Perform join based on type
 	switch joinType {
@@ -109,7 +142,11 @@ func (df *DataFrame) Merge(other *DataFrame, joinType string, leftOn, rightOn []
 // hashJoinInner performs an inner join using hash join algorithm.
 func hashJoinInner(left, right *DataFrame, leftOn, rightOn []string, opts *JoinOptions) (*DataFrame, error) {
 	// Build hash table on right (smaller table ideally)
-	rightHash := buildHashTable(right, rightOn)
+	leftCodes, rightCodes := joinKeyCodes(left, right, leftOn, rightOn)
+	rightHash, err := buildJoinHashTable(left, right, leftOn, rightOn, leftCodes, rightCodes, opts)
+	if err != nil {
+		return nil, err
+	}
 
 	// Probe with left table
 	var matchedLeftRows []int
@@ -124,14 +161,14 @@ func hashJoinInner(left, right *DataFrame, leftOn, rightOn []string, opts *JoinO
 			continue
 		}
 
-		keyHash := hashJoinKey(leftKey)
+		keyHash := encodeRowKey(leftKey, leftCodes, i)
 
 		// Find matches in right table
 		if rightRows, exists := rightHash[keyHash]; exists {
 			for _, rightIdx := range rightRows {
 				// Verify key equality (handle hash collisions)
 				rightKey := extractKey(right, rightIdx, rightOn)
-				if keysEqual(leftKey, rightKey) {
+				if keysEqual(leftKey, rightKey, leftCodes, rightCodes, i, rightIdx) {
 					matchedLeftRows = append(matchedLeftRows, i)
 					matchedRightRows = append(matchedRightRows, rightIdx)
 				}
@@ -140,13 +177,27 @@ func hashJoinInner(left, right *DataFrame, leftOn, rightOn []string, opts *JoinO
 	}
 
 	// Build result DataFrame
-	return buildJoinResult(left, right, matchedLeftRows, matchedRightRows, leftOn, opts)
+	return buildJoinResult(left, right, matchedLeftRows, matchedRightRows, leftOn, rightOn, opts)
 }
 
 // hashJoinLeft performs a left join.
 func hashJoinLeft(left, right *DataFrame, leftOn, rightOn []string, opts *JoinOptions) (*DataFrame, error) {
+	matchedLeftRows, matchedRightRows, err := leftJoinRows(left, right, leftOn, rightOn, opts)
+	if err != nil {
+		return nil, err
+	}
+	return buildJoinResult(left, right, matchedLeftRows, matchedRightRows, leftOn, rightOn, opts)
+}
+
+// leftJoinRows returns the rows of a left join: every left row, paired with
+// each matching right row, or with -1 if none matches.
+func leftJoinRows(left, right *DataFrame, leftOn, rightOn []string, opts *JoinOptions) ([]int, []int, error) {
 	// Build hash table on right
-	rightHash := buildHashTable(right, rightOn)
+	leftCodes, rightCodes := joinKeyCodes(left, right, leftOn, rightOn)
+	rightHash, err := buildJoinHashTable(left, right, leftOn, rightOn, leftCodes, rightCodes, opts)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	var matchedLeftRows []int
 	var matchedRightRows []int
@@ -161,13 +212,13 @@ func hashJoinLeft(left, right *DataFrame, leftOn, rightOn []string, opts *JoinOp
 			continue
 		}
 
-		keyHash := hashJoinKey(leftKey)
+		keyHash := encodeRowKey(leftKey, leftCodes, i)
 		
 		if rightRows, exists := rightHash[keyHash]; exists {
 			matched := false
 			for _, rightIdx := range rightRows {
 				rightKey := extractKey(right, rightIdx, rightOn)
-				if keysEqual(leftKey, rightKey) {
+				if keysEqual(leftKey, rightKey, leftCodes, rightCodes, i, rightIdx) {
 					matchedLeftRows = append(matchedLeftRows, i)
 					matchedRightRows = append(matchedRightRows, rightIdx)
 					matched = true
@@ -185,25 +236,31 @@ func hashJoinLeft(left, right *DataFrame, leftOn, rightOn []string, opts *JoinOp
 		}
 	}
 
-	return buildJoinResult(left, right, matchedLeftRows, matchedRightRows, leftOn, opts)
+	return matchedLeftRows, matchedRightRows, nil
 }
 
-// hashJoinRight performs a right join.
+// hashJoinRight performs a right join. Its rows are those of a left join
+// with the tables swapped, but its columns are in the usual order: left
+// columns, then right non-key columns.
 func hashJoinRight(left, right *DataFrame, leftOn, rightOn []string, opts *JoinOptions) (*DataFrame, error) {
-	// Right join is left join with tables swapped
-	result, err := hashJoinLeft(right, left, rightOn, leftOn, opts)
+	swapped := *opts
+	swapped.uniqueLeft, swapped.uniqueRight = opts.uniqueRight, opts.uniqueLeft
+	swapped.swapped = !opts.swapped
+	matchedRightRows, matchedLeftRows, err := leftJoinRows(right, left, rightOn, leftOn, &swapped)
 	if err != nil {
 		return nil, err
 	}
-	
-	// Reorder columns to match expected output (left columns first)
-	return result, nil
+	return buildJoinResult(left, right, matchedLeftRows, matchedRightRows, leftOn, rightOn, opts)
 }
 
 // hashJoinOuter performs a full outer join.
 func hashJoinOuter(left, right *DataFrame, leftOn, rightOn []string, opts *JoinOptions) (*DataFrame, error) {
 	// Build hash tables for both sides
-	rightHash := buildHashTable(right, rightOn)
+	leftCodes, rightCodes := joinKeyCodes(left, right, leftOn, rightOn)
+	rightHash, err := buildJoinHashTable(left, right, leftOn, rightOn, leftCodes, rightCodes, opts)
+	if err != nil {
+		return nil, err
+	}
 	matchedRight := make(map[int]bool)
 
 	var matchedLeftRows []int
@@ -220,13 +277,13 @@ func hashJoinOuter(left, right *DataFrame, leftOn, rightOn []string, opts *JoinO
 			continue
 		}
 
-		keyHash := hashJoinKey(leftKey)
+		keyHash := encodeRowKey(leftKey, leftCodes, i)
 		
 		if rightRows, exists := rightHash[keyHash]; exists {
 			foundMatch := false
 			for _, rightIdx := range rightRows {
 				rightKey := extractKey(right, rightIdx, rightOn)
-				if keysEqual(leftKey, rightKey) {
+				if keysEqual(leftKey, rightKey, leftCodes, rightCodes, i, rightIdx) {
 					matchedLeftRows = append(matchedLeftRows, i)
 					matchedRightRows = append(matchedRightRows, rightIdx)
 					matchedRight[rightIdx] = true
@@ -252,7 +309,7 @@ func hashJoinOuter(left, right *DataFrame, leftOn, rightOn []string, opts *JoinO
 		}
 	}
 
-	return buildJoinResult(left, right, matchedLeftRows, matchedRightRows, leftOn, opts)
+	return buildJoinResult(left, right, matchedLeftRows, matchedRightRows, leftOn, rightOn, opts)
 }
 
 // crossJoin performs a Cartesian product.
@@ -267,7 +324,7 @@ func crossJoin(left, right *DataFrame, opts *JoinOptions) (*DataFrame, error) {
 		}
 	}
 
-	return buildJoinResult(left, right, matchedLeftRows, matchedRightRows, nil, opts)
+	return buildJoinResult(left, right, matchedLeftRows, matchedRightRows, nil, nil, opts)
 }
 
 // Helper functions
@@ -277,7 +334,30 @@ func isValidJoinType(joinType string) bool {
 		joinType == JoinRight || joinType == JoinOuter || joinType == JoinCross
 }
 
-func buildHashTable(df *DataFrame, keyColumns []string) map[string][]int {
+// buildJoinHashTable builds the hash table on the right side of a join,
+// first checking that the keys are unique on each side opts requires.
+func buildJoinHashTable(left, right *DataFrame, leftOn, rightOn []string, leftCodes, rightCodes [][]int32, opts *JoinOptions) (map[string][]int, error) {
+	leftSide, rightSide := "left", "right"
+	if opts.swapped {
+		leftSide, rightSide = rightSide, leftSide
+	}
+	
+	if opts.uniqueLeft {
+		if _, err := buildHashTable(left, leftOn, leftCodes, true); err != nil {
+			return nil, fmt.Errorf("validate %q: %s keys are not unique: %w", opts.validate, leftSide, err)
+		}
+	}
+	rightHash, err := buildHashTable(right, rightOn, rightCodes, opts.uniqueRight)
+	if err != nil {
+		return nil, fmt.Errorf("validate %q: %s keys are not unique: %w", opts.validate, rightSide, err)
+	}
+	return rightHash, nil
+}
+
+// buildHashTable maps each non-null key of df to the rows holding it. With
+// unique set, a key found in a second row is an error wrapping
+// core.ErrConstraintViolation.
+func buildHashTable(df *DataFrame, keyColumns []string, codes [][]int32, unique bool) (map[string][]int, error) {
 	hashTable := make(map[string][]int)
 	
 	for i := 0; i < df.nrows; i++ {
@@ -288,11 +368,14 @@ func buildHashTable(df *DataFrame, keyColumns []string) map[string][]int {
 			continue
 		}
 		
-		keyHash := hashJoinKey(key)
+		keyHash := encodeRowKey(key, codes, i)
+		if rows := hashTable[keyHash]; unique && len(rows) > 0 {
+			return nil, fmt.Errorf("key %v is in rows %d and %d: %w", key, rows[0], i, core.ErrConstraintViolation)
+		}
 		hashTable[keyHash] = append(hashTable[keyHash], i)
 	}
 	
-	return hashTable
+	return hashTable, nil
 }
 
 func extractKey(df *DataFrame, row int, keyColumns []string) []any {
@@ -318,120 +401,167 @@ func hasNullKey(key []any) bool {
 	return false
 }
 
-func hashJoinKey(key []any) string {
-	parts := make([]string, len(key))
-	for i, val := range key {
-		parts[i] = fmt.Sprintf("%v", val)
+// joinKeyCodes returns category codes for key column pairs that are
+// categorical on both sides, with nil entries for all other pairs.
+// Right codes are translated into the left dictionary so that equal values
+// share a code; right values missing from the left dictionary get codes past
+// the end of it, which never match a left code.
+func joinKeyCodes(left, right *DataFrame, leftOn, rightOn []string) ([][]int32, [][]int32) {
+	leftCodes := make([][]int32, len(leftOn))
+	rightCodes := make([][]int32, len(rightOn))
+
+	for j := range leftOn {
+		ls := left.series[leftOn[j]]
+		rs := right.series[rightOn[j]]
+		if !ls.IsCategorical() || !rs.IsCategorical() {
+			continue
+		}
+
+		leftCats := ls.Categories()
+		rightCats := rs.Categories()
+		translate := make([]int32, len(rightCats))
+		for k, cat := range rightCats {
+			if code, ok := ls.CategoryCode(cat); ok {
+				translate[k] = code
+			} else {
+				translate[k] = int32(len(leftCats) + k)
+			}
+		}
+
+		codes := rs.Codes()
+		for i, code := range codes {
+			if code >= 0 {
+				codes[i] = translate[code]
+			}
+		}
+		leftCodes[j] = ls.Codes()
+		rightCodes[j] = codes
 	}
-	return strings.Join(parts, "|")
+
+	return leftCodes, rightCodes
 }
 
-func keysEqual(key1, key2 []any) bool {
+// keysEqual reports whether the keys of left row i and right row j match.
+// Columns with codes compare codes; other columns compare values.
+func keysEqual(key1, key2 []any, codes1, codes2 [][]int32, i, j int) bool {
 	if len(key1) != len(key2) {
 		return false
 	}
-	for i := range key1 {
-		if !valuesEqual(key1[i], key2[i]) {
+	for k := range key1 {
+		if codes1[k] != nil {
+			if codes1[k][i] != codes2[k][j] {
+				return false
+			}
+		} else if !valuesEqual(key1[k], key2[k]) {
 			return false
 		}
 	}
 	return true
 }
 
+// valuesEqual reports whether two key values are equal as encodeKey
+// compares them.
 func valuesEqual(v1, v2 any) bool {
-	if v1 == nil && v2 == nil {
-		return true
-	}
-	if v1 == nil || v2 == nil {
-		return false
-	}
-	return fmt.Sprintf("%v", v1) == fmt.Sprintf("%v", v2)
+	return encodeKey([]any{v1}) == encodeKey([]any{v2})
 }
 
-func buildJoinResult(left, right *DataFrame, leftRows, rightRows []int, keyColumns []string, opts *JoinOptions) (*DataFrame, error) {
+// buildJoinResult assembles the joined DataFrame from pairs of matched rows,
+// where -1 marks a row missing on that side. The columns are the left
+// columns followed by the right non-key columns, with overlapping names
+// suffixed, and the indicator if requested. A key column shared by both
+// sides takes its value from the right on rows that have no left row, and
+// missing values are null.
+func buildJoinResult(left, right *DataFrame, leftRows, rightRows []int, leftOn, rightOn []string, opts *JoinOptions) (*DataFrame, error) {
 	nrows := len(leftRows)
-	resultData := make(map[string]any)
+	var columns []string
+	seriesMap := make(map[string]*series.Series[any])
+
+	// addColumn gathers a column whose row i comes from row rows[i] of s,
+	// or from row fallbackRows[i] of fallback when rows[i] is -1.
+	addColumn := func(name string, s *series.Series[any], rows []int, fallback *series.Series[any], fallbackRows []int) error {
+		if _, exists := seriesMap[name]; exists {
+			return fmt.Errorf("column %q: %w", name, core.ErrDuplicateColumn)
+		}
+		data := make([]any, nrows)
+		nulls := bitset.New(nrows)
+		for i, row := range rows {
+			src := s
+			if row < 0 && fallback != nil {
+				src, row = fallback, fallbackRows[i]
+			}
+			var val any
+			if row >= 0 {
+				val, _ = src.Get(row)
+			}
+			if val == nil {
+				nulls.Set(i)
+			}
+			data[i] = val
+		}
+		columns = append(columns, name)
+		seriesMap[name] = series.NewWithNulls(name, data, inferDtype(data), nulls)
+		return nil
+	}
 
-	// Determine column names and handle overlaps
-	leftCols := left.columns
-	rightCols := right.columns
-	
-	// Find overlapping columns (excluding join keys)
-	keySet := make(map[string]bool)
-	for _, key := range keyColumns {
-		keySet[key] = true
+	// A key with the same name on both sides becomes a single column; keys
+	// joined under different names are kept as ordinary columns.
+	sharedKeys := make(map[string]bool, len(leftOn))
+	for k, key := range leftOn {
+		if rightOn[k] == key {
+			sharedKeys[key] = true
+		}
 	}
 	
+	// Find overlapping columns (excluding join keys)
 	overlapCols := make(map[string]bool)
-	for _, rcol := range rightCols {
-		if keySet[rcol] {
+	for _, rcol := range right.columns {
+		if sharedKeys[rcol] {
 			continue // Skip join keys
 		}
-		for _, lcol := range leftCols {
-			if lcol == rcol {
-				overlapCols[rcol] = true
-				break
-			}
+		if _, exists := left.series[rcol]; exists {
+			overlapCols[rcol] = true
 		}
 	}
 
-	// Add left columns
-	for _, col := range leftCols {
-		data := make([]any, nrows)
-		s := left.series[col]
-		
-		for i, leftIdx := range leftRows {
-			if leftIdx >= 0 {
-				val, ok := s.Get(leftIdx)
-				if ok {
-					data[i] = val
-				} else {
-					data[i] = nil
-				}
-			} else {
-				data[i] = nil
-			}
-		}
-		
+	// Add left columns, filling keys from the right for right-only rows
+	for _, col := range left.columns {
 		colName := col
-		if overlapCols[col] && !keySet[col] {
+		if overlapCols[col] {
 			colName = col + opts.suffixLeft
 		}
-		resultData[colName] = data
+		
+		var err error
+		if sharedKeys[col] {
+			err = addColumn(colName, left.series[col], leftRows, right.series[col], rightRows)
+		} else {
+			err = addColumn(colName, left.series[col], leftRows, nil, nil)
+		}
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Add right columns
-	for _, col := range rightCols {
+	for _, col := range right.columns {
 		// Skip join key columns (already added from left)
-		if keySet[col] {
+		if sharedKeys[col] {
 			continue
 		}
 		
-		data := make([]any, nrows)
-		s := right.series[col]
-		
-		for i, rightIdx := range rightRows {
-			if rightIdx >= 0 {
-				val, ok := s.Get(rightIdx)
-				if ok {
-					data[i] = val
-				} else {
-					data[i] = nil
-				}
-			} else {
-				data[i] = nil
-			}
-		}
-		
 		colName := col
 		if overlapCols[col] {
 			colName = col + opts.suffixRight
 		}
-		resultData[colName] = data
+		if err := addColumn(colName, right.series[col], rightRows, nil, nil); err != nil {
+			return nil, err
+		}
 	}
 
 	// Add indicator column if requested
 	if opts.indicator != "" {
+		if _, exists := seriesMap[opts.indicator]; exists {
+			return nil, fmt.Errorf("indicator column %q: %w", opts.indicator, core.ErrDuplicateColumn)
+		}
 		indicator := make([]string, nrows)
 		for i := range leftRows {
 			if leftRows[i] >= 0 && rightRows[i] >= 0 {
@@ -442,8 +572,14 @@ func buildJoinResult(left, right *DataFrame, leftRows, rightRows []int, keyColum
 				indicator[i] = "right_only"
 			}
 		}
-		resultData[opts.indicator] = indicator
+		columns = append(columns, opts.indicator)
+		seriesMap[opts.indicator] = convertToAnySeries(opts.indicator, indicator, core.DtypeString)
 	}
 
-	return New(resultData)
+	return &DataFrame{
+		columns: columns,
+		series:  seriesMap,
+		index:   NewRangeIndex(0, nrows, 1),
+		nrows:   nrows,
+	}, nil
 }
@@ -0,0 +1,211 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func TestMergeValidate(t *testing.T) {
+	unique, _ := New(map[string]any{
+		"k": []string{"a", "b", "c"},
+		"x": []int64{1, 2, 3},
+	})
+	dup, _ := New(map[string]any{
+		"k": []string{"a", "b", "a"},
+		"y": []int64{10, 20, 30},
+	})
+
+	cases := []struct {
+		validate    string
+		left, right *DataFrame
+		wantErr     bool
+	}{
+		{"1:1", unique, unique, false},
+		{"1:1", unique, dup, true},
+		{"1:1", dup, unique, true},
+		{"one_to_one", unique, dup, true},
+		{"1:m", unique, dup, false},
+		{"1:m", dup, unique, true},
+		{"m:1", dup, unique, false},
+		{"m:1", unique, dup, true},
+		{"many_to_one", unique, dup, true},
+		{"m:m", dup, dup, false},
+	}
+	for _, c := range cases {
+		for _, how := range []string{JoinInner, JoinLeft, JoinRight, JoinOuter} {
+			_, err := c.left.Join(c.right, how, "k", Validate(c.validate))
+			if c.wantErr && !errors.Is(err, core.ErrConstraintViolation) {
+				t.Errorf("%s join, validate %q: expected ErrConstraintViolation, got %v", how, c.validate, err)
+			}
+			if !c.wantErr && err != nil {
+				t.Errorf("%s join, validate %q: unexpected error %v", how, c.validate, err)
+			}
+		}
+	}
+
+	// Null keys never match, so repeated nulls are not duplicates.
+	nulls, _ := New(map[string]any{
+		"k": []any{"a", nil, nil},
+		"y": []int64{1, 2, 3},
+	})
+	nk, _ := nulls.Column("k")
+	nk.SetNull(1)
+	nk.SetNull(2)
+	if _, err := unique.Join(nulls, JoinLeft, "k", Validate("1:1")); err != nil {
+		t.Errorf("null keys should not count as duplicates, got %v", err)
+	}
+
+	if _, err := unique.Join(dup, JoinInner, "k", Validate("1:n")); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument, got %v", err)
+	}
+}
+
+func TestOuterJoinNullKeys(t *testing.T) {
+	left, _ := New(map[string]any{
+		"k": []any{"a", nil, "b"},
+		"x": []int64{1, 2, 3},
+	})
+	right, _ := New(map[string]any{
+		"k": []any{"a", nil, "c"},
+		"y": []int64{10, 20, 30},
+	})
+	left, right = left.Select("k", "x"), right.Select("k", "y")
+	lk, _ := left.Column("k")
+	lk.SetNull(1)
+	rk, _ := right.Column("k")
+	rk.SetNull(1)
+
+	result, err := left.Join(right, JoinOuter, "k", WithIndicator("_merge"))
+	if err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+
+	// "a" matches; the null keys match nothing, so each side keeps its own
+	// row, as do "b" and "c".
+	want := map[string][]any{
+		"k":      {"a", nil, "b", nil, "c"},
+		"x":      {int64(1), int64(2), int64(3), nil, nil},
+		"y":      {int64(10), nil, nil, int64(20), int64(30)},
+		"_merge": {"both", "left_only", "left_only", "right_only", "right_only"},
+	}
+	if result.Nrows() != 5 {
+		t.Fatalf("expected 5 rows, got %d", result.Nrows())
+	}
+	for col, values := range want {
+		s, err := result.Column(col)
+		if err != nil {
+			t.Fatalf("column %q: %v", col, err)
+		}
+		for i, w := range values {
+			got, _ := s.Get(i)
+			if got != w {
+				t.Errorf("%s[%d] = %v, want %v", col, i, got, w)
+			}
+			if s.IsNull(i) != (w == nil) {
+				t.Errorf("%s[%d]: IsNull = %v, want %v", col, i, s.IsNull(i), w == nil)
+			}
+		}
+	}
+}
+
+func TestJoinIndicatorAndOverlap(t *testing.T) {
+	left, _ := New(map[string]any{
+		"k": []string{"a", "b"},
+		"v": []int64{1, 2},
+	})
+	right, _ := New(map[string]any{
+		"k": []string{"a", "c", "d"},
+		"v": []int64{10, 30, 40},
+	})
+	left, right = left.Select("k", "v"), right.Select("k", "v")
+
+	result, err := left.Join(right, JoinInner, "k")
+	if err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+	cols := result.Columns()
+	if len(cols) != 3 || cols[0] != "k" || cols[1] != "v_left" || cols[2] != "v_right" {
+		t.Errorf("expected columns [k v_left v_right], got %v", cols)
+	}
+
+	result, err = left.Join(right, JoinInner, "k", WithSuffixes("_l", "_r"))
+	if err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+	vl, _ := result.Column("v_l")
+	vr, _ := result.Column("v_r")
+	if got, _ := vl.Get(0); got != int64(1) {
+		t.Errorf("v_l[0] = %v, want 1", got)
+	}
+	if got, _ := vr.Get(0); got != int64(10) {
+		t.Errorf("v_r[0] = %v, want 10", got)
+	}
+
+	// In a right join, rows only in the right DataFrame are right_only,
+	// its columns still take the right suffix, and left columns come first.
+	result, err = left.Join(right, JoinRight, "k", WithIndicator("_merge"))
+	if err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+	if cols := result.Columns(); fmt.Sprint(cols) != "[k v_left v_right _merge]" {
+		t.Errorf("expected columns [k v_left v_right _merge], got %v", cols)
+	}
+	keys, _ := result.Column("k")
+	for i, want := range []string{"a", "c", "d"} {
+		if got, _ := keys.Get(i); got != want {
+			t.Errorf("k[%d] = %v, want %s", i, got, want)
+		}
+	}
+	indicator, _ := result.Column("_merge")
+	vRight, _ := result.Column("v_right")
+	for i, want := range []string{"both", "right_only", "right_only"} {
+		if got, _ := indicator.Get(i); got != want {
+			t.Errorf("_merge[%d] = %v, want %s", i, got, want)
+		}
+	}
+	for i, want := range []int64{10, 30, 40} {
+		if got, _ := vRight.Get(i); got != want {
+			t.Errorf("v_right[%d] = %v, want %d", i, got, want)
+		}
+	}
+
+	if _, err := left.Join(right, JoinInner, "k", WithIndicator("v_left")); !errors.Is(err, core.ErrDuplicateColumn) {
+		t.Errorf("expected ErrDuplicateColumn for a clashing indicator, got %v", err)
+	}
+}
+
+func TestJoinIntFloatKeys(t *testing.T) {
+	ints, _ := New(map[string]any{
+		"k": []int64{1, 10000000, 1 << 62},
+		"x": []string{"one", "ten million", "big"},
+	})
+	floats, _ := New(map[string]any{
+		"k": []float64{1, 1e7, 1 << 62, 2.5},
+		"y": []string{"one", "ten million", "big", "other"},
+	})
+
+	result, err := ints.Join(floats, JoinInner, "k")
+	if err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+	if result.Nrows() != 3 {
+		t.Fatalf("expected 3 matching rows, got %d:\n%v", result.Nrows(), result)
+	}
+	x, _ := result.Column("x")
+	y, _ := result.Column("y")
+	for i := 0; i < result.Nrows(); i++ {
+		if xv, yv := x.GetUnsafe(i), y.GetUnsafe(i); xv != yv {
+			t.Errorf("row %d joined %v with %v", i, xv, yv)
+		}
+	}
+
+	if encodeKey([]any{int64(10000000)}) != encodeKey([]any{1e7}) {
+		t.Error("Expected int64(10000000) and float64(1e7) to produce the same key")
+	}
+	if encodeKey([]any{uint64(1e19)}) != encodeKey([]any{1e19}) {
+		t.Error("Expected uint64(1e19) and float64(1e19) to produce the same key")
+	}
+}
@@ -0,0 +1,112 @@
+package dataframe
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/TIVerse/GopherData/series"
+)
+
+// encodeKey encodes a composite key as a string that is unique per value tuple.
+// Each value is written as a tag for its kind of value, its length, ':' and
+// its text, and nulls are written as "-:". Because every value carries its
+// own length, no value content can be mistaken for a boundary between values,
+// so ("a|b") and ("a", "b") always produce different keys. The tag keeps
+// values of different kinds apart, so int64(1) and "1" differ, while integers
+// and floats share one tag and one text for equal values, so that int64(1)
+// matches float64(1) as in joins between Int64 and Float64 columns.
+func encodeKey(values []any) string {
+	var sb strings.Builder
+	for _, val := range values {
+		if val == nil {
+			sb.WriteString("-:")
+			continue
+		}
+		tag, text := keyText(val)
+		sb.WriteString(tag)
+		sb.WriteString(strconv.Itoa(len(text)))
+		sb.WriteByte(':')
+		sb.WriteString(text)
+	}
+	return sb.String()
+}
+
+// keyText returns the tag and text encodeKey writes for a non-null value.
+// The tag is "n" for numbers, "s" for strings, "b" for booleans and the Go
+// type otherwise. Numbers are written in decimal, with whole floats written
+// as integers so that they match integers of the same value.
+func keyText(val any) (string, string) {
+	switch v := val.(type) {
+	case int:
+		return "n", strconv.FormatInt(int64(v), 10)
+	case int8:
+		return "n", strconv.FormatInt(int64(v), 10)
+	case int16:
+		return "n", strconv.FormatInt(int64(v), 10)
+	case int32:
+		return "n", strconv.FormatInt(int64(v), 10)
+	case int64:
+		return "n", strconv.FormatInt(v, 10)
+	case uint:
+		return "n", strconv.FormatUint(uint64(v), 10)
+	case uint8:
+		return "n", strconv.FormatUint(uint64(v), 10)
+	case uint16:
+		return "n", strconv.FormatUint(uint64(v), 10)
+	case uint32:
+		return "n", strconv.FormatUint(uint64(v), 10)
+	case uint64:
+		return "n", strconv.FormatUint(v, 10)
+	case float32:
+		return "n", keyFloat(float64(v))
+	case float64:
+		return "n", keyFloat(v)
+	case string:
+		return "s", v
+	case bool:
+		return "b", strconv.FormatBool(v)
+	default:
+		return fmt.Sprintf("%T", val), fmt.Sprintf("%v", val)
+	}
+}
+
+// keyFloat formats f for keyText: as an integer if it is whole and fits an
+// int64 or uint64, and in the shortest 'g' form otherwise.
+func keyFloat(f float64) string {
+	if f == math.Trunc(f) {
+		switch {
+		case f >= math.MinInt64 && f < math.MaxInt64:
+			return strconv.FormatInt(int64(f), 10)
+		case f >= 0 && f < math.MaxUint64:
+			return strconv.FormatUint(uint64(f), 10)
+		}
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// keyCodes returns the category codes of each categorical key column, with
+// nil entries for columns that are not categorical.
+func keyCodes(keySeries []*series.Series[any]) [][]int32 {
+	codes := make([][]int32, len(keySeries))
+	for j, s := range keySeries {
+		codes[j] = s.Codes()
+	}
+	return codes
+}
+
+// encodeRowKey encodes the key of row like encodeKey, but uses the category
+// code instead of the value for columns with codes. Codes identify values
+// exactly, so categorical keys are compared without formatting their values.
+func encodeRowKey(values []any, codes [][]int32, row int) string {
+	parts := make([]any, len(values))
+	for j, val := range values {
+		if codes[j] == nil {
+			parts[j] = val
+		} else if code := codes[j][row]; code >= 0 {
+			parts[j] = code
+		}
+	}
+	return encodeKey(parts)
+}
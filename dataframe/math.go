@@ -0,0 +1,154 @@
+package dataframe
+
+import (
+	"math"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+// Round returns a new DataFrame with float64 columns rounded to the given
+// number of decimal places. If cols is empty, every float64 column is
+// rounded. Other columns, nulls and columns that do not exist are left
+// untouched. A negative decimals rounds to the left of the decimal point.
+//
+// Halves round away from zero (2.5 to 3, -2.5 to -3). As the scaling is done
+// in binary floating point, a value such as 2.675, stored as
+// 2.67499999..., rounds to 2.67.
+func (df *DataFrame) Round(decimals int, cols ...string) *DataFrame {
+	if len(cols) == 0 {
+		df.mu.RLock()
+		cols = df.columnsOfDtypes([]core.Dtype{core.DtypeFloat64}, nil)
+		df.mu.RUnlock()
+	}
+
+	perColumn := make(map[string]int, len(cols))
+	for _, col := range cols {
+		perColumn[col] = decimals
+	}
+	return df.RoundColumns(perColumn)
+}
+
+// RoundColumns is like Round with a number of decimal places per column.
+func (df *DataFrame) RoundColumns(decimals map[string]int) *DataFrame {
+	result := df
+	for _, col := range df.Columns() {
+		d, ok := decimals[col]
+		if !ok {
+			continue
+		}
+		s, _ := df.Column(col)
+		if s.Dtype() != core.DtypeFloat64 {
+			continue
+		}
+
+		scale := math.Pow(10, float64(d))
+		result = result.ApplyColumn(col, func(val any) any {
+			f, _ := core.ToFloat64(val)
+			return math.Round(f*scale) / scale
+		})
+	}
+
+	if result == df {
+		return df.Copy()
+	}
+	return result
+}
+
+// Abs returns a new DataFrame with the absolute value of each numeric
+// column in cols, or of every numeric column if cols is empty. int64
+// columns stay int64. Nulls, non-numeric columns and columns that do not
+// exist are left untouched.
+func (df *DataFrame) Abs(cols ...string) *DataFrame {
+	return df.mapNumeric(cols, func(val any) any {
+		if i, ok := val.(int64); ok {
+			if i < 0 {
+				return -i
+			}
+			return i
+		}
+		f, _ := core.ToFloat64(val)
+		return math.Abs(f)
+	})
+}
+
+// Log returns a new DataFrame with the natural logarithm of each numeric
+// column in cols, or of every numeric column if cols is empty.
+//
+// Log, Log1p, Sqrt and Exp produce float64 columns. A result that is not a
+// finite number (the log of zero or a negative value, the square root of a
+// negative value, or an overflowing exponential) is stored as null rather
+// than NaN or ±Inf, so aggregations skip it like any other missing value.
+// Nulls, non-numeric columns and columns that do not exist are left
+// untouched.
+func (df *DataFrame) Log(cols ...string) *DataFrame {
+	return df.mapFloat(cols, math.Log)
+}
+
+// Log1p returns a new DataFrame with log(1+x) of each numeric column,
+// which is accurate for x near zero. See Log for the null policy.
+func (df *DataFrame) Log1p(cols ...string) *DataFrame {
+	return df.mapFloat(cols, math.Log1p)
+}
+
+// Sqrt returns a new DataFrame with the square root of each numeric column.
+// See Log for the null policy.
+func (df *DataFrame) Sqrt(cols ...string) *DataFrame {
+	return df.mapFloat(cols, math.Sqrt)
+}
+
+// Exp returns a new DataFrame with e raised to each value of each numeric
+// column. See Log for the null policy.
+func (df *DataFrame) Exp(cols ...string) *DataFrame {
+	return df.mapFloat(cols, math.Exp)
+}
+
+// mapFloat applies fn to numeric columns as float64, storing non-finite
+// results as null.
+func (df *DataFrame) mapFloat(cols []string, fn func(float64) float64) *DataFrame {
+	return df.mapNumeric(cols, func(val any) any {
+		f, _ := core.ToFloat64(val)
+		result := fn(f)
+		if math.IsNaN(result) || math.IsInf(result, 0) {
+			return nil
+		}
+		return result
+	})
+}
+
+// mapNumeric applies fn with MapColumns to the numeric columns among cols,
+// or to every numeric column if cols is empty.
+func (df *DataFrame) mapNumeric(cols []string, fn func(any) any) *DataFrame {
+	df.mu.RLock()
+	numeric := df.columnsOfDtypes(core.NumericDtypes(), nil)
+	df.mu.RUnlock()
+
+	if len(cols) > 0 {
+		numeric = intersectColumns(numeric, cols)
+		if len(numeric) == 0 {
+			return df.Copy()
+		}
+	}
+
+	result, err := df.MapColumns(numeric, fn)
+	if err != nil {
+		return df.Copy() // Columns were checked above
+	}
+	return result
+}
+
+// intersectColumns returns the columns of cols that are also in wanted, in
+// the order of cols.
+func intersectColumns(cols, wanted []string) []string {
+	keep := make(map[string]bool, len(wanted))
+	for _, col := range wanted {
+		keep[col] = true
+	}
+
+	result := make([]string, 0, len(cols))
+	for _, col := range cols {
+		if keep[col] {
+			result = append(result, col)
+		}
+	}
+	return result
+}
@@ -0,0 +1,134 @@
+package dataframe
+
+import (
+	"math"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func TestRound(t *testing.T) {
+	df, _ := New(map[string]any{
+		"price": []float64{1.005, 2.4449, -3.125, 10},
+		"ratio": []float64{0.33333, 0.5, 0.66666, 1},
+		"qty":   []int64{1, 2, 3, 4},
+		"name":  []string{"a", "b", "c", "d"},
+	})
+	price, _ := df.Column("price")
+	price.SetNull(3)
+
+	rounded := df.Round(2)
+
+	tests := []struct {
+		col  string
+		want []any
+	}{
+		{"price", []any{1.0, 2.44, -3.13, nil}},
+		{"ratio", []any{0.33, 0.5, 0.67, 1.0}},
+		{"qty", []any{int64(1), int64(2), int64(3), int64(4)}},
+		{"name", []any{"a", "b", "c", "d"}},
+	}
+	for _, tt := range tests {
+		s, _ := rounded.Column(tt.col)
+		for i, want := range tt.want {
+			got, ok := s.Get(i)
+			if want == nil {
+				if ok {
+					t.Errorf("%s[%d] = %v, want null", tt.col, i, got)
+				}
+				continue
+			}
+			if got != want {
+				t.Errorf("%s[%d] = %v, want %v", tt.col, i, got, want)
+			}
+		}
+	}
+
+	// The source frame is unchanged
+	if val, _ := df.Column("ratio"); val.GetUnsafe(0) != 0.33333 {
+		t.Errorf("source ratio[0] = %v, want 0.33333", val.GetUnsafe(0))
+	}
+}
+
+func TestRoundColumns(t *testing.T) {
+	df, _ := New(map[string]any{
+		"a": []float64{1.2345, 1250},
+		"b": []float64{1.2345, 1250},
+	})
+
+	rounded := df.RoundColumns(map[string]int{"a": 1, "b": -2, "missing": 3})
+
+	a, _ := rounded.Column("a")
+	b, _ := rounded.Column("b")
+	if got := a.GetUnsafe(0); got != 1.2 {
+		t.Errorf("a[0] = %v, want 1.2", got)
+	}
+	if got := b.GetUnsafe(1); got != 1300.0 {
+		t.Errorf("b[1] = %v, want 1300 (half away from zero)", got)
+	}
+	if rounded.Ncols() != 2 {
+		t.Errorf("got %d columns, want 2", rounded.Ncols())
+	}
+}
+
+func TestLogNullPolicy(t *testing.T) {
+	df, _ := New(map[string]any{
+		"x":    []float64{math.E, 1, 0, -2, 5},
+		"name": []string{"a", "b", "c", "d", "e"},
+	})
+	x, _ := df.Column("x")
+	x.SetNull(4)
+
+	logged := df.Log()
+
+	s, _ := logged.Column("x")
+	if s.Dtype() != core.DtypeFloat64 {
+		t.Errorf("dtype = %v, want float64", s.Dtype())
+	}
+	if got := s.GetUnsafe(0); got != 1.0 {
+		t.Errorf("log(e) = %v, want 1", got)
+	}
+	if got := s.GetUnsafe(1); got != 0.0 {
+		t.Errorf("log(1) = %v, want 0", got)
+	}
+	for _, i := range []int{2, 3, 4} {
+		if !s.IsNull(i) {
+			t.Errorf("x[%d] = %v, want null for log of a non-positive or null value", i, s.GetUnsafe(i))
+		}
+	}
+	if name, _ := logged.Column("name"); name.GetUnsafe(0) != "a" {
+		t.Error("non-numeric column should be unchanged")
+	}
+}
+
+func TestSqrtAndAbs(t *testing.T) {
+	df, _ := New(map[string]any{
+		"a": []int64{4, -9, 16},
+		"b": []float64{2.25, -1, 0},
+	})
+	df = df.Select("a", "b")
+
+	roots := df.Sqrt("b")
+	b, _ := roots.Column("b")
+	if got := b.GetUnsafe(0); got != 1.5 {
+		t.Errorf("sqrt(2.25) = %v, want 1.5", got)
+	}
+	if !b.IsNull(1) {
+		t.Error("sqrt(-1) should be null")
+	}
+	if got := b.GetUnsafe(2); got != 0.0 {
+		t.Errorf("sqrt(0) = %v, want 0", got)
+	}
+	if a, _ := roots.Column("a"); a.GetUnsafe(1) != int64(-9) {
+		t.Error("column a was not requested and should be unchanged")
+	}
+
+	abs := df.Abs()
+	a, _ := abs.Column("a")
+	if a.Dtype() != core.DtypeInt64 || a.GetUnsafe(1) != int64(9) {
+		t.Errorf("abs(a)[1] = %v (%v), want int64 9", a.GetUnsafe(1), a.Dtype())
+	}
+	if b, _ := abs.Column("b"); b.GetUnsafe(1) != 1.0 {
+		t.Errorf("abs(b)[1] = %v, want 1", b.GetUnsafe(1))
+	}
+}
@@ -0,0 +1,79 @@
+package dataframe
+
+import (
+	"fmt"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/series"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ToMatrix returns the given numeric columns as a gonum dense matrix, one
+// row per DataFrame row, along with the column names in matrix order. If
+// cols is empty, every numeric column is used in DataFrame order.
+//
+// It returns an error if a column does not exist, is not numeric or
+// contains a null; impute or drop missing values first. As gonum cannot
+// hold an empty matrix, a DataFrame with no rows or no selected columns is
+// an error too.
+func (df *DataFrame) ToMatrix(cols ...string) (*mat.Dense, []string, error) {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	if len(cols) == 0 {
+		cols = df.columnsOfDtypes(core.NumericDtypes(), nil)
+	} else {
+		cols = append([]string(nil), cols...)
+	}
+	if df.nrows == 0 || len(cols) == 0 {
+		return nil, nil, fmt.Errorf("no numeric data to convert: %w", core.ErrEmptyDataFrame)
+	}
+
+	data := make([]float64, df.nrows*len(cols))
+	for j, col := range cols {
+		s, ok := df.series[col]
+		if !ok {
+			return nil, nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
+		}
+		if !core.IsNumeric(s.Dtype()) {
+			return nil, nil, fmt.Errorf("column %q has dtype %v: %w", col, s.Dtype(), core.ErrTypeMismatch)
+		}
+		for i := 0; i < df.nrows; i++ {
+			val, ok := s.Get(i)
+			if !ok || val == nil {
+				return nil, nil, fmt.Errorf("column %q has null at row %d: %w", col, i, core.ErrNullValue)
+			}
+			data[i*len(cols)+j], _ = core.ToFloat64(val)
+		}
+	}
+
+	return mat.NewDense(df.nrows, len(cols), data), cols, nil
+}
+
+// FromMatrix creates a DataFrame with one float64 column per column of m,
+// named by cols, and a default RangeIndex.
+func FromMatrix(m *mat.Dense, cols []string) (*DataFrame, error) {
+	if m == nil {
+		return nil, fmt.Errorf("matrix is nil: %w", core.ErrInvalidArgument)
+	}
+	nrows, ncols := m.Dims()
+	if len(cols) != ncols {
+		return nil, fmt.Errorf("got %d column names for %d matrix columns: %w",
+			len(cols), ncols, core.ErrInvalidShape)
+	}
+
+	seriesMap := make(map[string]*series.Series[any], ncols)
+	for j, col := range cols {
+		if _, ok := seriesMap[col]; ok {
+			return nil, fmt.Errorf("column %q: %w", col, core.ErrDuplicateColumn)
+		}
+		seriesMap[col] = convertToAnySeries(col, mat.Col(nil, j, m), core.DtypeFloat64)
+	}
+
+	return &DataFrame{
+		columns: append([]string(nil), cols...),
+		series:  seriesMap,
+		index:   NewRangeIndex(0, nrows, 1),
+		nrows:   nrows,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package dataframe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestToMatrixFromMatrixRoundTrip(t *testing.T) {
+	df, _ := New(map[string]any{
+		"a":    []float64{1.5, 2.5, 3.5},
+		"b":    []int64{4, 5, 6},
+		"name": []string{"x", "y", "z"},
+	})
+	df = df.Select("a", "name", "b")
+
+	m, cols, err := df.ToMatrix()
+	if err != nil {
+		t.Fatalf("ToMatrix failed: %v", err)
+	}
+	if len(cols) != 2 || cols[0] != "a" || cols[1] != "b" {
+		t.Fatalf("expected columns [a b], got %v", cols)
+	}
+	want := mat.NewDense(3, 2, []float64{1.5, 4, 2.5, 5, 3.5, 6})
+	if !mat.Equal(m, want) {
+		t.Errorf("expected %v, got %v", mat.Formatted(want), mat.Formatted(m))
+	}
+
+	back, err := FromMatrix(m, cols)
+	if err != nil {
+		t.Fatalf("FromMatrix failed: %v", err)
+	}
+	expected, _ := New(map[string]any{
+		"a": []float64{1.5, 2.5, 3.5},
+		"b": []float64{4, 5, 6},
+	})
+	if !back.Equals(expected.Select("a", "b")) {
+		t.Errorf("round trip mismatch:\n%v", back)
+	}
+
+	// Named columns come back in the order asked for.
+	m, cols, err = df.ToMatrix("b", "a")
+	if err != nil {
+		t.Fatalf("ToMatrix failed: %v", err)
+	}
+	if cols[0] != "b" || m.At(0, 0) != 4 || m.At(0, 1) != 1.5 {
+		t.Errorf("expected b before a, got %v\n%v", cols, mat.Formatted(m))
+	}
+}
+
+func TestToMatrixErrors(t *testing.T) {
+	df, _ := New(map[string]any{
+		"a":    []float64{1, 2, 3},
+		"name": []string{"x", "y", "z"},
+	})
+
+	if _, _, err := df.ToMatrix("missing"); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("expected ErrColumnNotFound, got %v", err)
+	}
+	if _, _, err := df.ToMatrix("name"); !errors.Is(err, core.ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch, got %v", err)
+	}
+
+	a, _ := df.Column("a")
+	a.SetNull(1)
+	if _, _, err := df.ToMatrix(); !errors.Is(err, core.ErrNullValue) {
+		t.Errorf("expected ErrNullValue, got %v", err)
+	}
+
+	m := mat.NewDense(2, 2, nil)
+	if _, err := FromMatrix(m, []string{"a"}); !errors.Is(err, core.ErrInvalidShape) {
+		t.Errorf("expected ErrInvalidShape, got %v", err)
+	}
+	if _, err := FromMatrix(m, []string{"a", "a"}); !errors.Is(err, core.ErrDuplicateColumn) {
+		t.Errorf("expected ErrDuplicateColumn, got %v", err)
+	}
+}
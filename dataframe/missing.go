@@ -1,7 +1,9 @@
 package dataframe
 
 import (
+	"fmt"
 
+	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/series"
 )
 
@@ -185,9 +187,65 @@ func (df *DataFrame) FillNADict(values map[string]any) *DataFrame {
 	}
 }
 
+// FillNAWithSeries returns a new DataFrame in which each null in col is
+// replaced by the value at the same position of filler, so filling one
+// column from another coalesces the two. Where filler is null too, the null
+// stays. If the dtypes differ the column is widened as WhereCond widens it:
+// numbers to float64, anything else to string.
+//
+// Returns an error wrapping core.ErrColumnNotFound if col does not exist and
+// core.ErrInvalidShape if filler's length is not the row count.
+func (df *DataFrame) FillNAWithSeries(col string, filler *series.Series[any]) (*DataFrame, error) {
+	if filler == nil {
+		return nil, fmt.Errorf("filler is nil: %w", core.ErrInvalidArgument)
+	}
+
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	s, exists := df.series[col]
+	if !exists {
+		return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
+	}
+	if filler.Len() != df.nrows {
+		return nil, fmt.Errorf("filler has length %d, DataFrame has %d rows: %w", filler.Len(), df.nrows, core.ErrInvalidShape)
+	}
+
+	values := make([]any, df.nrows)
+	for i := range values {
+		val, ok := s.Get(i)
+		if !ok {
+			val, ok = filler.Get(i)
+		}
+		if ok {
+			values[i] = val
+		}
+	}
+
+	dtype := widenDtype(s.Dtype(), filler.Dtype())
+	newS := widenedSeries(col, values, dtype)
+	if s.IsCategorical() && dtype == core.DtypeCategory {
+		newS = newS.AsCategorical()
+	}
+
+	newSeries := make(map[string]*series.Series[any], len(df.series))
+	for c, cs := range df.series {
+		newSeries[c] = cs
+	}
+	newSeries[col] = newS
+
+	return &DataFrame{
+		columns: df.columns,
+		series:  newSeries,
+		index:   df.index,
+		nrows:   df.nrows,
+	}, nil
+}
+
 // InterpolateOptions configures interpolation behavior.
 type InterpolateOptions struct {
 	limit int // Maximum number of consecutive nulls to fill
+	order int // Polynomial degree for the "polynomial" method
 }
 
 // InterpolateOption is a functional option for Interpolate.
@@ -200,9 +258,43 @@ func Limit(n int) InterpolateOption {
 	}
 }
 
-// Interpolate fills null values using interpolation.
-// method can be "linear", "ffill", or "bfill".
+// Order sets the polynomial degree used by the "polynomial" method.
+func Order(n int) InterpolateOption {
+	return func(opts *InterpolateOptions) {
+		opts.order = n
+	}
+}
+
+// Interpolate fills null values in numeric columns. method can be:
+//
+//   - "linear": a straight line between the surrounding valid values,
+//     treating rows as equally spaced.
+//   - "time": like "linear", but weighted by the distances between the
+//     DatetimeIndex timestamps. The index must be a DatetimeIndex.
+//   - "polynomial": a polynomial of degree Order(n) through the n+1 valid
+//     values nearest each gap (fewer if the column has fewer). Order is
+//     required.
+//   - "spline": a natural cubic spline through all valid values.
+//   - "ffill" / "bfill": propagate the previous / next valid value.
+//
+// Except for "bfill", leading nulls stay null, and except for "ffill",
+// trailing nulls do too. With Limit(n), gaps of more than n nulls are left
+// unfilled ("ffill" and "bfill" fill the first n instead). An unknown method,
+// or a method that cannot be applied, returns an unchanged copy; use
+// InterpolateE to get the error instead.
 func (df *DataFrame) Interpolate(method string, opts ...InterpolateOption) *DataFrame {
+	result, err := df.InterpolateE(method, opts...)
+	if err != nil {
+		return df.Copy()
+	}
+	return result
+}
+
+// InterpolateE is Interpolate, but returns an error wrapping
+// core.ErrInvalidArgument where Interpolate would return an unchanged copy
+// because the method cannot be applied: "time" without a DatetimeIndex, or
+// "polynomial" without a positive Order.
+func (df *DataFrame) InterpolateE(method string, opts ...InterpolateOption) (*DataFrame, error) {
 	df.mu.RLock()
 	defer df.mu.RUnlock()
 
@@ -214,18 +306,35 @@ func (df *DataFrame) Interpolate(method string, opts ...InterpolateOption) *Data
 		opt(interpOpts)
 	}
 
+	var xs []float64 // Row coordinates; nil means positions
+	switch method {
+	case "time":
+		idx, ok := df.index.(*DatetimeIndex)
+		if !ok {
+			return nil, fmt.Errorf("time interpolation requires a DatetimeIndex: %w", core.ErrInvalidArgument)
+		}
+		xs = make([]float64, len(idx.times))
+		for i, t := range idx.times {
+			xs[i] = t.Sub(idx.times[0]).Seconds()
+		}
+	case "polynomial":
+		if interpOpts.order <= 0 {
+			return nil, fmt.Errorf("polynomial interpolation order %d must be positive: %w", interpOpts.order, core.ErrInvalidArgument)
+		}
+	}
+
 	newSeries := make(map[string]*series.Series[any])
 
 	for _, col := range df.columns {
 		s := df.series[col]
 		
 		// Only interpolate numeric columns
-		if !isNumericType(s.Dtype()) {
+		if !core.IsNumeric(s.Dtype()) {
 			newSeries[col] = s
 			continue
 		}
 
-		newS := interpolateSeries(s, method, interpOpts.limit)
+		newS := interpolateSeries(s, method, xs, interpOpts)
 		newSeries[col] = newS
 	}
 
@@ -234,27 +343,76 @@ func (df *DataFrame) Interpolate(method string, opts ...InterpolateOption) *Data
 		series:  newSeries,
 		index:   df.index,
 		nrows:   df.nrows,
-	}
+	}, nil
 }
 
-// interpolateSeries performs interpolation on a single series.
-func interpolateSeries(s *series.Series[any], method string, limit int) *series.Series[any] {
-	data := s.Data()
-	newData := make([]any, len(data))
-	copy(newData, data)
-
+// interpolateSeries performs interpolation on a single series. xs holds the
+// coordinate of each row, or is nil to use row positions.
+func interpolateSeries(s *series.Series[any], method string, xs []float64, opts *InterpolateOptions) *series.Series[any] {
+	limit := opts.limit
 	switch method {
-	case "linear":
-		interpolateLinear(newData, s, limit)
-	case "ffill":
-		interpolateFFill(newData, s, limit)
-	case "bfill":
-		interpolateBFill(newData, s, limit)
+	case "linear", "time", "polynomial", "spline":
+	case "ffill", "bfill":
+		if limit == 0 {
+			return s.Copy()
+		}
+		return s.FillNAMethod(method, limit)
 	default:
 		// Unknown method, return copy
 		return s.Copy()
 	}
 
+	x := func(i int) float64 {
+		if xs == nil {
+			return float64(i)
+		}
+		return xs[i]
+	}
+
+	// Collect the valid points the curve passes through
+	data := s.Data()
+	var pos []int
+	var px, py []float64
+	for i := range data {
+		if !s.IsNull(i) {
+			pos = append(pos, i)
+			px = append(px, x(i))
+			f, _ := core.ToFloat64(data[i])
+			py = append(py, f)
+		}
+	}
+
+	// eval returns the curve at xi, which lies between valid points k and k+1
+	var eval func(k int, xi float64) float64
+	switch method {
+	case "polynomial":
+		eval = func(k int, xi float64) float64 {
+			return polynomialAt(px, py, k, opts.order, xi)
+		}
+	case "spline":
+		m := splineSecondDerivatives(px, py)
+		eval = func(k int, xi float64) float64 {
+			return splineAt(px, py, m, k, xi)
+		}
+	default:
+		eval = func(k int, xi float64) float64 {
+			fraction := (xi - px[k]) / (px[k+1] - px[k])
+			return py[k] + fraction*(py[k+1]-py[k])
+		}
+	}
+
+	newData := make([]any, len(data))
+	copy(newData, data)
+	for k := 0; k+1 < len(pos); k++ {
+		gap := pos[k+1] - pos[k] - 1
+		if gap == 0 || (limit >= 0 && gap > limit) {
+			continue // No nulls, or gap too large
+		}
+		for i := pos[k] + 1; i < pos[k+1]; i++ {
+			newData[i] = eval(k, x(i))
+		}
+	}
+
 	// Create new series
 	newS := series.New(s.Name(), newData, s.Dtype())
 	
@@ -268,86 +426,67 @@ func interpolateSeries(s *series.Series[any], method string, limit int) *series.
 	return newS
 }
 
-// interpolateLinear performs linear interpolation.
-func interpolateLinear(data []any, s *series.Series[any], limit int) {
-	n := len(data)
-
-	for i := 0; i < n; i++ {
-		if !s.IsNull(i) {
-			continue
-		}
-
-		// Find previous non-null value
-		prevIdx := -1
-		var prevVal float64
-		for j := i - 1; j >= 0; j-- {
-			if !s.IsNull(j) {
-				prevIdx = j
-				prevVal = toFloat64(s.GetUnsafe(j))
-				break
-			}
-		}
-
-		// Find next non-null value
-		nextIdx := -1
-		var nextVal float64
-		for j := i + 1; j < n; j++ {
-			if !s.IsNull(j) {
-				nextIdx = j
-				nextVal = toFloat64(s.GetUnsafe(j))
-				break
-			}
+// polynomialAt evaluates the Lagrange polynomial of the given degree through
+// the points nearest xi, starting from points k and k+1 and widening toward
+// whichever neighbor is closer.
+func polynomialAt(px, py []float64, k, degree int, xi float64) float64 {
+	lo, hi := k, k+1
+	for hi-lo < degree && (lo > 0 || hi < len(px)-1) {
+		if lo == 0 || (hi < len(px)-1 && px[hi+1]-xi < xi-px[lo-1]) {
+			hi++
+		} else {
+			lo--
 		}
+	}
 
-		// Interpolate if we have both boundaries
-		if prevIdx >= 0 && nextIdx >= 0 {
-			gap := nextIdx - prevIdx
-			if limit >= 0 && gap-1 > limit {
-				continue // Gap too large
+	result := 0.0
+	for j := lo; j <= hi; j++ {
+		term := py[j]
+		for m := lo; m <= hi; m++ {
+			if m != j {
+				term *= (xi - px[m]) / (px[j] - px[m])
 			}
-
-			// Linear interpolation
-			fraction := float64(i-prevIdx) / float64(gap)
-			interpolated := prevVal + fraction*(nextVal-prevVal)
-			data[i] = interpolated
 		}
+		result += term
 	}
+	return result
 }
 
-// interpolateFFill performs forward fill.
-func interpolateFFill(data []any, s *series.Series[any], limit int) {
-	var lastValue any
-	consecutiveNulls := 0
-
-	for i := 0; i < len(data); i++ {
-		if !s.IsNull(i) {
-			lastValue = s.GetUnsafe(i)
-			consecutiveNulls = 0
-		} else if lastValue != nil {
-			if limit < 0 || consecutiveNulls < limit {
-				data[i] = lastValue
-				consecutiveNulls++
-			}
-		}
+// splineSecondDerivatives returns the second derivatives of the natural cubic
+// spline through the points, solving the tridiagonal system with the Thomas
+// algorithm. The ends have zero curvature.
+func splineSecondDerivatives(px, py []float64) []float64 {
+	n := len(px)
+	m := make([]float64, n)
+	if n < 3 {
+		return m
 	}
-}
 
-// interpolateBFill performs backward fill.
-func interpolateBFill(data []any, s *series.Series[any], limit int) {
-	var nextValue any
-	consecutiveNulls := 0
+	// Forward sweep over the interior points
+	c := make([]float64, n) // Modified super-diagonal
+	d := make([]float64, n) // Modified right-hand side
+	for i := 1; i < n-1; i++ {
+		h0 := px[i] - px[i-1]
+		h1 := px[i+1] - px[i]
+		rhs := 6 * ((py[i+1]-py[i])/h1 - (py[i]-py[i-1])/h0)
+		denom := 2*(h0+h1) - h0*c[i-1]
+		c[i] = h1 / denom
+		d[i] = (rhs - h0*d[i-1]) / denom
+	}
 
-	for i := len(data) - 1; i >= 0; i-- {
-		if !s.IsNull(i) {
-			nextValue = s.GetUnsafe(i)
-			consecutiveNulls = 0
-		} else if nextValue != nil {
-			if limit < 0 || consecutiveNulls < limit {
-				data[i] = nextValue
-				consecutiveNulls++
-			}
-		}
+	// Back substitution
+	for i := n - 2; i >= 1; i-- {
+		m[i] = d[i] - c[i]*m[i+1]
 	}
+	return m
+}
+
+// splineAt evaluates the cubic spline segment between points k and k+1.
+func splineAt(px, py, m []float64, k int, xi float64) float64 {
+	h := px[k+1] - px[k]
+	a := (px[k+1] - xi) / h
+	b := (xi - px[k]) / h
+	return a*py[k] + b*py[k+1] + ((a*a*a-a)*m[k]+(b*b*b-b)*m[k+1])*h*h/6
 }
 
 // IsNA returns a DataFrame of boolean values indicating null positions.
@@ -0,0 +1,218 @@
+package dataframe
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+// nullableFrame builds a DataFrame whose nil values are marked null.
+func nullableFrame(t *testing.T, data map[string]any) *DataFrame {
+	t.Helper()
+	df, err := New(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for col, values := range data {
+		s, _ := df.Column(col)
+		for i, v := range values.([]any) {
+			if v == nil {
+				s.SetNull(i)
+			}
+		}
+	}
+	return df
+}
+
+func interpolatedValue(t *testing.T, df *DataFrame, col string, row int) float64 {
+	t.Helper()
+	s, err := df.Column(col)
+	if err != nil {
+		t.Fatal(err)
+	}
+	val, ok := s.Get(row)
+	if !ok {
+		t.Fatalf("%s[%d] is still null", col, row)
+	}
+	f, _ := core.ToFloat64(val)
+	return f
+}
+
+func TestInterpolateTime(t *testing.T) {
+	df := nullableFrame(t, map[string]any{
+		"temp": []any{0.0, nil, 10.0, nil, nil, 40.0},
+	})
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	offsets := []time.Duration{0, time.Hour, 10 * time.Hour, 11 * time.Hour, 13 * time.Hour, 14 * time.Hour}
+	times := make([]time.Time, len(offsets))
+	for i, d := range offsets {
+		times[i] = start.Add(d)
+	}
+	if err := df.SetIndex(NewDatetimeIndex(times, nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	linear, err := df.InterpolateE("linear")
+	if err != nil {
+		t.Fatalf("InterpolateE(linear) error = %v", err)
+	}
+	timed, err := df.InterpolateE("time")
+	if err != nil {
+		t.Fatalf("InterpolateE(time) error = %v", err)
+	}
+
+	tests := []struct {
+		row          int
+		linear, time float64
+	}{
+		{1, 5, 1},     // 1h of a 10h gap
+		{3, 20, 17.5}, // 1h of a 4h gap
+		{4, 30, 32.5}, // 3h of a 4h gap
+	}
+	for _, tt := range tests {
+		if got := interpolatedValue(t, linear, "temp", tt.row); math.Abs(got-tt.linear) > 1e-9 {
+			t.Errorf("linear row %d = %v, want %v", tt.row, got, tt.linear)
+		}
+		if got := interpolatedValue(t, timed, "temp", tt.row); math.Abs(got-tt.time) > 1e-9 {
+			t.Errorf("time row %d = %v, want %v", tt.row, got, tt.time)
+		}
+	}
+}
+
+func TestInterpolateTimeRequiresDatetimeIndex(t *testing.T) {
+	df := nullableFrame(t, map[string]any{"x": []any{1.0, nil, 3.0}})
+
+	if _, err := df.InterpolateE("time"); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("InterpolateE(time) error = %v, want ErrInvalidArgument", err)
+	}
+
+	// Interpolate keeps its error-free signature and returns a copy
+	result := df.Interpolate("time")
+	if x, _ := result.Column("x"); !x.IsNull(1) {
+		t.Error("Interpolate(time) without a DatetimeIndex should leave nulls unfilled")
+	}
+}
+
+func TestInterpolatePolynomial(t *testing.T) {
+	// y = x^2 with x = 3 missing
+	df := nullableFrame(t, map[string]any{
+		"y": []any{0.0, 1.0, 4.0, nil, 16.0, 25.0},
+	})
+
+	result, err := df.InterpolateE("polynomial", Order(2))
+	if err != nil {
+		t.Fatalf("InterpolateE(polynomial) error = %v", err)
+	}
+	if got := interpolatedValue(t, result, "y", 3); math.Abs(got-9) > 1e-9 {
+		t.Errorf("y[3] = %v, want 9", got)
+	}
+
+	if _, err := df.InterpolateE("polynomial"); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("InterpolateE(polynomial) without Order error = %v, want ErrInvalidArgument", err)
+	}
+}
+
+func TestInterpolateSpline(t *testing.T) {
+	df := nullableFrame(t, map[string]any{
+		"line":  []any{nil, 2.0, nil, 6.0, 8.0, nil},
+		"curve": []any{0.0, 1.0, nil, 1.0, 0.0, -1.0},
+	})
+
+	result, err := df.InterpolateE("spline")
+	if err != nil {
+		t.Fatalf("InterpolateE(spline) error = %v", err)
+	}
+
+	// A spline through collinear points is the line itself
+	if got := interpolatedValue(t, result, "line", 2); math.Abs(got-4) > 1e-9 {
+		t.Errorf("line[2] = %v, want 4", got)
+	}
+	line, _ := result.Column("line")
+	if !line.IsNull(0) || !line.IsNull(5) {
+		t.Error("leading and trailing nulls should stay null")
+	}
+
+	// The curve bulges above the straight line between its neighbors
+	if got := interpolatedValue(t, result, "curve", 2); got <= 1 {
+		t.Errorf("curve[2] = %v, want above 1", got)
+	}
+}
+
+func TestInterpolateLimit(t *testing.T) {
+	df := nullableFrame(t, map[string]any{
+		"x": []any{0.0, nil, 2.0, nil, nil, nil, 6.0},
+	})
+
+	result, err := df.InterpolateE("spline", Limit(2))
+	if err != nil {
+		t.Fatalf("InterpolateE() error = %v", err)
+	}
+	x, _ := result.Column("x")
+	if x.IsNull(1) {
+		t.Error("gap of one null should be filled")
+	}
+	for i := 3; i <= 5; i++ {
+		if !x.IsNull(i) {
+			t.Errorf("x[%d] in a gap longer than the limit should stay null", i)
+		}
+	}
+}
+
+func TestFillNAWithSeries(t *testing.T) {
+	df := nullableFrame(t, map[string]any{
+		"phone":  []any{"555-1", nil, nil, "555-4"},
+		"mobile": []any{"777-1", "777-2", nil, nil},
+		"count":  []any{int64(1), nil, int64(3), nil},
+	})
+	mobile, _ := df.Column("mobile")
+
+	result, err := df.FillNAWithSeries("phone", mobile)
+	if err != nil {
+		t.Fatalf("FillNAWithSeries() error = %v", err)
+	}
+	phone, _ := result.Column("phone")
+	want := []any{"555-1", "777-2", nil, "555-4"}
+	for i, w := range want {
+		got, ok := phone.Get(i)
+		if w == nil {
+			if ok {
+				t.Errorf("phone[%d] = %v, want null", i, got)
+			}
+			continue
+		}
+		if !ok || got != w {
+			t.Errorf("phone[%d] = %v, want %v", i, got, w)
+		}
+	}
+	if original, _ := df.Column("phone"); !original.IsNull(1) {
+		t.Error("the original DataFrame should be unchanged")
+	}
+
+	// Filling an int64 column with floats widens it
+	filler, _ := New(map[string]any{"f": []float64{0, 2.5, 0, 4.5}})
+	f, _ := filler.Column("f")
+	result, err = df.FillNAWithSeries("count", f)
+	if err != nil {
+		t.Fatalf("FillNAWithSeries() error = %v", err)
+	}
+	count, _ := result.Column("count")
+	if count.Dtype() != core.DtypeFloat64 {
+		t.Errorf("count dtype = %v, want float64", count.Dtype())
+	}
+	if got := interpolatedValue(t, result, "count", 1); got != 2.5 {
+		t.Errorf("count[1] = %v, want 2.5", got)
+	}
+	if got := interpolatedValue(t, result, "count", 2); got != 3 {
+		t.Errorf("count[2] = %v, want 3", got)
+	}
+
+	if _, err := df.FillNAWithSeries("missing", mobile); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("expected ErrColumnNotFound, got %v", err)
+	}
+	if _, err := df.FillNAWithSeries("phone", mobile.Slice(0, 2)); !errors.Is(err, core.ErrInvalidShape) {
+		t.Errorf("expected ErrInvalidShape, got %v", err)
+	}
+}
@@ -0,0 +1,154 @@
+package dataframe
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/series"
+)
+
+const (
+	// outlierIQRFactor is how many interquartile ranges beyond the quartiles
+	// a value must lie to be an "iqr" outlier, unless set by WithThreshold.
+	outlierIQRFactor = 1.5
+
+	// outlierZScore is how many standard deviations from the mean a value
+	// must lie to be a "zscore" outlier, unless set by WithThreshold.
+	outlierZScore = 3.0
+)
+
+// OutlierOptions configures DetectOutliersWith and RemoveOutliersWith.
+type OutlierOptions struct {
+	threshold float64 // 0 uses the method's default
+}
+
+// OutlierOption is a functional option for DetectOutliersWith and
+// RemoveOutliersWith.
+type OutlierOption func(*OutlierOptions)
+
+// WithThreshold sets how far out a value must lie to be an outlier: the
+// number of interquartile ranges beyond the quartiles for "iqr" (default
+// 1.5), or of standard deviations from the mean for "zscore" (default 3).
+func WithThreshold(threshold float64) OutlierOption {
+	return func(opts *OutlierOptions) {
+		opts.threshold = threshold
+	}
+}
+
+// DetectOutliers returns a boolean DataFrame, with the same index, marking
+// the outliers in each of cols, or in every numeric column if cols is empty.
+// method is "iqr", which flags values outside [Q1-1.5·IQR, Q3+1.5·IQR], or
+// "zscore", which flags values more than 3 sample standard deviations from
+// the mean. Nulls are never outliers. Use DetectOutliersWith to change the
+// thresholds.
+//
+// No value of a column with n non-null values can lie more than (n-1)/√n
+// sample standard deviations from its mean, so "zscore" flags nothing when
+// n <= 10; use "iqr" or a lower threshold for small samples.
+func (df *DataFrame) DetectOutliers(method string, cols ...string) (*DataFrame, error) {
+	return df.DetectOutliersWith(method, cols)
+}
+
+// DetectOutliersWith is DetectOutliers with options such as WithThreshold.
+func (df *DataFrame) DetectOutliersWith(method string, cols []string, opts ...OutlierOption) (*DataFrame, error) {
+	if method != "iqr" && method != "zscore" {
+		return nil, fmt.Errorf("method %q must be 'iqr' or 'zscore': %w", method, core.ErrInvalidArgument)
+	}
+
+	outlierOpts := &OutlierOptions{}
+	for _, opt := range opts {
+		opt(outlierOpts)
+	}
+	threshold := outlierOpts.threshold
+	switch {
+	case threshold < 0 || math.IsNaN(threshold):
+		return nil, fmt.Errorf("threshold %v must be positive: %w", threshold, core.ErrInvalidArgument)
+	case threshold == 0 && method == "iqr":
+		threshold = outlierIQRFactor
+	case threshold == 0:
+		threshold = outlierZScore
+	}
+
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	if len(cols) == 0 {
+		cols = df.getNumericColumns()
+	} else {
+		cols = append([]string(nil), cols...)
+	}
+
+	seriesMap := make(map[string]*series.Series[any], len(cols))
+	for _, col := range cols {
+		s, ok := df.series[col]
+		if !ok {
+			return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
+		}
+		if !core.IsNumeric(s.Dtype()) {
+			return nil, fmt.Errorf("column %q has dtype %v: %w", col, s.Dtype(), core.ErrTypeMismatch)
+		}
+		seriesMap[col] = convertToAnySeries(col, outlierMask(s, method, threshold), core.DtypeBool)
+	}
+
+	return &DataFrame{
+		columns: cols,
+		series:  seriesMap,
+		index:   df.index,
+		nrows:   df.nrows,
+	}, nil
+}
+
+// RemoveOutliers returns a new DataFrame without the rows that
+// DetectOutliers flags in any of cols.
+func (df *DataFrame) RemoveOutliers(method string, cols ...string) (*DataFrame, error) {
+	return df.RemoveOutliersWith(method, cols)
+}
+
+// RemoveOutliersWith is RemoveOutliers with options such as WithThreshold.
+func (df *DataFrame) RemoveOutliersWith(method string, cols []string, opts ...OutlierOption) (*DataFrame, error) {
+	mask, err := df.DetectOutliersWith(method, cols, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var outliers []int
+	for i := 0; i < mask.nrows; i++ {
+		for _, col := range mask.columns {
+			if flagged, _ := mask.series[col].Get(i); flagged == true {
+				outliers = append(outliers, i)
+				break
+			}
+		}
+	}
+	return df.DropRows(outliers...), nil
+}
+
+// outlierMask flags the values of a numeric Series lying more than
+// threshold interquartile ranges or standard deviations out, by method.
+func outlierMask(s *series.Series[any], method string, threshold float64) []bool {
+	var lower, upper float64
+	switch method {
+	case "iqr":
+		q1, q3 := quantileColumn(s, 0.25), quantileColumn(s, 0.75)
+		iqr := q3 - q1
+		lower, upper = q1-threshold*iqr, q3+threshold*iqr
+	case "zscore":
+		mean, std := meanColumn(s), stdColumn(s)
+		lower, upper = mean-threshold*std, mean+threshold*std
+	}
+
+	mask := make([]bool, s.Len())
+	if math.IsNaN(lower) || math.IsNaN(upper) {
+		return mask
+	}
+	for i := range mask {
+		val, ok := s.Get(i)
+		if !ok || val == nil {
+			continue
+		}
+		v, _ := core.ToFloat64(val)
+		mask[i] = v < lower || v > upper
+	}
+	return mask
+}
@@ -0,0 +1,109 @@
+package dataframe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func outlierFrame() *DataFrame {
+	values := []float64{10, 11, 9, 10, 12, 10, 11, 9, 10, 11, 10, 9, 12, 10, 11, 10, 9, 11, 10, 100}
+	names := make([]string, len(values))
+	for i := range names {
+		names[i] = "ok"
+	}
+	names[len(names)-1] = "bad"
+	df, _ := New(map[string]any{"value": values, "name": names})
+	return df.Select("value", "name")
+}
+
+func TestDetectOutliers(t *testing.T) {
+	df := outlierFrame()
+	last := df.Nrows() - 1
+
+	for _, method := range []string{"iqr", "zscore"} {
+		mask, err := df.DetectOutliers(method)
+		if err != nil {
+			t.Fatalf("%s: DetectOutliers failed: %v", method, err)
+		}
+		if cols := mask.Columns(); len(cols) != 1 || cols[0] != "value" {
+			t.Fatalf("%s: columns = %v, want [value]", method, cols)
+		}
+
+		s, _ := mask.Column("value")
+		if s.Dtype() != core.DtypeBool {
+			t.Errorf("%s: dtype = %v, want bool", method, s.Dtype())
+		}
+		for i := 0; i < mask.Nrows(); i++ {
+			got, _ := s.Get(i)
+			if want := i == last; got != want {
+				t.Errorf("%s: row %d flagged = %v, want %v", method, i, got, want)
+			}
+		}
+
+		cleaned, err := df.RemoveOutliers(method, "value")
+		if err != nil {
+			t.Fatalf("%s: RemoveOutliers failed: %v", method, err)
+		}
+		if cleaned.Nrows() != last {
+			t.Errorf("%s: %d rows after RemoveOutliers, want %d", method, cleaned.Nrows(), last)
+		}
+		names, _ := cleaned.Column("name")
+		for i := 0; i < names.Len(); i++ {
+			if name, _ := names.Get(i); name != "ok" {
+				t.Errorf("%s: row %d kept name %v", method, i, name)
+			}
+		}
+	}
+}
+
+func TestDetectOutliersThreshold(t *testing.T) {
+	// With n <= 10 no value can reach 3 standard deviations from the mean
+	small, _ := New(map[string]any{"value": []float64{1, 1, 1, 1, 1, 10}})
+	cleaned, err := small.RemoveOutliers("zscore")
+	if err != nil {
+		t.Fatalf("RemoveOutliers failed: %v", err)
+	}
+	if cleaned.Nrows() != 6 {
+		t.Errorf("%d rows after RemoveOutliers, want all 6", cleaned.Nrows())
+	}
+
+	cleaned, err = small.RemoveOutliersWith("zscore", nil, WithThreshold(2))
+	if err != nil {
+		t.Fatalf("RemoveOutliersWith failed: %v", err)
+	}
+	if cleaned.Nrows() != 5 {
+		t.Errorf("%d rows after RemoveOutliersWith, want 5", cleaned.Nrows())
+	}
+
+	// A wide enough IQR fence keeps the obvious outlier
+	mask, err := outlierFrame().DetectOutliersWith("iqr", []string{"value"}, WithThreshold(100))
+	if err != nil {
+		t.Fatalf("DetectOutliersWith failed: %v", err)
+	}
+	s, _ := mask.Column("value")
+	for i := 0; i < s.Len(); i++ {
+		if flagged, _ := s.Get(i); flagged == true {
+			t.Errorf("iqr with threshold 100 flagged row %d", i)
+		}
+	}
+
+	if _, err := small.DetectOutliersWith("iqr", nil, WithThreshold(-1)); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for a negative threshold, got %v", err)
+	}
+}
+
+func TestDetectOutliersErrors(t *testing.T) {
+	df := outlierFrame()
+
+	if _, err := df.DetectOutliers("mad"); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument, got %v", err)
+	}
+	if _, err := df.DetectOutliers("iqr", "missing"); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("expected ErrColumnNotFound, got %v", err)
+	}
+	if _, err := df.RemoveOutliers("iqr", "name"); !errors.Is(err, core.ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch, got %v", err)
+	}
+}
@@ -81,6 +81,19 @@ func BenchmarkGroupByManyGroups(b *testing.B) {
 	}
 }
 
+// BenchmarkGroupByManyGroupsSerial is the single-worker baseline for BenchmarkGroupByManyGroups
+func BenchmarkGroupByManyGroupsSerial(b *testing.B) {
+	df := generateTestData(1000000, 42)
+	
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		grouped, _ := df.groupBy([]string{"group"}, 1)
+		_, _ = grouped.Agg(map[string]string{
+			"value1": "sum",
+		})
+	}
+}
+
 // BenchmarkJoinInner benchmarks inner join operations
 func BenchmarkJoinInner(b *testing.B) {
 	sizes := []struct {
@@ -1,6 +1,7 @@
 package dataframe
 
 import (
+	"math"
 	"testing"
 
 	"github.com/TIVerse/GopherData/core"
@@ -455,3 +456,56 @@ func TestWindowEdgeCases(t *testing.T) {
 		}
 	})
 }
+
+// TestWindowApply checks a custom window function against the built-in
+// aggregations it can be composed from
+func TestWindowApply(t *testing.T) {
+	df, _ := New(map[string]any{
+		"val": []any{4.0, 1.0, nil, 7.0, 3.0, 9.0, 2.0},
+	})
+
+	rangeFn := func(values []float64) float64 {
+		lo, hi := values[0], values[0]
+		for _, v := range values {
+			lo = math.Min(lo, v)
+			hi = math.Max(hi, v)
+		}
+		return hi - lo
+	}
+
+	windows := map[string]*Window{
+		"rolling":   df.Rolling(3, MinPeriods(2)),
+		"centered":  df.Rolling(3, MinPeriods(1), Center()),
+		"expanding": df.Expanding(2),
+	}
+
+	for name, window := range windows {
+		t.Run(name, func(t *testing.T) {
+			ranges, err := window.Apply("val", rangeFn)
+			if err != nil {
+				t.Fatalf("Apply failed: %v", err)
+			}
+			maxes, _ := window.Max("val")
+			mins, _ := window.Min("val")
+
+			for i := 0; i < ranges.Len(); i++ {
+				got, _ := ranges.Get(i)
+				hi, ok := maxes.Get(i)
+				lo, _ := mins.Get(i)
+				if !ok || hi == nil {
+					if !math.IsNaN(got) {
+						t.Errorf("Row %d: expected NaN below minPeriods, got %v", i, got)
+					}
+					continue
+				}
+				if want := hi.(float64) - lo.(float64); got != want {
+					t.Errorf("Row %d: expected range %v, got %v", i, want, got)
+				}
+			}
+		})
+	}
+
+	if _, err := df.Rolling(2).Apply("missing", rangeFn); err == nil {
+		t.Error("Expected an error for a missing column")
+	}
+}
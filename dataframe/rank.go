@@ -0,0 +1,73 @@
+package dataframe
+
+import (
+	"fmt"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/series"
+)
+
+// Rank returns a DataFrame, with the same index, of the Float64 ranks of
+// each of cols, or of every numeric column if cols is empty. method is
+// "average", "min", "max", "first" or "dense", as for series.Rank, and
+// ascending orders the ranks. Nulls stay null.
+//
+// Returns an error wrapping core.ErrInvalidArgument for an unknown method,
+// core.ErrColumnNotFound for a missing column and core.ErrTypeMismatch for
+// a column that is not numeric, string, category or time.
+func (df *DataFrame) Rank(cols []string, method string, ascending bool) (*DataFrame, error) {
+	if err := checkRankMethod(method); err != nil {
+		return nil, err
+	}
+
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	if len(cols) == 0 {
+		cols = df.getNumericColumns()
+	} else {
+		cols = append([]string(nil), cols...)
+	}
+
+	seriesMap := make(map[string]*series.Series[any], len(cols))
+	for _, col := range cols {
+		s, ok := df.series[col]
+		if !ok {
+			return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
+		}
+		if !rankable(s.Dtype()) {
+			return nil, fmt.Errorf("column %q has dtype %v: %w", col, s.Dtype(), core.ErrTypeMismatch)
+		}
+		ranks := series.Rank(s, method, ascending)
+		values := make([]any, ranks.Len())
+		for i := range values {
+			if rank, ok := ranks.Get(i); ok {
+				values[i] = rank
+			}
+		}
+		seriesMap[col] = widenedSeries(col, values, core.DtypeFloat64)
+	}
+
+	return &DataFrame{
+		columns: cols,
+		series:  seriesMap,
+		index:   df.index,
+		nrows:   df.nrows,
+	}, nil
+}
+
+// checkRankMethod returns an error wrapping core.ErrInvalidArgument unless
+// method is one series.Rank knows.
+func checkRankMethod(method string) error {
+	switch method {
+	case "average", "min", "max", "first", "dense":
+		return nil
+	}
+	return fmt.Errorf("rank method %q must be average, min, max, first or dense: %w", method, core.ErrInvalidArgument)
+}
+
+// rankable reports whether series.Rank can rank a column of dtype.
+func rankable(dtype core.Dtype) bool {
+	return core.IsNumeric(dtype) || dtype == core.DtypeString ||
+		dtype == core.DtypeCategory || dtype == core.DtypeTime
+}
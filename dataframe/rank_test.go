@@ -0,0 +1,57 @@
+package dataframe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func TestRankColumns(t *testing.T) {
+	df, _ := New(map[string]any{
+		"score": []int64{70, 90, 70, 80},
+		"name":  []string{"d", "a", "c", "b"},
+	})
+
+	ranked, err := df.Rank([]string{"score", "name"}, "average", true)
+	if err != nil {
+		t.Fatalf("Rank failed: %v", err)
+	}
+	if cols := ranked.Columns(); len(cols) != 2 || cols[0] != "score" || cols[1] != "name" {
+		t.Fatalf("Expected columns [score name], got %v", cols)
+	}
+
+	want := map[string][]float64{
+		"score": {1.5, 4, 1.5, 3},
+		"name":  {4, 1, 3, 2},
+	}
+	for col, values := range want {
+		s, _ := ranked.Column(col)
+		if s.Dtype() != core.DtypeFloat64 {
+			t.Errorf("%s: expected float64 ranks, got %v", col, s.Dtype())
+		}
+		for i, w := range values {
+			if got, _ := s.Get(i); got != w {
+				t.Errorf("%s[%d]: expected %v, got %v", col, i, w, got)
+			}
+		}
+	}
+
+	desc, err := df.Rank([]string{"score"}, "min", false)
+	if err != nil {
+		t.Fatalf("Rank failed: %v", err)
+	}
+	score, _ := desc.Column("score")
+	for i, w := range []float64{3, 1, 3, 2} {
+		if got, _ := score.Get(i); got != w {
+			t.Errorf("descending score[%d]: expected %v, got %v", i, w, got)
+		}
+	}
+
+	if _, err := df.Rank(nil, "median", true); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument, got %v", err)
+	}
+	if _, err := df.Rank([]string{"missing"}, "min", true); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("Expected ErrColumnNotFound, got %v", err)
+	}
+}
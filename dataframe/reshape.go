@@ -2,14 +2,50 @@ package dataframe
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
 
 	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/series"
 )
 
+// DummyOptions configures GetDummies.
+type DummyOptions struct {
+	dropFirst bool
+	prefixes  map[string]string
+	prefixSep string
+}
+
+// DummyOption is a functional option for GetDummies.
+type DummyOption func(*DummyOptions)
+
+// DropFirst drops the first category of each column to avoid collinear indicators.
+func DropFirst() DummyOption {
+	return func(opts *DummyOptions) {
+		opts.dropFirst = true
+	}
+}
+
+// WithPrefix sets the indicator column prefix per source column (default: the column name).
+func WithPrefix(prefixes map[string]string) DummyOption {
+	return func(opts *DummyOptions) {
+		opts.prefixes = prefixes
+	}
+}
+
+// WithPrefixSep sets the separator between prefix and category (default: "_").
+func WithPrefixSep(sep string) DummyOption {
+	return func(opts *DummyOptions) {
+		opts.prefixSep = sep
+	}
+}
+
 // Pivot transforms long format to wide format.
 // index: column to use as row index
 // columns: column to use for new column names
 // values: column to use for cell values
+// Each (index, columns) pair is expected to appear at most once; when a pair
+// repeats, only the last value is kept. Use PivotTable to aggregate duplicates.
 func (df *DataFrame) Pivot(index, columns, values string) (*DataFrame, error) {
 	df.mu.RLock()
 	defer df.mu.RUnlock()
@@ -21,29 +57,14 @@ func (df *DataFrame) Pivot(index, columns, values string) (*DataFrame, error) {
 		}
 	}
 
-	// Get unique index and column values
+	// Get unique index values and sorted column values
 	indexVals := df.uniqueValues(index)
-	colVals := df.uniqueValues(columns)
-
-	// Build pivot table
-	pivotData := make(map[string][]any)
-	
-	// Add index column
-	indexData := make([]any, len(indexVals))
-	copy(indexData, indexVals)
-	pivotData[index] = indexData
+	colNames := pivotColumnNames(df.uniqueValues(columns))
 
-	// Create columns for each unique column value
-	for _, colVal := range colVals {
-		colName := fmt.Sprintf("%v", colVal)
-		columnData := make([]any, len(indexVals))
-		
-		// Initialize with nil
-		for i := range columnData {
-			columnData[i] = nil
-		}
-		
-		pivotData[colName] = columnData
+	// Create column data for each unique column value
+	pivotData := make(map[string][]any, len(colNames))
+	for _, colName := range colNames {
+		pivotData[colName] = make([]any, len(indexVals))
 	}
 
 	// Fill pivot table
@@ -78,13 +99,164 @@ func (df *DataFrame) Pivot(index, columns, values string) (*DataFrame, error) {
 		}
 	}
 
-	// Convert to map[string]any for New()
-	convertedData := make(map[string]any, len(pivotData))
-	for key, val := range pivotData {
-		convertedData[key] = val
+	return buildPivotFrame(index, indexVals, colNames, pivotData)
+}
+
+// PivotTable transforms long format to wide format, aggregating values that
+// share the same (index, columns) pair.
+// index: column to use as row index
+// columns: column to use for new column names
+// values: column to aggregate into cells
+// aggFunc: aggregation to apply (AggSum, AggMean, AggCount, ...)
+// Cells with no matching rows are null.
+func (df *DataFrame) PivotTable(index, columns, values, aggFunc string) (*DataFrame, error) {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	// Validate columns
+	for _, col := range []string{index, columns, values} {
+		if !df.HasColumn(col) {
+			return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
+		}
+	}
+	if !isValidAggFunc(aggFunc) {
+		return nil, fmt.Errorf("unknown aggregation function %q: %w", aggFunc, core.ErrInvalidArgument)
+	}
+
+	// Get unique index values and sorted column values
+	indexVals := df.uniqueValues(index)
+	colNames := pivotColumnNames(df.uniqueValues(columns))
+
+	// Create index lookup
+	indexLookup := make(map[string]int)
+	for i, val := range indexVals {
+		indexLookup[fmt.Sprintf("%v", val)] = i
+	}
+
+	// Collect the values falling in each cell
+	cells := make(map[string][][]any, len(colNames))
+	for _, colName := range colNames {
+		cells[colName] = make([][]any, len(indexVals))
+	}
+
+	idxSeries := df.series[index]
+	colSeries := df.series[columns]
+	valSeries := df.series[values]
+
+	for i := 0; i < df.nrows; i++ {
+		idxVal, _ := idxSeries.Get(i)
+		colVal, _ := colSeries.Get(i)
+		if idxVal == nil || colVal == nil {
+			continue
+		}
+
+		rowIdx := indexLookup[fmt.Sprintf("%v", idxVal)]
+		cell := cells[fmt.Sprintf("%v", colVal)]
+
+		val, ok := valSeries.Get(i)
+		if ok {
+			cell[rowIdx] = append(cell[rowIdx], val)
+		} else if aggFunc == AggSize {
+			cell[rowIdx] = append(cell[rowIdx], nil)
+		}
+	}
+
+	// Aggregate each cell
+	pivotData := make(map[string][]any, len(colNames))
+	for colName, cell := range cells {
+		columnData := make([]any, len(indexVals))
+		for rowIdx, cellValues := range cell {
+			columnData[rowIdx] = applyAggregation(aggFunc, cellValues, valSeries.Dtype())
+		}
+		pivotData[colName] = columnData
+	}
+
+	return buildPivotFrame(index, indexVals, colNames, pivotData)
+}
+
+// pivotColumnNames returns the string names of pivot column values in sorted order.
+func pivotColumnNames(colVals []any) []string {
+	sorted := sortedValues(colVals)
+	names := make([]string, len(sorted))
+	for i, val := range sorted {
+		names[i] = fmt.Sprintf("%v", val)
+	}
+	return names
+}
+
+// sortedValues returns a sorted copy of vals.
+func sortedValues(vals []any) []any {
+	sorted := make([]any, len(vals))
+	copy(sorted, vals)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		// Values of mixed types fall back to comparing their string form
+		if fmt.Sprintf("%T", sorted[i]) != fmt.Sprintf("%T", sorted[j]) {
+			return fmt.Sprintf("%v", sorted[i]) < fmt.Sprintf("%v", sorted[j])
+		}
+		return compareAny(sorted[i], sorted[j]) < 0
+	})
+	return sorted
+}
+
+// buildPivotFrame assembles a pivot result with the index column first,
+// followed by colNames in order. Missing cells are marked null.
+func buildPivotFrame(index string, indexVals []any, colNames []string, pivotData map[string][]any) (*DataFrame, error) {
+	columns := make([]string, 0, len(colNames)+1)
+	seriesMap := make(map[string]*series.Series[any], len(colNames)+1)
+
+	indexData := make([]any, len(indexVals))
+	copy(indexData, indexVals)
+	columns = append(columns, index)
+	seriesMap[index] = series.New(index, indexData, inferDtype(indexData))
+
+	for _, colName := range colNames {
+		if _, exists := seriesMap[colName]; exists {
+			return nil, fmt.Errorf("pivot column %q: %w", colName, core.ErrDuplicateColumn)
+		}
+
+		columnData := pivotData[colName]
+		s := series.New(colName, columnData, inferDtype(columnData))
+		for i, val := range columnData {
+			if val == nil {
+				s.SetNull(i)
+			}
+		}
+
+		columns = append(columns, colName)
+		seriesMap[colName] = s
 	}
 
-	return New(convertedData)
+	return &DataFrame{
+		columns: columns,
+		series:  seriesMap,
+		index:   NewRangeIndex(0, len(indexVals), 1),
+		nrows:   len(indexVals),
+	}, nil
+}
+
+// MeltGroup names a set of columns that are melted into a single value column.
+type MeltGroup struct {
+	Name    string   // Output value column name
+	Columns []string // Source columns, in variable order
+}
+
+// MeltOptions configures Melt.
+type MeltOptions struct {
+	groups []MeltGroup
+}
+
+// MeltOption is a functional option for Melt.
+type MeltOption func(*MeltOptions)
+
+// MeltGroups melts several groups of columns side by side, producing one value
+// column per group. All groups must have the same number of columns; the k-th
+// column of every group lands in the same output row, labeled in the variable
+// column with the k-th column name of the first group.
+// When set, the valueVars and valueName arguments of Melt are ignored.
+func MeltGroups(groups ...MeltGroup) MeltOption {
+	return func(opts *MeltOptions) {
+		opts.groups = groups
+	}
 }
 
 // Melt transforms wide format to long format.
@@ -92,101 +264,203 @@ func (df *DataFrame) Pivot(index, columns, values string) (*DataFrame, error) {
 // valueVars: columns to unpivot (if empty, use all non-id columns)
 // varName: name for the variable column
 // valueName: name for the value column
-func (df *DataFrame) Melt(idVars, valueVars []string, varName, valueName string) (*DataFrame, error) {
+// The value column keeps the dtype of the melted columns when they share one;
+// Int64 and Float64 columns melted together produce Float64.
+// Output columns are ordered: idVars, varName, then the value column(s).
+func (df *DataFrame) Melt(idVars, valueVars []string, varName, valueName string, opts ...MeltOption) (*DataFrame, error) {
 	df.mu.RLock()
 	defer df.mu.RUnlock()
 
+	meltOpts := &MeltOptions{}
+	for _, opt := range opts {
+		opt(meltOpts)
+	}
+
 	// Validate id columns
+	idSet := make(map[string]bool)
 	for _, col := range idVars {
 		if !df.HasColumn(col) {
 			return nil, fmt.Errorf("id column %q: %w", col, core.ErrColumnNotFound)
 		}
+		idSet[col] = true
 	}
 
-	// Determine value columns
-	if len(valueVars) == 0 {
-		// Use all non-id columns
-		idSet := make(map[string]bool)
-		for _, col := range idVars {
-			idSet[col] = true
-		}
+	// Default names
+	if varName == "" {
+		varName = "variable"
+	}
+	if valueName == "" {
+		valueName = "value"
+	}
 
-		for _, col := range df.columns {
-			if !idSet[col] {
-				valueVars = append(valueVars, col)
+	// Determine value column groups
+	groups := meltOpts.groups
+	if len(groups) == 0 {
+		if len(valueVars) == 0 {
+			// Use all non-id columns
+			for _, col := range df.columns {
+				if !idSet[col] {
+					valueVars = append(valueVars, col)
+				}
 			}
 		}
-	} else {
-		// Validate value columns
-		for _, col := range valueVars {
+		groups = []MeltGroup{{Name: valueName, Columns: valueVars}}
+	}
+
+	nvars := len(groups[0].Columns)
+	if nvars == 0 {
+		return nil, fmt.Errorf("no value columns to melt")
+	}
+
+	for _, group := range groups {
+		if len(group.Columns) != nvars {
+			return nil, fmt.Errorf("melt group %q has %d columns, expected %d: %w",
+				group.Name, len(group.Columns), nvars, core.ErrInvalidShape)
+		}
+		for _, col := range group.Columns {
 			if !df.HasColumn(col) {
 				return nil, fmt.Errorf("value column %q: %w", col, core.ErrColumnNotFound)
 			}
+			if idSet[col] {
+				return nil, fmt.Errorf("column %q is both an id and a value column: %w", col, core.ErrInvalidArgument)
+			}
 		}
 	}
 
-	if len(valueVars) == 0 {
-		return nil, fmt.Errorf("no value columns to melt")
+	// Output column names must be unique
+	columns := make([]string, 0, len(idVars)+1+len(groups))
+	columns = append(columns, idVars...)
+	columns = append(columns, varName)
+	for _, group := range groups {
+		columns = append(columns, group.Name)
 	}
-
-	// Default names
-	if varName == "" {
-		varName = "variable"
-	}
-	if valueName == "" {
-		valueName = "value"
+	seen := make(map[string]bool, len(columns))
+	for _, col := range columns {
+		if seen[col] {
+			return nil, fmt.Errorf("output column %q: %w", col, core.ErrDuplicateColumn)
+		}
+		seen[col] = true
 	}
 
 	// Calculate result size
-	resultRows := df.nrows * len(valueVars)
-	
-	// Build melted data
-	meltedData := make(map[string][]any)
-
-	// Initialize columns
-	for _, col := range idVars {
-		meltedData[col] = make([]any, resultRows)
+	resultRows := df.nrows * nvars
+	seriesMap := make(map[string]*series.Series[any], len(columns))
+
+	// Id columns repeat each row nvars times
+	for _, idCol := range idVars {
+		src := df.series[idCol]
+		data := make([]any, resultRows)
+		nulls := make([]int, 0)
+		for i := 0; i < df.nrows; i++ {
+			val, ok := src.Get(i)
+			for k := 0; k < nvars; k++ {
+				row := i*nvars + k
+				if ok {
+					data[row] = val
+				} else {
+					nulls = append(nulls, row)
+				}
+			}
+		}
+		seriesMap[idCol] = newSeriesWithNulls(idCol, data, src.Dtype(), nulls)
 	}
-	meltedData[varName] = make([]any, resultRows)
-	meltedData[valueName] = make([]any, resultRows)
 
-	// Fill melted data
-	rowIdx := 0
+	// Variable column cycles through the labels of the first group
+	varData := make([]any, resultRows)
 	for i := 0; i < df.nrows; i++ {
-		for _, valCol := range valueVars {
-			// Copy id values
-			for _, idCol := range idVars {
-				s := df.series[idCol]
-				val, ok := s.Get(i)
-				if ok {
-					meltedData[idCol][rowIdx] = val
-				} else {
-					meltedData[idCol][rowIdx] = nil
+		for k, col := range groups[0].Columns {
+			varData[i*nvars+k] = col
+		}
+	}
+	seriesMap[varName] = series.New(varName, varData, core.DtypeString)
+
+	// One value column per group
+	for _, group := range groups {
+		dtype := commonDtype(df, group.Columns)
+		data := make([]any, resultRows)
+		nulls := make([]int, 0)
+		for k, col := range group.Columns {
+			src := df.series[col]
+			for i := 0; i < df.nrows; i++ {
+				row := i*nvars + k
+				val, ok := src.Get(i)
+				if !ok || val == nil {
+					nulls = append(nulls, row)
+					continue
+				}
+				switch {
+				case dtype == core.DtypeFloat64:
+					val, _ = core.ToFloat64(val)
+				case dtype == core.DtypeString && src.Dtype() != core.DtypeString:
+					val = fmt.Sprintf("%v", val)
 				}
+				data[row] = val
 			}
+		}
+		seriesMap[group.Name] = newSeriesWithNulls(group.Name, data, dtype, nulls)
+	}
 
-			// Add variable name
-			meltedData[varName][rowIdx] = valCol
+	return &DataFrame{
+		columns: columns,
+		series:  seriesMap,
+		index:   NewRangeIndex(0, resultRows, 1),
+		nrows:   resultRows,
+	}, nil
+}
 
-			// Add value
-			s := df.series[valCol]
-			val, ok := s.Get(i)
-			if ok {
-				meltedData[valueName][rowIdx] = val
-			} else {
-				meltedData[valueName][rowIdx] = nil
-			}
+// newSeriesWithNulls creates a Series with the given positions marked null.
+func newSeriesWithNulls(name string, data []any, dtype core.Dtype, nulls []int) *series.Series[any] {
+	s := series.New(name, data, dtype)
+	for _, i := range nulls {
+		s.SetNull(i)
+	}
+	return s
+}
 
-			rowIdx++
-		}
+// commonDtype returns the common dtype of cols. Numeric columns of mixed
+// Int64/Float64 widen to Float64; any other mix falls back to String.
+func commonDtype(df *DataFrame, cols []string) core.Dtype {
+	dtype := df.series[cols[0]].Dtype()
+	for _, col := range cols[1:] {
+		dtype = widenDtype(dtype, df.series[col].Dtype())
 	}
+	return dtype
+}
 
-	// Convert map[string][]any to map[string]any
-	convertedData := make(map[string]any, len(meltedData))
-	for key, val := range meltedData {
-		convertedData[key] = val
+// widenDtype returns a dtype that can hold values of both a and b, as
+// commonDtype does for a pair.
+func widenDtype(a, b core.Dtype) core.Dtype {
+	switch {
+	case a == b:
+		return a
+	case core.IsNumeric(a) && core.IsNumeric(b):
+		return core.DtypeFloat64
+	default:
+		return core.DtypeString
+	}
+}
+
+// widenedSeries builds a Series of dtype from values whose own dtypes
+// widenDtype widened to dtype: numbers become float64 for Float64, values
+// are formatted for String, and nils are marked null.
+func widenedSeries(name string, values []any, dtype core.Dtype) *series.Series[any] {
+	data := make([]any, len(values))
+	var nulls []int
+	for i, val := range values {
+		switch {
+		case val == nil:
+			nulls = append(nulls, i)
+			continue
+		case dtype == core.DtypeFloat64:
+			val, _ = core.ToFloat64(val)
+		case dtype == core.DtypeString:
+			if _, isString := val.(string); !isString {
+				val = fmt.Sprintf("%v", val)
+			}
+		}
+		data[i] = val
 	}
-	return New(convertedData)
+	return newSeriesWithNulls(name, data, dtype, nulls)
 }
 
 // Stack pivots columns into rows (multi-level index).
@@ -236,50 +510,247 @@ func (df *DataFrame) Unstack(rowCol, colCol, valueCol string) (*DataFrame, error
 }
 
 // Transpose swaps rows and columns.
+// The result has one column per original row, named by the original row
+// labels, and one row per original column. Its index is a StringIndex of the
+// original column names. Values keep their dtype when all columns share one;
+// Int64 and Float64 columns widen to Float64, and any other mix becomes String.
 func (df *DataFrame) Transpose() (*DataFrame, error) {
 	df.mu.RLock()
 	defer df.mu.RUnlock()
 
-	// Build transposed data
-	transposedData := make(map[string][]any)
+	if len(df.columns) == 0 {
+		return &DataFrame{
+			columns: []string{},
+			series:  make(map[string]*series.Series[any]),
+			index:   NewStringIndex([]string{}),
+			nrows:   0,
+		}, nil
+	}
 
-	// Use original column names as row identifiers
-	rowNames := make([]string, df.nrows)
+	// Original row labels become column names
+	newColumns := make([]string, df.nrows)
+	seen := make(map[string]bool, df.nrows)
 	for i := 0; i < df.nrows; i++ {
-		rowNames[i] = fmt.Sprintf("row_%d", i)
+		var label any = i
+		if df.index != nil {
+			label = df.index.Get(i)
+		}
+		newColumns[i] = fmt.Sprintf("%v", label)
+		if seen[newColumns[i]] {
+			return nil, fmt.Errorf("row label %q: %w", newColumns[i], core.ErrDuplicateColumn)
+		}
+		seen[newColumns[i]] = true
 	}
 
-	// Each original column becomes a row
+	dtype := commonDtype(df, df.columns)
+	ncols := len(df.columns)
+
+	// Original column j becomes row j; original row i becomes column i
+	seriesMap := make(map[string]*series.Series[any], df.nrows)
+	for i, name := range newColumns {
+		data := make([]any, ncols)
+		nulls := make([]int, 0)
+		for j, col := range df.columns {
+			src := df.series[col]
+			val, ok := src.Get(i)
+			if !ok || val == nil {
+				nulls = append(nulls, j)
+				continue
+			}
+			switch {
+			case dtype == core.DtypeFloat64:
+				val, _ = core.ToFloat64(val)
+			case dtype == core.DtypeString && src.Dtype() != core.DtypeString:
+				val = fmt.Sprintf("%v", val)
+			}
+			data[j] = val
+		}
+		seriesMap[name] = newSeriesWithNulls(name, data, dtype, nulls)
+	}
+
+	rowLabels := make([]string, ncols)
+	copy(rowLabels, df.columns)
+
+	return &DataFrame{
+		columns: newColumns,
+		series:  seriesMap,
+		index:   NewStringIndex(rowLabels),
+		nrows:   ncols,
+	}, nil
+}
+
+// GetDummies converts categorical columns into indicator columns named
+// prefix_value, one per distinct value in sorted order. Unlike the stateful
+// encoders, categories are taken from this DataFrame alone.
+// cols: columns to encode (if empty, use all string and category columns)
+// Encoded columns are dropped; indicators are appended after the remaining
+// columns. Null values produce zeros in every indicator.
+func (df *DataFrame) GetDummies(cols []string, opts ...DummyOption) (*DataFrame, error) {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	dummyOpts := &DummyOptions{
+		prefixSep: "_",
+	}
+	for _, opt := range opts {
+		opt(dummyOpts)
+	}
+
+	// Determine columns to encode
+	if len(cols) == 0 {
+		for _, col := range df.columns {
+			dtype := df.series[col].Dtype()
+			if dtype == core.DtypeString || dtype == core.DtypeCategory {
+				cols = append(cols, col)
+			}
+		}
+	} else {
+		for _, col := range cols {
+			if _, exists := df.series[col]; !exists {
+				return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
+			}
+		}
+	}
+
+	encodeSet := make(map[string]bool, len(cols))
+	for _, col := range cols {
+		encodeSet[col] = true
+	}
+
+	// Keep the columns that are not encoded
+	newColumns := make([]string, 0, len(df.columns))
+	newSeries := make(map[string]*series.Series[any])
 	for _, col := range df.columns {
+		if !encodeSet[col] {
+			newColumns = append(newColumns, col)
+			newSeries[col] = df.series[col]
+		}
+	}
+
+	for _, col := range cols {
 		s := df.series[col]
-		colData := make([]any, df.nrows)
-		
+
+		// Collect the string form of each row and the sorted categories
+		keys := make([]string, df.nrows)
+		present := make([]bool, df.nrows)
+		seen := make(map[string]bool)
+		categories := make([]string, 0)
 		for i := 0; i < df.nrows; i++ {
 			val, ok := s.Get(i)
-			if ok {
-				colData[i] = val
-			} else {
-				colData[i] = nil
+			if !ok || val == nil {
+				continue
+			}
+			keys[i] = fmt.Sprintf("%v", val)
+			present[i] = true
+			if !seen[keys[i]] {
+				seen[keys[i]] = true
+				categories = append(categories, keys[i])
 			}
 		}
-		
-		transposedData[col] = colData
+		sort.Strings(categories)
+
+		if dummyOpts.dropFirst && len(categories) > 0 {
+			categories = categories[1:]
+		}
+
+		prefix := col
+		if p, ok := dummyOpts.prefixes[col]; ok {
+			prefix = p
+		}
+
+		for _, category := range categories {
+			name := prefix + dummyOpts.prefixSep + category
+			if _, exists := newSeries[name]; exists {
+				return nil, fmt.Errorf("indicator column %q: %w", name, core.ErrDuplicateColumn)
+			}
+
+			indicator := make([]any, df.nrows)
+			for i := 0; i < df.nrows; i++ {
+				if present[i] && keys[i] == category {
+					indicator[i] = int64(1)
+				} else {
+					indicator[i] = int64(0)
+				}
+			}
+
+			newColumns = append(newColumns, name)
+			newSeries[name] = series.New(name, indicator, core.DtypeInt64)
+		}
 	}
 
-	// Note: This creates a transposed DataFrame where:
-	// - Original columns become rows
-	// - Original rows become columns
-	// Convert map[string][]any to map[string]any
-	convertedTransposedData := make(map[string]any, len(transposedData))
-	for key, val := range transposedData {
-		convertedTransposedData[key] = val
+	return &DataFrame{
+		columns: newColumns,
+		series:  newSeries,
+		index:   df.index,
+		nrows:   df.nrows,
+	}, nil
+}
+
+// Explode expands a list-valued column so each list element gets its own row.
+// Other columns are repeated for every element. Any slice value (for example
+// []any from JSON arrays) counts as a list; an empty list produces a single
+// row with a null in col, and non-list values pass through unchanged.
+// The result has a new RangeIndex.
+func (df *DataFrame) Explode(col string) (*DataFrame, error) {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	src, exists := df.series[col]
+	if !exists {
+		return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
 	}
-	return New(convertedTransposedData)
+
+	positions := make([]int, 0, df.nrows)
+	exploded := make([]any, 0, df.nrows)
+	nulls := make([]int, 0)
+
+	for i := 0; i < df.nrows; i++ {
+		val, ok := src.Get(i)
+		if !ok || val == nil {
+			nulls = append(nulls, len(exploded))
+			positions = append(positions, i)
+			exploded = append(exploded, nil)
+			continue
+		}
+
+		rv := reflect.ValueOf(val)
+		if rv.Kind() != reflect.Slice {
+			positions = append(positions, i)
+			exploded = append(exploded, val)
+			continue
+		}
+
+		if rv.Len() == 0 {
+			nulls = append(nulls, len(exploded))
+			positions = append(positions, i)
+			exploded = append(exploded, nil)
+			continue
+		}
+
+		for k := 0; k < rv.Len(); k++ {
+			elem := rv.Index(k).Interface()
+			if elem == nil {
+				nulls = append(nulls, len(exploded))
+			}
+			positions = append(positions, i)
+			exploded = append(exploded, elem)
+		}
+	}
+
+	result := df.iloc(positions)
+	result.series[col] = newSeriesWithNulls(col, exploded, inferDtype(exploded), nulls)
+
+	return result, nil
 }
 
 // Helper function to get unique values from a column.
 func (df *DataFrame) uniqueValues(col string) []any {
-	s := df.series[col]
+	return uniqueSeriesValues(df.series[col])
+}
+
+// uniqueSeriesValues returns the distinct non-null values of s in order of
+// first appearance. Values are compared by their string form.
+func uniqueSeriesValues(s *series.Series[any]) []any {
 	seen := make(map[string]bool)
 	unique := make([]any, 0)
 
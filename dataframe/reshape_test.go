@@ -0,0 +1,455 @@
+package dataframe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func TestGetDummies(t *testing.T) {
+	df, err := New(map[string]any{
+		"color": []string{"red", "blue", "red", "green"},
+		"size":  []string{"S", "L", "M", "S"},
+		"price": []float64{1.0, 2.0, 3.0, 4.0},
+	})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	t.Run("AutoDetect", func(t *testing.T) {
+		result, err := df.GetDummies(nil)
+		if err != nil {
+			t.Fatalf("GetDummies failed: %v", err)
+		}
+
+		if result.Ncols() != 7 {
+			t.Fatalf("Expected 7 columns, got %d: %v", result.Ncols(), result.Columns())
+		}
+		if result.HasColumn("color") || result.HasColumn("size") {
+			t.Error("Encoded columns should be dropped")
+		}
+		if !result.HasColumn("price") {
+			t.Error("Numeric column should be kept")
+		}
+
+		expected := map[string][]int64{
+			"color_blue":  {0, 1, 0, 0},
+			"color_green": {0, 0, 0, 1},
+			"color_red":   {1, 0, 1, 0},
+			"size_L":      {0, 1, 0, 0},
+			"size_M":      {0, 0, 1, 0},
+			"size_S":      {1, 0, 0, 1},
+		}
+		for name, want := range expected {
+			s, err := result.Column(name)
+			if err != nil {
+				t.Fatalf("Missing indicator column %q", name)
+			}
+			for i, w := range want {
+				if val, _ := s.Get(i); val != w {
+					t.Errorf("%s[%d]: expected %d, got %v", name, i, w, val)
+				}
+			}
+		}
+
+		// Kept columns come first; indicators of one column stay together in
+		// sorted category order
+		cols := result.Columns()
+		if cols[0] != "price" {
+			t.Errorf("Expected kept column first, got %v", cols)
+		}
+		for i, col := range cols {
+			if col == "size_L" && (i+2 >= len(cols) || cols[i+1] != "size_M" || cols[i+2] != "size_S") {
+				t.Errorf("Expected contiguous sorted size indicators, got %v", cols)
+			}
+		}
+	})
+
+	t.Run("DropFirst", func(t *testing.T) {
+		result, err := df.GetDummies([]string{"color"}, DropFirst())
+		if err != nil {
+			t.Fatalf("GetDummies failed: %v", err)
+		}
+
+		if result.HasColumn("color_blue") {
+			t.Error("First category should be dropped")
+		}
+		if !result.HasColumn("color_green") || !result.HasColumn("color_red") {
+			t.Errorf("Expected remaining indicators, got %v", result.Columns())
+		}
+		if !result.HasColumn("size") {
+			t.Error("Columns not listed should be left untouched")
+		}
+	})
+
+	t.Run("Prefix", func(t *testing.T) {
+		result, err := df.GetDummies([]string{"size"}, WithPrefix(map[string]string{"size": "sz"}), WithPrefixSep("="))
+		if err != nil {
+			t.Fatalf("GetDummies failed: %v", err)
+		}
+		for _, name := range []string{"sz=L", "sz=M", "sz=S"} {
+			if !result.HasColumn(name) {
+				t.Errorf("Expected column %q, got %v", name, result.Columns())
+			}
+		}
+	})
+
+	t.Run("Nulls", func(t *testing.T) {
+		withNull, _ := FromRecords([]map[string]any{
+			{"c": "a"},
+			{"c": nil},
+			{"c": "b"},
+		})
+		result, err := withNull.GetDummies([]string{"c"})
+		if err != nil {
+			t.Fatalf("GetDummies failed: %v", err)
+		}
+		for _, name := range []string{"c_a", "c_b"} {
+			s, _ := result.Column(name)
+			if val, _ := s.Get(1); val != int64(0) {
+				t.Errorf("%s: expected 0 for null row, got %v", name, val)
+			}
+		}
+	})
+
+	t.Run("MissingColumn", func(t *testing.T) {
+		_, err := df.GetDummies([]string{"missing"})
+		if !errors.Is(err, core.ErrColumnNotFound) {
+			t.Errorf("Expected ErrColumnNotFound, got %v", err)
+		}
+	})
+}
+
+func TestPivotTable(t *testing.T) {
+	df, _ := New(map[string]any{
+		"store":   []string{"s1", "s1", "s1", "s2", "s2"},
+		"product": []string{"apple", "apple", "pear", "apple", "apple"},
+		"sales":   []float64{10, 5, 3, 7, 8},
+	})
+
+	result, err := df.PivotTable("store", "product", "sales", AggSum)
+	if err != nil {
+		t.Fatalf("PivotTable failed: %v", err)
+	}
+	if result.Nrows() != 2 {
+		t.Fatalf("Expected 2 rows, got %d", result.Nrows())
+	}
+
+	stores, _ := result.Column("store")
+	apple, _ := result.Column("apple")
+	pear, _ := result.Column("pear")
+
+	expectedApple := map[string]float64{"s1": 15, "s2": 15}
+	for i := 0; i < result.Nrows(); i++ {
+		store, _ := stores.Get(i)
+		if val, _ := apple.Get(i); val != expectedApple[store.(string)] {
+			t.Errorf("apple[%v]: expected %v, got %v", store, expectedApple[store.(string)], val)
+		}
+		pearVal, _ := pear.Get(i)
+		if store == "s1" && pearVal != 3.0 {
+			t.Errorf("pear[s1]: expected 3, got %v", pearVal)
+		}
+		if store == "s2" && pearVal != nil {
+			t.Errorf("pear[s2]: expected no value, got %v", pearVal)
+		}
+	}
+
+	counts, err := df.PivotTable("store", "product", "sales", AggCount)
+	if err != nil {
+		t.Fatalf("PivotTable count failed: %v", err)
+	}
+	countApple, _ := counts.Column("apple")
+	if val, _ := countApple.Get(0); val != int64(2) {
+		t.Errorf("Expected count 2, got %v", val)
+	}
+
+	if _, err := df.PivotTable("store", "product", "sales", "bogus"); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument for unknown aggregation, got %v", err)
+	}
+}
+
+func TestPivotColumnOrder(t *testing.T) {
+	df, _ := New(map[string]any{
+		"id":    []int64{1, 1, 1, 2, 2, 2},
+		"month": []int64{12, 3, 10, 3, 12, 10},
+		"value": []float64{1, 2, 3, 4, 5, 6},
+	})
+
+	expected := []string{"id", "3", "10", "12"}
+	for run := 0; run < 20; run++ {
+		result, err := df.Pivot("id", "month", "value")
+		if err != nil {
+			t.Fatalf("Pivot failed: %v", err)
+		}
+
+		cols := result.Columns()
+		if len(cols) != len(expected) {
+			t.Fatalf("Expected columns %v, got %v", expected, cols)
+		}
+		for i, col := range expected {
+			if cols[i] != col {
+				t.Fatalf("Run %d: expected columns %v, got %v", run, expected, cols)
+			}
+		}
+	}
+
+	result, _ := df.PivotTable("id", "month", "value", AggSum)
+	cols := result.Columns()
+	for i, col := range expected {
+		if cols[i] != col {
+			t.Fatalf("PivotTable: expected columns %v, got %v", expected, cols)
+		}
+	}
+
+	// Missing cells are null
+	sparse, _ := New(map[string]any{
+		"k": []string{"a", "b"},
+		"c": []string{"x", "y"},
+		"v": []float64{1, 2},
+	})
+	pivoted, _ := sparse.Pivot("k", "c", "v")
+	x, _ := pivoted.Column("x")
+	if !x.IsNull(1) {
+		t.Error("Expected missing cell to be null")
+	}
+}
+
+func TestMeltDtypes(t *testing.T) {
+	df, _ := New(map[string]any{
+		"id":     []string{"a", "b"},
+		"height": []float64{1.5, 1.8},
+		"weight": []float64{60, 80},
+	})
+
+	result, err := df.Melt([]string{"id"}, []string{"height", "weight"}, "measure", "amount")
+	if err != nil {
+		t.Fatalf("Melt failed: %v", err)
+	}
+
+	expectedCols := []string{"id", "measure", "amount"}
+	cols := result.Columns()
+	for i, col := range expectedCols {
+		if cols[i] != col {
+			t.Fatalf("Expected columns %v, got %v", expectedCols, cols)
+		}
+	}
+
+	amount, _ := result.Column("amount")
+	if amount.Dtype() != core.DtypeFloat64 {
+		t.Errorf("Expected float64 value column, got %v", amount.Dtype())
+	}
+
+	expected := []struct {
+		id      string
+		measure string
+		amount  float64
+	}{
+		{"a", "height", 1.5},
+		{"a", "weight", 60},
+		{"b", "height", 1.8},
+		{"b", "weight", 80},
+	}
+	id, _ := result.Column("id")
+	measure, _ := result.Column("measure")
+	for i, want := range expected {
+		gotID, _ := id.Get(i)
+		gotMeasure, _ := measure.Get(i)
+		gotAmount, _ := amount.Get(i)
+		if gotID != want.id || gotMeasure != want.measure || gotAmount != want.amount {
+			t.Errorf("Row %d: expected %v, got (%v, %v, %v)", i, want, gotID, gotMeasure, gotAmount)
+		}
+	}
+
+	// Int64 and Float64 value columns widen to Float64
+	mixed, _ := New(map[string]any{
+		"id": []string{"a"},
+		"n":  []int64{3},
+		"x":  []float64{0.5},
+	})
+	widened, err := mixed.Melt([]string{"id"}, nil, "", "")
+	if err != nil {
+		t.Fatalf("Melt failed: %v", err)
+	}
+	value, _ := widened.Column("value")
+	if value.Dtype() != core.DtypeFloat64 {
+		t.Errorf("Expected float64 for mixed numeric columns, got %v", value.Dtype())
+	}
+	for i := 0; i < value.Len(); i++ {
+		if val, _ := value.Get(i); val != 3.0 && val != 0.5 {
+			t.Errorf("Unexpected widened value %v (%T)", val, val)
+		}
+	}
+
+	if _, err := df.Melt([]string{"id"}, []string{"id", "height"}, "", ""); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument for overlapping id/value columns, got %v", err)
+	}
+}
+
+func TestMeltGroups(t *testing.T) {
+	df, _ := New(map[string]any{
+		"store":     []string{"s1", "s2"},
+		"sales_q1":  []float64{10, 20},
+		"sales_q2":  []float64{11, 21},
+		"visits_q1": []int64{100, 200},
+		"visits_q2": []int64{110, 210},
+	})
+
+	result, err := df.Melt([]string{"store"}, nil, "quarter", "",
+		MeltGroups(
+			MeltGroup{Name: "sales", Columns: []string{"sales_q1", "sales_q2"}},
+			MeltGroup{Name: "visits", Columns: []string{"visits_q1", "visits_q2"}},
+		))
+	if err != nil {
+		t.Fatalf("Melt failed: %v", err)
+	}
+
+	if result.Nrows() != 4 || result.Ncols() != 4 {
+		t.Fatalf("Expected 4x4 result, got %dx%d: %v", result.Nrows(), result.Ncols(), result.Columns())
+	}
+
+	sales, _ := result.Column("sales")
+	visits, _ := result.Column("visits")
+	quarter, _ := result.Column("quarter")
+	if sales.Dtype() != core.DtypeFloat64 || visits.Dtype() != core.DtypeInt64 {
+		t.Errorf("Expected dtypes float64/int64, got %v/%v", sales.Dtype(), visits.Dtype())
+	}
+	if val, _ := quarter.Get(1); val != "sales_q2" {
+		t.Errorf("Expected variable label sales_q2, got %v", val)
+	}
+	if val, _ := sales.Get(3); val != 21.0 {
+		t.Errorf("Expected sales 21 in row 3, got %v", val)
+	}
+	if val, _ := visits.Get(3); val != int64(210) {
+		t.Errorf("Expected visits 210 in row 3, got %v", val)
+	}
+
+	_, err = df.Melt([]string{"store"}, nil, "", "",
+		MeltGroups(
+			MeltGroup{Name: "sales", Columns: []string{"sales_q1", "sales_q2"}},
+			MeltGroup{Name: "visits", Columns: []string{"visits_q1"}},
+		))
+	if !errors.Is(err, core.ErrInvalidShape) {
+		t.Errorf("Expected ErrInvalidShape for uneven groups, got %v", err)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	base, _ := New(map[string]any{
+		"a": []float64{1, 4},
+		"b": []float64{2, 5},
+		"c": []float64{3, 6},
+	})
+	df := base.Select("a", "b", "c")
+
+	result, err := df.Transpose()
+	if err != nil {
+		t.Fatalf("Transpose failed: %v", err)
+	}
+
+	if result.Nrows() != 3 || result.Ncols() != 2 {
+		t.Fatalf("Expected 3x2 result, got %dx%d", result.Nrows(), result.Ncols())
+	}
+
+	cols := result.Columns()
+	if cols[0] != "0" || cols[1] != "1" {
+		t.Errorf("Expected columns [0 1] from row labels, got %v", cols)
+	}
+
+	idx := result.Index()
+	for j, name := range []string{"a", "b", "c"} {
+		if label := idx.Get(j); label != name {
+			t.Errorf("Index %d: expected %s, got %v", j, name, label)
+		}
+	}
+
+	// result[j][i] == df[i][j]
+	expected := [][]float64{{1, 4}, {2, 5}, {3, 6}}
+	for j, row := range expected {
+		for i, want := range row {
+			s, _ := result.Column(cols[i])
+			if val, _ := s.Get(j); val != want {
+				t.Errorf("Cell (%d, %d): expected %v, got %v", j, i, want, val)
+			}
+		}
+	}
+
+	s, _ := result.Column("0")
+	if s.Dtype() != core.DtypeFloat64 {
+		t.Errorf("Expected float64 columns, got %v", s.Dtype())
+	}
+
+	// Transposing twice restores the values
+	back, err := result.Transpose()
+	if err != nil {
+		t.Fatalf("Transpose back failed: %v", err)
+	}
+	b, _ := back.Column("b")
+	if val, _ := b.Get(1); val != 5.0 {
+		t.Errorf("Expected b[1] = 5 after double transpose, got %v", val)
+	}
+
+	// Mixed dtypes become strings
+	mixed, _ := New(map[string]any{
+		"name": []string{"x"},
+		"n":    []int64{1},
+	})
+	mixedT, err := mixed.Select("name", "n").Transpose()
+	if err != nil {
+		t.Fatalf("Transpose failed: %v", err)
+	}
+	m, _ := mixedT.Column("0")
+	if m.Dtype() != core.DtypeString {
+		t.Errorf("Expected string dtype for mixed frame, got %v", m.Dtype())
+	}
+	if val, _ := m.Get(1); val != "1" {
+		t.Errorf("Expected \"1\", got %v", val)
+	}
+}
+
+func TestExplode(t *testing.T) {
+	df, _ := FromRecords([]map[string]any{
+		{"id": int64(1), "tags": []any{"a", "b", "c"}},
+		{"id": int64(2), "tags": []any{}},
+		{"id": int64(3), "tags": "solo"},
+		{"id": int64(4), "tags": []any{"d", "e"}},
+	})
+
+	result, err := df.Explode("tags")
+	if err != nil {
+		t.Fatalf("Explode failed: %v", err)
+	}
+
+	// 3 + 1 (empty list) + 1 (scalar) + 2
+	if result.Nrows() != 7 {
+		t.Fatalf("Expected 7 rows, got %d", result.Nrows())
+	}
+
+	ids, _ := result.Column("id")
+	tags, _ := result.Column("tags")
+	expectedIDs := []int64{1, 1, 1, 2, 3, 4, 4}
+	expectedTags := []any{"a", "b", "c", nil, "solo", "d", "e"}
+	for i := range expectedIDs {
+		if val, _ := ids.Get(i); val != expectedIDs[i] {
+			t.Errorf("Row %d: expected id %d, got %v", i, expectedIDs[i], val)
+		}
+		val, ok := tags.Get(i)
+		if expectedTags[i] == nil {
+			if ok {
+				t.Errorf("Row %d: expected null tag, got %v", i, val)
+			}
+			continue
+		}
+		if val != expectedTags[i] {
+			t.Errorf("Row %d: expected tag %v, got %v", i, expectedTags[i], val)
+		}
+	}
+
+	if tags.Dtype() != core.DtypeString {
+		t.Errorf("Expected string dtype for exploded column, got %v", tags.Dtype())
+	}
+
+	if _, err := df.Explode("missing"); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("Expected ErrColumnNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,313 @@
+package dataframe
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+// SampleOptions configures Sample and StratifiedSample.
+type SampleOptions struct {
+	seed    int64  // Random seed
+	seeded  bool   // Whether seed was set
+	replace bool   // Sample with replacement
+	weights string // Column of sampling weights ("" for uniform)
+}
+
+// SampleOption is a functional option for Sample and StratifiedSample.
+type SampleOption func(*SampleOptions)
+
+// WithSeed makes sampling reproducible. Without it, a time-based seed is used.
+func WithSeed(seed int64) SampleOption {
+	return func(opts *SampleOptions) {
+		opts.seed = seed
+		opts.seeded = true
+	}
+}
+
+// WithReplacement samples rows with replacement, so a row can appear more
+// than once and more rows than the DataFrame holds can be drawn.
+func WithReplacement() SampleOption {
+	return func(opts *SampleOptions) {
+		opts.replace = true
+	}
+}
+
+// WithWeights samples rows with probability proportional to the values of a
+// numeric column. Rows with zero weight are never drawn. Negative, NaN, or
+// null weights are an error.
+func WithWeights(col string) SampleOption {
+	return func(opts *SampleOptions) {
+		opts.weights = col
+	}
+}
+
+// Sample returns n randomly selected rows, in the order they were drawn.
+// Without replacement, n may not exceed the number of rows (or the number
+// of rows with a positive weight when WithWeights is used).
+func (df *DataFrame) Sample(n int, opts ...SampleOption) (*DataFrame, error) {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	sampleOpts := applySampleOptions(opts)
+	if n < 0 {
+		return nil, fmt.Errorf("sample size %d: %w", n, core.ErrInvalidArgument)
+	}
+
+	weights, err := df.sampleWeights(sampleOpts.weights)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]int, df.nrows)
+	for i := range rows {
+		rows[i] = i
+	}
+
+	positions, err := drawRows(rows, weights, n, sampleOpts.replace, sampleRand(sampleOpts))
+	if err != nil {
+		return nil, err
+	}
+
+	return df.iloc(positions), nil
+}
+
+// StratifiedSample samples the fraction frac of the rows within each group of
+// byCol, so every class keeps its share of the rows. Each group contributes
+// round(frac * group size) rows; nulls in byCol form their own group. The
+// sampled rows are returned in their original order. Weights and replacement
+// apply within each group.
+func (df *DataFrame) StratifiedSample(byCol string, frac float64, opts ...SampleOption) (*DataFrame, error) {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	sampleOpts := applySampleOptions(opts)
+	if frac < 0 || (frac > 1 && !sampleOpts.replace) || math.IsNaN(frac) {
+		return nil, fmt.Errorf("sample fraction %g: %w", frac, core.ErrInvalidArgument)
+	}
+
+	s, exists := df.series[byCol]
+	if !exists {
+		return nil, fmt.Errorf("column %q: %w", byCol, core.ErrColumnNotFound)
+	}
+
+	weights, err := df.sampleWeights(sampleOpts.weights)
+	if err != nil {
+		return nil, err
+	}
+
+	// Group rows by class in order of first appearance
+	groups := make(map[string][]int)
+	classes := make(map[string]any)
+	order := make([]string, 0)
+	for i := 0; i < df.nrows; i++ {
+		val, ok := s.Get(i)
+		if !ok {
+			val = nil
+		}
+		key := encodeKey([]any{val})
+		if _, seen := groups[key]; !seen {
+			order = append(order, key)
+			classes[key] = val
+		}
+		groups[key] = append(groups[key], i)
+	}
+
+	rng := sampleRand(sampleOpts)
+	positions := make([]int, 0)
+	for _, key := range order {
+		rows := groups[key]
+		n := int(math.Round(frac * float64(len(rows))))
+		drawn, err := drawRows(rows, weights, n, sampleOpts.replace, rng)
+		if err != nil {
+			return nil, fmt.Errorf("class %v of %q: %w", classes[key], byCol, err)
+		}
+		positions = append(positions, drawn...)
+	}
+	sort.Ints(positions)
+
+	return df.iloc(positions), nil
+}
+
+// ShuffleOptions configures Shuffle.
+type ShuffleOptions struct {
+	keepIndex bool // Permute the index with the rows
+}
+
+// ShuffleOption is a functional option for Shuffle.
+type ShuffleOption func(*ShuffleOptions)
+
+// KeepIndex carries each row's index label along when shuffling, instead of
+// resetting to a RangeIndex. A RangeIndex has no way to hold permuted labels
+// and is always reset; call ResetIndex first to keep the original positions
+// as a column.
+func KeepIndex(keep bool) ShuffleOption {
+	return func(opts *ShuffleOptions) {
+		opts.keepIndex = keep
+	}
+}
+
+// Shuffle returns a new DataFrame with all rows in a random order determined
+// by seed, so the same seed always gives the same order. The result has a
+// fresh RangeIndex unless KeepIndex is set.
+func (df *DataFrame) Shuffle(seed int64, opts ...ShuffleOption) *DataFrame {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	shuffleOpts := &ShuffleOptions{}
+	for _, opt := range opts {
+		opt(shuffleOpts)
+	}
+
+	// Fisher-Yates
+	rng := rand.New(rand.NewSource(seed))
+	positions := make([]int, df.nrows)
+	for i := range positions {
+		positions[i] = i
+	}
+	for i := len(positions) - 1; i > 0; i-- {
+		j := rng.Intn(i + 1)
+		positions[i], positions[j] = positions[j], positions[i]
+	}
+
+	result := df.iloc(positions)
+	if shuffleOpts.keepIndex {
+		if idx := takeIndex(df.index, positions); idx != nil {
+			result.index = idx
+		}
+	}
+	return result
+}
+
+// applySampleOptions applies opts to the default sample options.
+func applySampleOptions(opts []SampleOption) *SampleOptions {
+	sampleOpts := &SampleOptions{}
+	for _, opt := range opts {
+		opt(sampleOpts)
+	}
+	return sampleOpts
+}
+
+// sampleRand returns the random source for a sampling call.
+func sampleRand(opts *SampleOptions) *rand.Rand {
+	seed := opts.seed
+	if !opts.seeded {
+		seed = time.Now().UnixNano()
+	}
+	return rand.New(rand.NewSource(seed))
+}
+
+// sampleWeights reads the weight column, or returns nil for uniform sampling.
+// Must be called with the lock held.
+func (df *DataFrame) sampleWeights(col string) ([]float64, error) {
+	if col == "" {
+		return nil, nil
+	}
+
+	s, exists := df.series[col]
+	if !exists {
+		return nil, fmt.Errorf("weight column %q: %w", col, core.ErrColumnNotFound)
+	}
+
+	if !core.IsNumeric(s.Dtype()) {
+		return nil, fmt.Errorf("weight column %q has dtype %s: %w", col, s.Dtype(), core.ErrTypeMismatch)
+	}
+
+	weights := make([]float64, df.nrows)
+	for i := range weights {
+		val, ok := s.Get(i)
+		if !ok || val == nil {
+			return nil, fmt.Errorf("weight column %q row %d: %w", col, i, core.ErrNullValue)
+		}
+		w, _ := core.ToFloat64(val)
+		if w < 0 || math.IsNaN(w) {
+			return nil, fmt.Errorf("weight column %q row %d has invalid weight %v: %w", col, i, w, core.ErrInvalidArgument)
+		}
+		weights[i] = w
+	}
+	return weights, nil
+}
+
+// drawRows draws n of rows. weights is indexed by row position and may be nil
+// for uniform sampling.
+func drawRows(rows []int, weights []float64, n int, replace bool, rng *rand.Rand) ([]int, error) {
+	if weights == nil {
+		if replace {
+			if n > 0 && len(rows) == 0 {
+				return nil, fmt.Errorf("cannot sample %d rows from an empty frame: %w", n, core.ErrInvalidArgument)
+			}
+			drawn := make([]int, n)
+			for i := range drawn {
+				drawn[i] = rows[rng.Intn(len(rows))]
+			}
+			return drawn, nil
+		}
+
+		if n > len(rows) {
+			return nil, fmt.Errorf("cannot sample %d of %d rows without replacement: %w", n, len(rows), core.ErrInvalidArgument)
+		}
+		perm := rng.Perm(len(rows))
+		drawn := make([]int, n)
+		for i := range drawn {
+			drawn[i] = rows[perm[i]]
+		}
+		return drawn, nil
+	}
+
+	// Only rows with a positive weight can be drawn
+	candidates := make([]int, 0, len(rows))
+	total := 0.0
+	for _, r := range rows {
+		if weights[r] > 0 {
+			candidates = append(candidates, r)
+			total += weights[r]
+		}
+	}
+
+	if replace {
+		if n > 0 && len(candidates) == 0 {
+			return nil, fmt.Errorf("cannot sample %d rows: all weights are zero: %w", n, core.ErrInvalidArgument)
+		}
+		cumulative := make([]float64, len(candidates))
+		sum := 0.0
+		for i, r := range candidates {
+			sum += weights[r]
+			cumulative[i] = sum
+		}
+		drawn := make([]int, n)
+		for i := range drawn {
+			k := sort.SearchFloat64s(cumulative, rng.Float64()*total)
+			if k >= len(candidates) {
+				k = len(candidates) - 1
+			}
+			drawn[i] = candidates[k]
+		}
+		return drawn, nil
+	}
+
+	if n > len(candidates) {
+		return nil, fmt.Errorf("cannot sample %d of %d positive-weight rows without replacement: %w", n, len(candidates), core.ErrInvalidArgument)
+	}
+
+	// Efraimidis-Spirakis: keep the n largest keys log(u)/w
+	keys := make([]float64, len(candidates))
+	for i, r := range candidates {
+		keys[i] = math.Log(1-rng.Float64()) / weights[r]
+	}
+	order := make([]int, len(candidates))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return keys[order[a]] > keys[order[b]]
+	})
+	drawn := make([]int, n)
+	for i := range drawn {
+		drawn[i] = candidates[order[i]]
+	}
+	return drawn, nil
+}
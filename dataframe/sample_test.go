@@ -0,0 +1,208 @@
+package dataframe
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func TestSample(t *testing.T) {
+	ids := make([]int64, 100)
+	for i := range ids {
+		ids[i] = int64(i)
+	}
+	df, _ := New(map[string]any{"id": ids})
+
+	a, err := df.Sample(10, WithSeed(7))
+	if err != nil {
+		t.Fatalf("Sample failed: %v", err)
+	}
+	b, _ := df.Sample(10, WithSeed(7))
+	if !a.Equals(b) {
+		t.Error("Expected the same seed to draw the same rows")
+	}
+
+	col, _ := a.Column("id")
+	seen := make(map[any]bool)
+	for i := 0; i < col.Len(); i++ {
+		v, _ := col.Get(i)
+		if seen[v] {
+			t.Errorf("Row %v drawn twice without replacement", v)
+		}
+		seen[v] = true
+	}
+
+	if _, err := df.Sample(101); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument for oversized sample, got %v", err)
+	}
+	if big, err := df.Sample(150, WithReplacement(), WithSeed(1)); err != nil || big.Nrows() != 150 {
+		t.Errorf("Expected 150 rows with replacement, got %v", err)
+	}
+}
+
+func TestSampleWeights(t *testing.T) {
+	df, _ := New(map[string]any{
+		"id": []int64{0, 1, 2, 3},
+		"w":  []float64{1, 2, 7, 0},
+	})
+
+	draws := 20000
+	result, err := df.Sample(draws, WithWeights("w"), WithReplacement(), WithSeed(42))
+	if err != nil {
+		t.Fatalf("Sample failed: %v", err)
+	}
+
+	counts := make(map[int64]int)
+	col, _ := result.Column("id")
+	for i := 0; i < col.Len(); i++ {
+		v, _ := col.Get(i)
+		counts[v.(int64)]++
+	}
+
+	expected := map[int64]float64{0: 0.1, 1: 0.2, 2: 0.7, 3: 0}
+	for id, p := range expected {
+		got := float64(counts[id]) / float64(draws)
+		if math.Abs(got-p) > 0.02 {
+			t.Errorf("Row %d: expected share %.2f, got %.3f", id, p, got)
+		}
+	}
+
+	// Without replacement, zero-weight rows are never drawn
+	if _, err := df.Sample(4, WithWeights("w")); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument when sampling a zero-weight row, got %v", err)
+	}
+	three, err := df.Sample(3, WithWeights("w"), WithSeed(3))
+	if err != nil {
+		t.Fatalf("Sample failed: %v", err)
+	}
+	ids, _ := three.Column("id")
+	for i := 0; i < ids.Len(); i++ {
+		if v, _ := ids.Get(i); v == int64(3) {
+			t.Error("Zero-weight row should never be drawn")
+		}
+	}
+
+	negative, _ := New(map[string]any{"w": []float64{1, -1}})
+	if _, err := negative.Sample(1, WithWeights("w")); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument for negative weight, got %v", err)
+	}
+}
+
+func TestStratifiedSample(t *testing.T) {
+	labels := make([]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		switch {
+		case i%10 == 0:
+			labels = append(labels, "rare") // 100 rows
+		case i%10 < 4:
+			labels = append(labels, "mid") // 300 rows
+		default:
+			labels = append(labels, "common") // 600 rows
+		}
+	}
+	idx := make([]int64, len(labels))
+	for i := range idx {
+		idx[i] = int64(i)
+	}
+	df, _ := New(map[string]any{"label": labels, "idx": idx})
+
+	result, err := df.StratifiedSample("label", 0.2, WithSeed(5))
+	if err != nil {
+		t.Fatalf("StratifiedSample failed: %v", err)
+	}
+	if result.Nrows() != 200 {
+		t.Fatalf("Expected 200 rows, got %d", result.Nrows())
+	}
+
+	counts := make(map[string]int)
+	col, _ := result.Column("label")
+	for i := 0; i < col.Len(); i++ {
+		v, _ := col.Get(i)
+		counts[v.(string)]++
+	}
+	expected := map[string]int{"rare": 20, "mid": 60, "common": 120}
+	for label, want := range expected {
+		if counts[label] != want {
+			t.Errorf("Class %s: expected %d rows, got %d", label, want, counts[label])
+		}
+	}
+
+	// Rows keep their original order
+	positions, _ := result.Column("idx")
+	prev := int64(-1)
+	for i := 0; i < positions.Len(); i++ {
+		v, _ := positions.Get(i)
+		if v.(int64) <= prev {
+			t.Fatalf("Expected rows in original order, got %v after %v", v, prev)
+		}
+		prev = v.(int64)
+	}
+
+	if _, err := df.StratifiedSample("missing", 0.5); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("Expected ErrColumnNotFound, got %v", err)
+	}
+	if _, err := df.StratifiedSample("label", 1.5); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument for fraction above 1, got %v", err)
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	ids := make([]int64, 50)
+	names := make([]string, 50)
+	for i := range ids {
+		ids[i] = int64(i)
+		names[i] = string(rune('a'+i%26)) + string(rune('a'+i/26))
+	}
+	df, _ := New(map[string]any{"id": ids, "name": names})
+
+	a := df.Shuffle(42)
+	b := df.Shuffle(42)
+	if !a.Equals(b) {
+		t.Error("Expected the same seed to produce the same order")
+	}
+	if c := df.Shuffle(43); a.Equals(c) {
+		t.Error("Expected different seeds to produce different orders")
+	}
+	if a.Equals(df) {
+		t.Error("Expected the shuffled rows to differ from the original order")
+	}
+
+	// Every row appears exactly once and stays intact
+	idCol, _ := a.Column("id")
+	nameCol, _ := a.Column("name")
+	seen := make(map[int64]bool)
+	for i := 0; i < a.Nrows(); i++ {
+		id := idCol.GetUnsafe(i).(int64)
+		if seen[id] {
+			t.Errorf("Row %d appears twice", id)
+		}
+		seen[id] = true
+		if nameCol.GetUnsafe(i) != names[id] {
+			t.Errorf("Row %d has name %v, want %v", id, nameCol.GetUnsafe(i), names[id])
+		}
+	}
+	if len(seen) != 50 {
+		t.Errorf("Expected 50 distinct rows, got %d", len(seen))
+	}
+
+	if _, ok := a.Index().(*RangeIndex); !ok || a.Index().Get(0) != 0 {
+		t.Error("Expected a reset RangeIndex by default")
+	}
+}
+
+func TestShuffleKeepIndex(t *testing.T) {
+	df, _ := New(map[string]any{"v": []int64{1, 2, 3, 4, 5}})
+	_ = df.SetIndex(NewStringIndex([]string{"a", "b", "c", "d", "e"}))
+
+	shuffled := df.Shuffle(3, KeepIndex(true))
+	v, _ := shuffled.Column("v")
+	for i := 0; i < shuffled.Nrows(); i++ {
+		label := shuffled.Index().Get(i).(string)
+		want := int64(label[0]-'a') + 1
+		if v.GetUnsafe(i) != want {
+			t.Errorf("Row with label %q has v=%v, want %d", label, v.GetUnsafe(i), want)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package dataframe
 
 import (
 	"fmt"
+	"path"
+	"regexp"
 
 	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/series"
@@ -11,6 +13,7 @@ import (
 type Row struct {
 	df  *DataFrame
 	idx int
+	col string // the cell being decided by WhereCond or MaskCond
 }
 
 // Get returns the value in the specified column for this row.
@@ -22,6 +25,16 @@ func (r *Row) Get(col string) (any, bool) {
 	return s.Get(r.idx)
 }
 
+// Value returns the cell that WhereCond or MaskCond is deciding about,
+// which lets a condition test each cell on its own. Outside those calls
+// there is no current cell and Value returns nil, false.
+func (r *Row) Value() (any, bool) {
+	if r.col == "" {
+		return nil, false
+	}
+	return r.Get(r.col)
+}
+
 // Select returns a new DataFrame containing only the specified columns.
 // This is a view operation (zero-copy) - the underlying data is shared.
 func (df *DataFrame) Select(cols ...string) *DataFrame {
@@ -47,6 +60,86 @@ func (df *DataFrame) Select(cols ...string) *DataFrame {
 	}
 }
 
+// SelectLike returns the columns whose names match a glob pattern, in their
+// current order, as Select does. The syntax is that of path.Match: "*"
+// matches any run of characters except "/", "?" matches one character, and
+// "[...]" matches a character class. If nothing matches, the result has no
+// columns. Returns an error if the pattern is malformed.
+func (df *DataFrame) SelectLike(pattern string) (*DataFrame, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("pattern %q: %w", pattern, err)
+	}
+	return df.selectMatching(func(col string) bool {
+		matched, _ := path.Match(pattern, col)
+		return matched
+	}), nil
+}
+
+// SelectRegex returns the columns whose names match the regular expression
+// expr, in their current order, as Select does. The match is unanchored; use
+// ^ and $ to match whole names. If nothing matches, the result has no columns.
+// Returns an error if expr does not compile.
+func (df *DataFrame) SelectRegex(expr string) (*DataFrame, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("pattern %q: %w", expr, err)
+	}
+	return df.selectMatching(re.MatchString), nil
+}
+
+// SelectDtypes returns the columns whose dtype is in include and not in
+// exclude, in their current order, as Select does. A nil or empty include
+// selects every dtype, so SelectDtypes(nil, exclude) only drops columns.
+//
+// Example:
+//
+//	numeric := df.SelectDtypes(core.NumericDtypes(), nil)
+//	noText := df.SelectDtypes(nil, []core.Dtype{core.DtypeString})
+func (df *DataFrame) SelectDtypes(include []core.Dtype, exclude []core.Dtype) *DataFrame {
+	df.mu.RLock()
+	cols := df.columnsOfDtypes(include, exclude)
+	df.mu.RUnlock()
+
+	return df.Select(cols...)
+}
+
+// columnsOfDtypes returns the columns whose dtype passes the SelectDtypes
+// filter. Must be called with the lock held.
+func (df *DataFrame) columnsOfDtypes(include []core.Dtype, exclude []core.Dtype) []string {
+	cols := make([]string, 0, len(df.columns))
+	for _, col := range df.columns {
+		dtype := df.series[col].Dtype()
+		if len(include) > 0 && !containsDtype(include, dtype) {
+			continue
+		}
+		if containsDtype(exclude, dtype) {
+			continue
+		}
+		cols = append(cols, col)
+	}
+	return cols
+}
+
+func containsDtype(dtypes []core.Dtype, dtype core.Dtype) bool {
+	for _, d := range dtypes {
+		if d == dtype {
+			return true
+		}
+	}
+	return false
+}
+
+// selectMatching selects the columns for which match returns true.
+func (df *DataFrame) selectMatching(match func(col string) bool) *DataFrame {
+	cols := make([]string, 0)
+	for _, col := range df.Columns() {
+		if match(col) {
+			cols = append(cols, col)
+		}
+	}
+	return df.Select(cols...)
+}
+
 // Filter returns a new DataFrame containing only rows for which the predicate returns true.
 // This creates a copy of the data for filtered rows.
 func (df *DataFrame) Filter(fn func(*Row) bool) *DataFrame {
@@ -126,6 +219,61 @@ func (df *DataFrame) Iloc(positions ...int) *DataFrame {
 	return df.iloc(positions)
 }
 
+// IlocRange returns the rows from position start up to, but not including,
+// stop, taking every step-th row, like a Python slice. Negative start and
+// stop count from the end, so -1 is the last row, and a negative step walks
+// backwards. As Go has no None, a stop of 0 means "run off the end" in the
+// direction of step: IlocRange(-100, 0, 1) is the last 100 rows and
+// IlocRange(-1, 0, -1) is every row in reverse. To stop just before row 0
+// going backwards, use a stop of -Nrows(). Positions past either end are
+// clamped as Python does, and a step of 0 selects no rows. Like Iloc, the
+// result has a new RangeIndex.
+func (df *DataFrame) IlocRange(start, stop, step int) *DataFrame {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	return df.iloc(slicePositions(df.nrows, start, stop, step))
+}
+
+// slicePositions returns the positions IlocRange selects from n rows.
+func slicePositions(n, start, stop, step int) []int {
+	if step == 0 || n == 0 {
+		return []int{}
+	}
+
+	// normalize resolves a negative position and clamps it to [lo, hi]
+	normalize := func(pos, lo, hi int) int {
+		if pos < 0 {
+			pos += n
+		}
+		return min(max(pos, lo), hi)
+	}
+
+	var positions []int
+	if step > 0 {
+		start = normalize(start, 0, n)
+		if stop == 0 {
+			stop = n
+		} else {
+			stop = normalize(stop, 0, n)
+		}
+		for i := start; i < stop; i += step {
+			positions = append(positions, i)
+		}
+	} else {
+		start = normalize(start, -1, n-1)
+		if stop == 0 {
+			stop = -1
+		} else {
+			stop = normalize(stop, -1, n-1)
+		}
+		for i := start; i > stop; i += step {
+			positions = append(positions, i)
+		}
+	}
+	return positions
+}
+
 // iloc is the internal implementation (must be called with lock held).
 func (df *DataFrame) iloc(positions []int) *DataFrame {
 	// Validate positions
@@ -203,6 +351,40 @@ func (df *DataFrame) Drop(cols ...string) *DataFrame {
 	}
 }
 
+// DropColumns returns a new DataFrame with the specified columns removed.
+// Unlike Drop, it returns an error if any column does not exist.
+func (df *DataFrame) DropColumns(cols ...string) (*DataFrame, error) {
+	for _, col := range cols {
+		if !df.HasColumn(col) {
+			return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
+		}
+	}
+
+	return df.Drop(cols...), nil
+}
+
+// DropRows returns a new DataFrame with the rows at the given positions
+// removed. The remaining rows keep their order. Out-of-range positions are
+// ignored.
+func (df *DataFrame) DropRows(indices ...int) *DataFrame {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	dropSet := make(map[int]bool, len(indices))
+	for _, i := range indices {
+		dropSet[i] = true
+	}
+
+	keep := make([]int, 0, df.nrows)
+	for i := 0; i < df.nrows; i++ {
+		if !dropSet[i] {
+			keep = append(keep, i)
+		}
+	}
+
+	return df.iloc(keep)
+}
+
 // SliceRows returns a new DataFrame with rows from start (inclusive) to end (exclusive).
 func (df *DataFrame) SliceRows(start, end int) *DataFrame {
 	df.mu.RLock()
@@ -214,20 +396,20 @@ func (df *DataFrame) SliceRows(start, end int) *DataFrame {
 	if end > df.nrows {
 		end = df.nrows
 	}
+	newSeries := make(map[string]*series.Series[any])
+	for _, col := range df.columns {
+		newSeries[col] = df.series[col].Slice(start, end)
+	}
+
 	if start >= end {
 		return &DataFrame{
 			columns: df.columns,
-			series:  make(map[string]*series.Series[any]),
+			series:  newSeries,
 			index:   NewRangeIndex(0, 0, 1),
 			nrows:   0,
 		}
 	}
 
-	newSeries := make(map[string]*series.Series[any])
-	for _, col := range df.columns {
-		newSeries[col] = df.series[col].Slice(start, end)
-	}
-
 	newIndex := df.index
 	if df.index != nil {
 		newIndex = df.index.Slice(start, end)
@@ -0,0 +1,193 @@
+package dataframe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func TestDropColumns(t *testing.T) {
+	df, _ := New(map[string]any{
+		"a": []int64{1, 2},
+		"b": []int64{3, 4},
+		"c": []int64{5, 6},
+	})
+	df = df.Select("a", "b", "c")
+
+	result, err := df.DropColumns("a", "c")
+	if err != nil {
+		t.Fatalf("DropColumns failed: %v", err)
+	}
+	if cols := result.Columns(); len(cols) != 1 || cols[0] != "b" {
+		t.Errorf("Expected columns [b], got %v", cols)
+	}
+
+	if _, err := df.DropColumns("a", "missing"); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("Expected ErrColumnNotFound, got %v", err)
+	}
+	if df.Ncols() != 3 {
+		t.Errorf("Original DataFrame should be unchanged, got %d columns", df.Ncols())
+	}
+}
+
+func TestDropRows(t *testing.T) {
+	df, _ := New(map[string]any{
+		"v": []any{int64(10), nil, int64(30), int64(40), int64(50)},
+	})
+	col, _ := df.Column("v")
+	col.SetNull(1)
+
+	result := df.DropRows(3, 0, 99)
+	if result.Nrows() != 3 {
+		t.Fatalf("Expected 3 rows, got %d", result.Nrows())
+	}
+
+	v, _ := result.Column("v")
+	if !v.IsNull(0) {
+		t.Error("Expected row 1 to stay null")
+	}
+	expected := []any{nil, int64(30), int64(50)}
+	for i := 1; i < len(expected); i++ {
+		if got, _ := v.Get(i); got != expected[i] {
+			t.Errorf("Row %d: expected %v, got %v", i, expected[i], got)
+		}
+	}
+
+	if df.DropRows().Nrows() != df.Nrows() {
+		t.Error("Dropping no rows should keep every row")
+	}
+}
+
+func TestSelectLike(t *testing.T) {
+	df, _ := New(map[string]any{
+		"feat_a":   []int64{1, 2},
+		"id":       []int64{3, 4},
+		"feat_b":   []int64{5, 6},
+		"target":   []int64{7, 8},
+		"feat_c_x": []int64{9, 10},
+	})
+	df = df.Select("feat_a", "id", "feat_b", "target", "feat_c_x")
+
+	result, err := df.SelectLike("feat_*")
+	if err != nil {
+		t.Fatalf("SelectLike failed: %v", err)
+	}
+	expected := []string{"feat_a", "feat_b", "feat_c_x"}
+	cols := result.Columns()
+	if len(cols) != len(expected) {
+		t.Fatalf("Expected columns %v, got %v", expected, cols)
+	}
+	for i := range expected {
+		if cols[i] != expected[i] {
+			t.Errorf("Expected columns %v, got %v", expected, cols)
+		}
+	}
+
+	none, err := df.SelectLike("label_*")
+	if err != nil || none.Ncols() != 0 {
+		t.Errorf("Expected an empty frame without error, got %v columns, err %v", none.Ncols(), err)
+	}
+
+	if _, err := df.SelectLike("feat_["); err == nil {
+		t.Error("Expected an error for a malformed pattern")
+	}
+}
+
+func TestSelectRegex(t *testing.T) {
+	df, _ := New(map[string]any{
+		"x_1":   []float64{1},
+		"x_2":   []float64{2},
+		"x_10":  []float64{3},
+		"y_1":   []float64{4},
+		"x_sum": []float64{5},
+	})
+	df = df.Select("x_1", "x_2", "x_10", "y_1", "x_sum")
+
+	result, err := df.SelectRegex(`^x_\d+$`)
+	if err != nil {
+		t.Fatalf("SelectRegex failed: %v", err)
+	}
+	expected := []string{"x_1", "x_2", "x_10"}
+	cols := result.Columns()
+	if len(cols) != len(expected) {
+		t.Fatalf("Expected columns %v, got %v", expected, cols)
+	}
+	for i := range expected {
+		if cols[i] != expected[i] {
+			t.Errorf("Expected columns %v, got %v", expected, cols)
+		}
+	}
+
+	if _, err := df.SelectRegex("x_("); err == nil {
+		t.Error("Expected an error for an invalid regular expression")
+	}
+}
+
+func TestSelectDtypes(t *testing.T) {
+	df, _ := New(map[string]any{
+		"id":     []int64{1, 2},
+		"name":   []string{"a", "b"},
+		"score":  []float64{0.5, 1.5},
+		"active": []bool{true, false},
+	})
+	df = df.Select("id", "name", "score", "active")
+
+	checkCols := func(name string, got *DataFrame, expected []string) {
+		t.Helper()
+		cols := got.Columns()
+		if len(cols) != len(expected) {
+			t.Fatalf("%s: expected columns %v, got %v", name, expected, cols)
+		}
+		for i := range expected {
+			if cols[i] != expected[i] {
+				t.Errorf("%s: expected columns %v, got %v", name, expected, cols)
+			}
+		}
+	}
+
+	checkCols("numeric", df.SelectDtypes(core.NumericDtypes(), nil), []string{"id", "score"})
+	checkCols("exclude string", df.SelectDtypes(nil, []core.Dtype{core.DtypeString}), []string{"id", "score", "active"})
+	checkCols("include and exclude", df.SelectDtypes(core.NumericDtypes(), []core.Dtype{core.DtypeInt64}), []string{"score"})
+	checkCols("no match", df.SelectDtypes([]core.Dtype{core.DtypeTime}, nil), []string{})
+
+	if got := df.SelectDtypes(core.NumericDtypes(), nil).Nrows(); got != 2 {
+		t.Errorf("Expected 2 rows, got %d", got)
+	}
+}
+
+func TestIlocRange(t *testing.T) {
+	df, _ := New(map[string]any{
+		"v": []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	})
+
+	cases := []struct {
+		name              string
+		start, stop, step int
+		want              []int64
+	}{
+		{"forward", 2, 5, 1, []int64{2, 3, 4}},
+		{"forward step", 1, 8, 3, []int64{1, 4, 7}},
+		{"last three", -3, 0, 1, []int64{7, 8, 9}},
+		{"negative stop", 0, -7, 1, []int64{0, 1, 2}},
+		{"reverse all", -1, 0, -1, []int64{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}},
+		{"reverse range", 6, 2, -2, []int64{6, 4}},
+		{"reverse stop before first", 2, -10, -1, []int64{2, 1}},
+		{"clamped", -50, 50, 4, []int64{0, 4, 8}},
+		{"empty", 5, 2, 1, nil},
+		{"zero step", 0, 5, 0, nil},
+	}
+	for _, c := range cases {
+		result := df.IlocRange(c.start, c.stop, c.step)
+		if result.Nrows() != len(c.want) {
+			t.Errorf("%s: got %d rows, want %d", c.name, result.Nrows(), len(c.want))
+			continue
+		}
+		v, _ := result.Column("v")
+		for i, w := range c.want {
+			if got, _ := v.Get(i); got != w {
+				t.Errorf("%s: row %d = %v, want %d", c.name, i, got, w)
+			}
+		}
+	}
+}
@@ -0,0 +1,333 @@
+package dataframe
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/internal/bitset"
+	"github.com/TIVerse/GopherData/series"
+)
+
+// structTag is the struct tag that names a field's column. A tag of "-"
+// skips the field.
+const structTag = "gopher"
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// structField describes the column a struct field maps to.
+type structField struct {
+	name     string
+	index    []int
+	dtype    core.Dtype
+	nullable bool // the field is a pointer; nil is null
+}
+
+// FromStructs creates a DataFrame with a column per exported field of T, in
+// field order, and a row per record. A field's column is named by its
+// `gopher:"name"` tag, or else by the field name; `gopher:"-"` skips it.
+// Fields of exported embedded structs are included as if they were T's own.
+//
+// Integer fields become int64 columns, floats float64, strings string, bools
+// bool and time.Time DtypeTime. A pointer to one of these gives a nullable
+// column with nil as null. Other field types are an error wrapping
+// core.ErrTypeMismatch, as is an unsigned value above math.MaxInt64, which
+// does not fit an int64 column. T may also be a pointer to a struct, in
+// which case a nil record is a row of nulls.
+func FromStructs[T any](records []T) (*DataFrame, error) {
+	structType := reflect.TypeOf((*T)(nil)).Elem()
+	pointerRecords := structType.Kind() == reflect.Pointer
+	if pointerRecords {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("FromStructs needs a struct type, got %v: %w", structType, core.ErrTypeMismatch)
+	}
+
+	fields, err := structFields(structType)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([][]any, len(fields))
+	nulls := make([]*bitset.BitSet, len(fields))
+	for j := range fields {
+		columns[j] = make([]any, len(records))
+		nulls[j] = bitset.New(len(records))
+	}
+
+	for i := range records {
+		record := reflect.ValueOf(&records[i]).Elem()
+		if pointerRecords {
+			if record.IsNil() {
+				for j := range fields {
+					nulls[j].Set(i)
+				}
+				continue
+			}
+			record = record.Elem()
+		}
+
+		for j, f := range fields {
+			v := record.FieldByIndex(f.index)
+			if f.nullable {
+				if v.IsNil() {
+					nulls[j].Set(i)
+					continue
+				}
+				v = v.Elem()
+			}
+			val, err := fieldValue(v, f.dtype)
+			if err != nil {
+				return nil, fmt.Errorf("field %s of record %d: %w", f.name, i, err)
+			}
+			columns[j][i] = val
+		}
+	}
+
+	names := make([]string, len(fields))
+	seriesMap := make(map[string]*series.Series[any], len(fields))
+	for j, f := range fields {
+		names[j] = f.name
+		seriesMap[f.name] = series.NewWithNulls(f.name, columns[j], f.dtype, nulls[j])
+	}
+
+	return &DataFrame{
+		columns: names,
+		series:  seriesMap,
+		index:   NewRangeIndex(0, len(records), 1),
+		nrows:   len(records),
+	}, nil
+}
+
+// structFields returns the columns the exported fields of t map to, in
+// field order, descending into exported embedded structs.
+func structFields(t reflect.Type) ([]structField, error) {
+	var fields []structField
+	seen := make(map[string]bool)
+
+	var walk func(t reflect.Type, prefix []int) error
+	walk = func(t reflect.Type, prefix []int) error {
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			tag := sf.Tag.Get(structTag)
+			if tag == "-" {
+				continue
+			}
+			index := append(append([]int(nil), prefix...), i)
+
+			if !sf.IsExported() {
+				continue
+			}
+			if sf.Anonymous && sf.Type.Kind() == reflect.Struct && sf.Type != timeType && tag == "" {
+				if err := walk(sf.Type, index); err != nil {
+					return err
+				}
+				continue
+			}
+
+			name := sf.Name
+			if tag != "" {
+				name = tag
+			}
+			if seen[name] {
+				return fmt.Errorf("column %q: %w", name, core.ErrDuplicateColumn)
+			}
+			seen[name] = true
+
+			fieldType := sf.Type
+			nullable := fieldType.Kind() == reflect.Pointer
+			if nullable {
+				fieldType = fieldType.Elem()
+			}
+			dtype, ok := fieldDtype(fieldType)
+			if !ok {
+				return fmt.Errorf("field %s has unsupported type %v: %w", sf.Name, sf.Type, core.ErrTypeMismatch)
+			}
+
+			fields = append(fields, structField{name: name, index: index, dtype: dtype, nullable: nullable})
+		}
+		return nil
+	}
+
+	if err := walk(t, nil); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// fieldDtype returns the column dtype for a (non-pointer) field type.
+func fieldDtype(t reflect.Type) (core.Dtype, bool) {
+	if t == timeType {
+		return core.DtypeTime, true
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return core.DtypeInt64, true
+	case reflect.Float32, reflect.Float64:
+		return core.DtypeFloat64, true
+	case reflect.String:
+		return core.DtypeString, true
+	case reflect.Bool:
+		return core.DtypeBool, true
+	}
+	return 0, false
+}
+
+// fieldValue returns a field's value as the Go type stored in a column of
+// the given dtype, or an error wrapping core.ErrTypeMismatch if it does not
+// fit one.
+func fieldValue(v reflect.Value, dtype core.Dtype) (any, error) {
+	switch dtype {
+	case core.DtypeInt64:
+		if v.CanInt() {
+			return v.Int(), nil
+		}
+		u := v.Uint()
+		if u > math.MaxInt64 {
+			return nil, fmt.Errorf("%d overflows int64: %w", u, core.ErrTypeMismatch)
+		}
+		return int64(u), nil
+	case core.DtypeFloat64:
+		return v.Float(), nil
+	case core.DtypeString:
+		return v.String(), nil
+	case core.DtypeBool:
+		return v.Bool(), nil
+	default:
+		return v.Interface(), nil
+	}
+}
+
+// ToStructs sets *out to a slice with one T per row of df, filling each
+// exported field of T from the column that FromStructs would create for it.
+// Fields without a matching column keep their zero value and columns
+// without a matching field are ignored.
+//
+// int64 columns fill integer and float fields, float64 columns float
+// fields, string and category columns string fields, bool columns bool
+// fields and DtypeTime columns time.Time fields; any other pairing is an
+// error wrapping core.ErrTypeMismatch, as is an integer that overflows its
+// field. A null sets a pointer field to nil and is an error wrapping
+// core.ErrNullValue for any other field. T may also be a pointer to a
+// struct.
+func ToStructs[T any](df *DataFrame, out *[]T) error {
+	if out == nil {
+		return fmt.Errorf("out is nil: %w", core.ErrInvalidArgument)
+	}
+
+	structType := reflect.TypeOf((*T)(nil)).Elem()
+	pointerRecords := structType.Kind() == reflect.Pointer
+	if pointerRecords {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return fmt.Errorf("ToStructs needs a struct type, got %v: %w", structType, core.ErrTypeMismatch)
+	}
+
+	fields, err := structFields(structType)
+	if err != nil {
+		return err
+	}
+
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	// Keep the fields that have a column, checking the dtypes up front
+	columns := make([]*series.Series[any], 0, len(fields))
+	matched := fields[:0:0]
+	for _, f := range fields {
+		s, ok := df.series[f.name]
+		if !ok {
+			continue
+		}
+		if !assignableDtype(s.Dtype(), f.dtype) {
+			return fmt.Errorf("column %q has dtype %v, field needs %v: %w", f.name, s.Dtype(), f.dtype, core.ErrTypeMismatch)
+		}
+		columns = append(columns, s)
+		matched = append(matched, f)
+	}
+
+	result := make([]T, df.nrows)
+	for i := range result {
+		record := reflect.ValueOf(&result[i]).Elem()
+		if pointerRecords {
+			record.Set(reflect.New(structType))
+			record = record.Elem()
+		}
+
+		for j, f := range matched {
+			val, ok := columns[j].Get(i)
+			if !ok || val == nil {
+				if !f.nullable {
+					return fmt.Errorf("column %q has null at row %d for a non-pointer field: %w", f.name, i, core.ErrNullValue)
+				}
+				continue
+			}
+
+			v := record.FieldByIndex(f.index)
+			if f.nullable {
+				v.Set(reflect.New(v.Type().Elem()))
+				v = v.Elem()
+			}
+			if err := setField(v, val); err != nil {
+				return fmt.Errorf("column %q row %d: %w", f.name, i, err)
+			}
+		}
+	}
+
+	*out = result
+	return nil
+}
+
+// assignableDtype reports whether a column of dtype col can fill a field
+// whose own dtype is field.
+func assignableDtype(col, field core.Dtype) bool {
+	switch field {
+	case core.DtypeFloat64:
+		return core.IsNumeric(col)
+	case core.DtypeString:
+		return col == core.DtypeString || col == core.DtypeCategory
+	default:
+		return col == field
+	}
+}
+
+// setField stores a column value in a (non-pointer) field.
+func setField(v reflect.Value, val any) error {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := val.(int64)
+		if !ok || v.OverflowInt(n) {
+			return fmt.Errorf("%v does not fit %v: %w", val, v.Type(), core.ErrTypeMismatch)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, ok := val.(int64)
+		if !ok || n < 0 || v.OverflowUint(uint64(n)) {
+			return fmt.Errorf("%v does not fit %v: %w", val, v.Type(), core.ErrTypeMismatch)
+		}
+		v.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		f, ok := core.ToFloat64(val)
+		if !ok {
+			return fmt.Errorf("%v is not a number: %w", val, core.ErrTypeMismatch)
+		}
+		v.SetFloat(f)
+	default:
+		rv := reflect.ValueOf(val)
+		if v.Kind() == reflect.String && rv.Kind() == reflect.String {
+			v.SetString(rv.String())
+		} else if v.Kind() == reflect.Bool && rv.Kind() == reflect.Bool {
+			v.SetBool(rv.Bool())
+		} else if rv.Type().AssignableTo(v.Type()) {
+			v.Set(rv)
+		} else {
+			return fmt.Errorf("%v (%T) cannot be stored in %v: %w", val, val, v.Type(), core.ErrTypeMismatch)
+		}
+	}
+	return nil
+}
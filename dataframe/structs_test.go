@@ -0,0 +1,198 @@
+package dataframe
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+type StructBase struct {
+	ID int `gopher:"id"`
+}
+
+type structRecord struct {
+	StructBase
+	Name    string
+	Score   float64 `gopher:"score"`
+	Visits  *int    `gopher:"visits"`
+	Active  bool
+	Skipped string `gopher:"-"`
+	private int
+}
+
+func TestFromStructs(t *testing.T) {
+	three := 3
+	records := []structRecord{
+		{StructBase: StructBase{ID: 1}, Name: "a", Score: 1.5, Visits: &three, Active: true},
+		{StructBase: StructBase{ID: 2}, Name: "b", Score: 2.5, Visits: nil},
+	}
+
+	df, err := FromStructs(records)
+	if err != nil {
+		t.Fatalf("FromStructs failed: %v", err)
+	}
+
+	wantCols := []string{"id", "Name", "score", "visits", "Active"}
+	wantDtypes := []core.Dtype{core.DtypeInt64, core.DtypeString, core.DtypeFloat64, core.DtypeInt64, core.DtypeBool}
+	cols := df.Columns()
+	if len(cols) != len(wantCols) {
+		t.Fatalf("columns = %v, want %v", cols, wantCols)
+	}
+	for i, col := range wantCols {
+		if cols[i] != col {
+			t.Fatalf("columns = %v, want %v", cols, wantCols)
+		}
+		s, _ := df.Column(col)
+		if s.Dtype() != wantDtypes[i] {
+			t.Errorf("%s dtype = %v, want %v", col, s.Dtype(), wantDtypes[i])
+		}
+	}
+
+	id, _ := df.Column("id")
+	if got, _ := id.Get(1); got != int64(2) {
+		t.Errorf("id[1] = %v, want 2", got)
+	}
+	visits, _ := df.Column("visits")
+	if got, ok := visits.Get(0); !ok || got != int64(3) {
+		t.Errorf("visits[0] = %v, %v, want 3", got, ok)
+	}
+	if !visits.IsNull(1) {
+		t.Error("visits[1] should be null")
+	}
+	if visits.NullCount() != 1 {
+		t.Errorf("visits has %d nulls, want 1", visits.NullCount())
+	}
+}
+
+func TestFromStructsPointersAndErrors(t *testing.T) {
+	df, err := FromStructs([]*StructBase{{ID: 7}, nil})
+	if err != nil {
+		t.Fatalf("FromStructs failed: %v", err)
+	}
+	id, _ := df.Column("id")
+	if got, _ := id.Get(0); got != int64(7) || !id.IsNull(1) {
+		t.Errorf("id = [%v, null?%v], want [7, null]", got, id.IsNull(1))
+	}
+
+	empty, err := FromStructs([]StructBase{})
+	if err != nil || empty.Nrows() != 0 || !empty.HasColumn("id") {
+		t.Errorf("empty input: %v, %v", empty, err)
+	}
+
+	type bad struct{ Tags []string }
+	if _, err := FromStructs([]bad{{}}); !errors.Is(err, core.ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch, got %v", err)
+	}
+	type dup struct {
+		A int `gopher:"x"`
+		B int `gopher:"x"`
+	}
+	if _, err := FromStructs([]dup{{}}); !errors.Is(err, core.ErrDuplicateColumn) {
+		t.Errorf("expected ErrDuplicateColumn, got %v", err)
+	}
+	if _, err := FromStructs([]int{1}); !errors.Is(err, core.ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch for non-struct, got %v", err)
+	}
+
+	type counter struct{ N uint64 }
+	if df, err := FromStructs([]counter{{math.MaxInt64}}); err != nil {
+		t.Errorf("MaxInt64 fits int64, got %v", err)
+	} else if n, _ := df.Column("N"); n.GetUnsafe(0) != int64(math.MaxInt64) {
+		t.Errorf("N = %v, want %d", n.GetUnsafe(0), int64(math.MaxInt64))
+	}
+	if _, err := FromStructs([]counter{{math.MaxInt64 + 1}}); !errors.Is(err, core.ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch for uint64 overflow, got %v", err)
+	}
+}
+
+func TestToStructsRoundTrip(t *testing.T) {
+	three := 3
+	records := []structRecord{
+		{StructBase: StructBase{ID: 1}, Name: "a", Score: 1.5, Visits: &three, Active: true, Skipped: "x"},
+		{StructBase: StructBase{ID: 2}, Name: "b", Score: 2.5, Visits: nil},
+	}
+	df, err := FromStructs(records)
+	if err != nil {
+		t.Fatalf("FromStructs failed: %v", err)
+	}
+
+	var out []structRecord
+	if err := ToStructs(df, &out); err != nil {
+		t.Fatalf("ToStructs failed: %v", err)
+	}
+	if len(out) != 2 {
+		t.Fatalf("got %d records, want 2", len(out))
+	}
+	for i, got := range out {
+		want := records[i]
+		if got.ID != want.ID || got.Name != want.Name || got.Score != want.Score || got.Active != want.Active {
+			t.Errorf("record %d = %+v, want %+v", i, got, want)
+		}
+		if got.Skipped != "" {
+			t.Errorf("record %d: skipped field set to %q", i, got.Skipped)
+		}
+	}
+	if out[0].Visits == nil || *out[0].Visits != 3 {
+		t.Errorf("record 0 visits = %v, want 3", out[0].Visits)
+	}
+	if out[1].Visits != nil {
+		t.Errorf("record 1 visits = %v, want nil", *out[1].Visits)
+	}
+
+	// Pointer records, with an int64 column filling a float field
+	type scored struct {
+		ID    float32 `gopher:"id"`
+		Extra string
+	}
+	var ptrs []*scored
+	if err := ToStructs(df, &ptrs); err != nil {
+		t.Fatalf("ToStructs failed: %v", err)
+	}
+	if ptrs[1].ID != 2 || ptrs[1].Extra != "" {
+		t.Errorf("record 1 = %+v, want ID 2 and no Extra", ptrs[1])
+	}
+}
+
+func TestToStructsErrors(t *testing.T) {
+	df, _ := New(map[string]any{
+		"name":  []string{"a", "b"},
+		"score": []float64{1.5, 2.5},
+		"big":   []int64{1, 300},
+	})
+	score, _ := df.Column("score")
+	score.SetNull(1)
+
+	var names []struct {
+		Name int `gopher:"name"`
+	}
+	if err := ToStructs(df, &names); !errors.Is(err, core.ErrTypeMismatch) {
+		t.Errorf("string into int: expected ErrTypeMismatch, got %v", err)
+	}
+
+	var scores []struct {
+		Score int `gopher:"score"`
+	}
+	if err := ToStructs(df, &scores); !errors.Is(err, core.ErrTypeMismatch) {
+		t.Errorf("float into int: expected ErrTypeMismatch, got %v", err)
+	}
+
+	var nonNull []struct {
+		Score float64 `gopher:"score"`
+	}
+	if err := ToStructs(df, &nonNull); !errors.Is(err, core.ErrNullValue) {
+		t.Errorf("null into float64: expected ErrNullValue, got %v", err)
+	}
+
+	var small []struct {
+		Big int8 `gopher:"big"`
+	}
+	if err := ToStructs(df, &small); !errors.Is(err, core.ErrTypeMismatch) {
+		t.Errorf("overflow: expected ErrTypeMismatch, got %v", err)
+	}
+
+	if err := ToStructs[StructBase](df, nil); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("nil out: expected ErrInvalidArgument, got %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+// Schema describes the columns a DataFrame is expected to have.
+type Schema struct {
+	Columns []ColumnSchema
+}
+
+// ColumnSchema describes one expected column. The column must exist unless
+// Optional is set; every other constraint is checked only when set.
+// Null values are only checked by NonNull.
+type ColumnSchema struct {
+	Name     string
+	Optional bool         // Column may be absent
+	Dtypes   []core.Dtype // Allowed dtypes (any if empty)
+	NonNull  bool         // No null values allowed
+	Min      *float64     // Inclusive lower bound for numeric values
+	Max      *float64     // Inclusive upper bound for numeric values
+	Allowed  []any        // Allowed values, compared by their formatted form (any if empty)
+}
+
+// Validate checks the DataFrame against schema and reports every violation
+// at once. Returns nil if the DataFrame conforms. Otherwise the error joins
+// one error per violation, each wrapping core.ErrColumnNotFound,
+// core.ErrTypeMismatch, core.ErrNullValue, or core.ErrConstraintViolation,
+// so errors.Is can test for a kind of violation.
+func (df *DataFrame) Validate(schema Schema) error {
+	df.mu.RLock()
+	defer df.mu.RUnlock()
+
+	var violations []error
+	for _, cs := range schema.Columns {
+		violations = append(violations, df.validateColumn(cs)...)
+	}
+
+	return errors.Join(violations...)
+}
+
+// validateColumn returns the violations of one column's constraints.
+// Must be called with the lock held.
+func (df *DataFrame) validateColumn(cs ColumnSchema) []error {
+	s, exists := df.series[cs.Name]
+	if !exists {
+		if cs.Optional {
+			return nil
+		}
+		return []error{fmt.Errorf("column %q: %w", cs.Name, core.ErrColumnNotFound)}
+	}
+
+	var violations []error
+
+	if len(cs.Dtypes) > 0 && !containsDtype(cs.Dtypes, s.Dtype()) {
+		violations = append(violations, fmt.Errorf("column %q has dtype %s, expected %s: %w",
+			cs.Name, s.Dtype(), formatDtypes(cs.Dtypes), core.ErrTypeMismatch))
+	}
+
+	checkRange := cs.Min != nil || cs.Max != nil
+	if checkRange && !core.IsNumeric(s.Dtype()) {
+		violations = append(violations, fmt.Errorf("column %q has dtype %s, cannot check range: %w",
+			cs.Name, s.Dtype(), core.ErrTypeMismatch))
+		checkRange = false
+	}
+
+	var allowed map[string]bool
+	if len(cs.Allowed) > 0 {
+		allowed = make(map[string]bool, len(cs.Allowed))
+		for _, v := range cs.Allowed {
+			allowed[fmt.Sprint(v)] = true
+		}
+	}
+
+	var nulls, outOfRange, notAllowed violationCount
+	for i := 0; i < s.Len(); i++ {
+		val, ok := s.Get(i)
+		if !ok || val == nil {
+			if cs.NonNull {
+				nulls.add(i, nil)
+			}
+			continue
+		}
+
+		if checkRange {
+			f, _ := core.ToFloat64(val)
+			if (cs.Min != nil && f < *cs.Min) || (cs.Max != nil && f > *cs.Max) {
+				outOfRange.add(i, val)
+			}
+		}
+
+		if allowed != nil && !allowed[fmt.Sprint(val)] {
+			notAllowed.add(i, val)
+		}
+	}
+
+	if nulls.n > 0 {
+		violations = append(violations, fmt.Errorf("column %q has %d null values (first at row %d): %w",
+			cs.Name, nulls.n, nulls.row, core.ErrNullValue))
+	}
+	if outOfRange.n > 0 {
+		violations = append(violations, fmt.Errorf("column %q has %d values outside %s (first at row %d: %v): %w",
+			cs.Name, outOfRange.n, formatRange(cs.Min, cs.Max), outOfRange.row, outOfRange.val, core.ErrConstraintViolation))
+	}
+	if notAllowed.n > 0 {
+		violations = append(violations, fmt.Errorf("column %q has %d values not in the allowed set (first at row %d: %v): %w",
+			cs.Name, notAllowed.n, notAllowed.row, notAllowed.val, core.ErrConstraintViolation))
+	}
+
+	return violations
+}
+
+// violationCount counts failing values and remembers the first one.
+type violationCount struct {
+	n   int
+	row int
+	val any
+}
+
+func (vc *violationCount) add(row int, val any) {
+	if vc.n == 0 {
+		vc.row = row
+		vc.val = val
+	}
+	vc.n++
+}
+
+func formatDtypes(dtypes []core.Dtype) string {
+	names := make([]string, len(dtypes))
+	for i, d := range dtypes {
+		names[i] = d.String()
+	}
+	return strings.Join(names, " or ")
+}
+
+func formatRange(min, max *float64) string {
+	lo, hi := "-inf", "+inf"
+	if min != nil {
+		lo = fmt.Sprint(*min)
+	}
+	if max != nil {
+		hi = fmt.Sprint(*max)
+	}
+	return "[" + lo + ", " + hi + "]"
+}
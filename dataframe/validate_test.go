@@ -0,0 +1,100 @@
+package dataframe
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func validateTestFrame() *DataFrame {
+	df, _ := New(map[string]any{
+		"id":   []int64{1, 2, 3, 4},
+		"age":  []float64{34, 150, 28, 61},
+		"plan": []string{"free", "pro", "free", "enterprise"},
+	})
+	return df
+}
+
+func TestValidateConforming(t *testing.T) {
+	df := validateTestFrame()
+	lo, hi := 0.0, 200.0
+
+	schema := Schema{Columns: []ColumnSchema{
+		{Name: "id", Dtypes: []core.Dtype{core.DtypeInt64}, NonNull: true},
+		{Name: "age", Dtypes: core.NumericDtypes(), Min: &lo, Max: &hi},
+		{Name: "plan", Allowed: []any{"free", "pro", "enterprise"}},
+		{Name: "referrer", Optional: true},
+	}}
+	if err := df.Validate(schema); err != nil {
+		t.Errorf("Expected no violations, got %v", err)
+	}
+}
+
+func TestValidateMissingColumn(t *testing.T) {
+	df := validateTestFrame()
+
+	err := df.Validate(Schema{Columns: []ColumnSchema{{Name: "country"}}})
+	if !errors.Is(err, core.ErrColumnNotFound) {
+		t.Fatalf("Expected ErrColumnNotFound, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `"country"`) {
+		t.Errorf("Expected the column name in the error, got %q", err)
+	}
+}
+
+func TestValidateWrongDtype(t *testing.T) {
+	df := validateTestFrame()
+
+	err := df.Validate(Schema{Columns: []ColumnSchema{
+		{Name: "plan", Dtypes: core.NumericDtypes()},
+	}})
+	if !errors.Is(err, core.ErrTypeMismatch) {
+		t.Fatalf("Expected ErrTypeMismatch, got %v", err)
+	}
+	if msg := err.Error(); !strings.Contains(msg, "string") || !strings.Contains(msg, "int64 or float64") {
+		t.Errorf("Expected actual and expected dtypes in the error, got %q", msg)
+	}
+}
+
+func TestValidateRangeViolation(t *testing.T) {
+	df := validateTestFrame()
+	hi := 120.0
+
+	err := df.Validate(Schema{Columns: []ColumnSchema{
+		{Name: "age", Max: &hi},
+	}})
+	if !errors.Is(err, core.ErrConstraintViolation) {
+		t.Fatalf("Expected ErrConstraintViolation, got %v", err)
+	}
+	if msg := err.Error(); !strings.Contains(msg, "[-inf, 120]") || !strings.Contains(msg, "row 1: 150") {
+		t.Errorf("Expected the range and first offending value in the error, got %q", msg)
+	}
+}
+
+func TestValidateReportsAllViolations(t *testing.T) {
+	df := validateTestFrame()
+	age, _ := df.Column("age")
+	age.SetNull(2)
+	lo := 40.0
+
+	err := df.Validate(Schema{Columns: []ColumnSchema{
+		{Name: "country"},
+		{Name: "id", Dtypes: []core.Dtype{core.DtypeString}},
+		{Name: "age", NonNull: true, Min: &lo},
+		{Name: "plan", Allowed: []any{"free", "pro"}},
+	}})
+	if err == nil {
+		t.Fatal("Expected violations")
+	}
+
+	for _, sentinel := range []error{core.ErrColumnNotFound, core.ErrTypeMismatch, core.ErrNullValue, core.ErrConstraintViolation} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("Expected %v among the violations, got %v", sentinel, err)
+		}
+	}
+	if n := len(strings.Split(err.Error(), "\n")); n != 5 {
+		t.Errorf("Expected 5 violations, got %d:\n%v", n, err)
+	}
+}
@@ -3,6 +3,8 @@ package dataframe
 import (
 	"fmt"
 	"math"
+	"sort"
+	"time"
 
 	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/series"
@@ -37,8 +39,13 @@ type Window struct {
 	size       int
 	minPeriods int
 	center     bool
-	windowType string // "rolling", "expanding", "ewm"
+	windowType string // "rolling", "rolling_time", "expanding", "ewm"
 	alpha      float64 // For EWM
+
+	// For rolling_time: the window length and the index timestamps
+	period time.Duration
+	times  []time.Time
+	err    error // reported by every aggregation, set if the window is invalid
 }
 
 // Rolling creates a rolling window.
@@ -60,6 +67,50 @@ func (df *DataFrame) Rolling(size int, opts ...WindowOption) *Window {
 	}
 }
 
+// RollingTime creates a rolling window covering a span of time rather than
+// a number of rows: the window at each row holds every row whose timestamp
+// is after the row's own timestamp minus window and not after it, like a
+// pandas offset window such as "7D". The DataFrame must have a
+// non-decreasing DatetimeIndex. MinPeriods defaults to 1; Center is not
+// supported. An invalid window is reported by each aggregation's error.
+func (df *DataFrame) RollingTime(window time.Duration, opts ...WindowOption) *Window {
+	winOpts := &WindowOptions{
+		minPeriods: 1,
+		center:     false,
+	}
+	for _, opt := range opts {
+		opt(winOpts)
+	}
+
+	w := &Window{
+		df:         df,
+		size:       -1,
+		minPeriods: winOpts.minPeriods,
+		windowType: "rolling_time",
+		period:     window,
+	}
+
+	idx, ok := df.Index().(*DatetimeIndex)
+	switch {
+	case !ok:
+		w.err = fmt.Errorf("time-based rolling needs a DatetimeIndex: %w", core.ErrInvalidArgument)
+	case window <= 0:
+		w.err = fmt.Errorf("window %v must be positive: %w", window, core.ErrInvalidArgument)
+	case winOpts.center:
+		w.err = fmt.Errorf("time-based rolling windows cannot be centered: %w", core.ErrInvalidArgument)
+	default:
+		w.times = idx.times
+		for i := 1; i < len(w.times); i++ {
+			if w.times[i].Before(w.times[i-1]) {
+				w.err = fmt.Errorf("DatetimeIndex must be non-decreasing, row %d is before row %d: %w",
+					i, i-1, core.ErrInvalidArgument)
+				break
+			}
+		}
+	}
+	return w
+}
+
 // Expanding creates an expanding window.
 func (df *DataFrame) Expanding(minPeriods int) *Window {
 	return &Window{
@@ -89,12 +140,15 @@ func (df *DataFrame) EWM(alpha float64) *Window {
 
 // Mean calculates the rolling mean for a column.
 func (w *Window) Mean(col string) (*series.Series[float64], error) {
+	if w.err != nil {
+		return nil, w.err
+	}
 	if !w.df.HasColumn(col) {
 		return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
 	}
 
 	s, _ := w.df.Column(col)
-	if !isNumericType(s.Dtype()) {
+	if !core.IsNumeric(s.Dtype()) {
 		return nil, fmt.Errorf("column %q: cannot compute mean of non-numeric type", col)
 	}
 
@@ -121,12 +175,15 @@ func (w *Window) Mean(col string) (*series.Series[float64], error) {
 
 // Sum calculates the rolling sum for a column.
 func (w *Window) Sum(col string) (*series.Series[float64], error) {
+	if w.err != nil {
+		return nil, w.err
+	}
 	if !w.df.HasColumn(col) {
 		return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
 	}
 
 	s, _ := w.df.Column(col)
-	if !isNumericType(s.Dtype()) {
+	if !core.IsNumeric(s.Dtype()) {
 		return nil, fmt.Errorf("column %q: cannot compute sum of non-numeric type", col)
 	}
 
@@ -149,12 +206,15 @@ func (w *Window) Sum(col string) (*series.Series[float64], error) {
 
 // Std calculates the rolling standard deviation for a column.
 func (w *Window) Std(col string) (*series.Series[float64], error) {
+	if w.err != nil {
+		return nil, w.err
+	}
 	if !w.df.HasColumn(col) {
 		return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
 	}
 
 	s, _ := w.df.Column(col)
-	if !isNumericType(s.Dtype()) {
+	if !core.IsNumeric(s.Dtype()) {
 		return nil, fmt.Errorf("column %q: cannot compute std of non-numeric type", col)
 	}
 
@@ -177,6 +237,9 @@ func (w *Window) Std(col string) (*series.Series[float64], error) {
 
 // Min calculates the rolling minimum for a column.
 func (w *Window) Min(col string) (*series.Series[any], error) {
+	if w.err != nil {
+		return nil, w.err
+	}
 	if !w.df.HasColumn(col) {
 		return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
 	}
@@ -201,6 +264,9 @@ func (w *Window) Min(col string) (*series.Series[any], error) {
 
 // Max calculates the rolling maximum for a column.
 func (w *Window) Max(col string) (*series.Series[any], error) {
+	if w.err != nil {
+		return nil, w.err
+	}
 	if !w.df.HasColumn(col) {
 		return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
 	}
@@ -223,6 +289,40 @@ func (w *Window) Max(col string) (*series.Series[any], error) {
 	return series.New(col+"_max", result, s.Dtype()), nil
 }
 
+// Apply calls fn on the non-null values of each window of a column and
+// returns the results. Windows with fewer than minPeriods values are NaN and
+// fn is not called for them. Window size, centering, and expanding bounds
+// are honored as for the built-in aggregations.
+func (w *Window) Apply(col string, fn func([]float64) float64) (*series.Series[float64], error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+	if !w.df.HasColumn(col) {
+		return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
+	}
+
+	s, _ := w.df.Column(col)
+	if !core.IsNumeric(s.Dtype()) {
+		return nil, fmt.Errorf("column %q: cannot apply a window function to non-numeric type", col)
+	}
+
+	nrows := w.df.Nrows()
+	result := make([]float64, nrows)
+
+	for i := 0; i < nrows; i++ {
+		windowStart, windowEnd := w.getWindowBounds(i, nrows)
+		values := w.extractWindowValues(s, windowStart, windowEnd)
+
+		if len(values) < w.minPeriods {
+			result[i] = math.NaN()
+		} else {
+			result[i] = fn(values)
+		}
+	}
+
+	return series.New(col+"_apply", result, core.DtypeFloat64), nil
+}
+
 // Helper functions
 
 // getWindowBounds returns the start and end indices for the window at position i.
@@ -231,6 +331,14 @@ func (w *Window) getWindowBounds(i, nrows int) (int, int) {
 		return 0, i + 1
 	}
 
+	if w.windowType == "rolling_time" {
+		// First row still within the period before row i
+		start := sort.Search(i, func(j int) bool {
+			return w.times[i].Sub(w.times[j]) < w.period
+		})
+		return start, i + 1
+	}
+
 	if w.center {
 		// Centered window
 		halfSize := w.size / 2
@@ -263,7 +371,8 @@ func (w *Window) extractWindowValues(s *series.Series[any], start, end int) []fl
 	for i := start; i < end; i++ {
 		if !s.IsNull(i) {
 			val := s.GetUnsafe(i)
-			values = append(values, toFloat64(val))
+			f, _ := core.ToFloat64(val)
+			values = append(values, f)
 		}
 	}
 
@@ -291,20 +400,11 @@ func windowMean(values []float64) float64 {
 		return math.NaN()
 	}
 
-	var sum float64
-	for _, v := range values {
-		sum += v
-	}
-
-	return sum / float64(len(values))
+	return sumFloat64s(values) / float64(len(values))
 }
 
 func windowSum(values []float64) float64 {
-	var sum float64
-	for _, v := range values {
-		sum += v
-	}
-	return sum
+	return sumFloat64s(values)
 }
 
 func windowStd(values []float64) float64 {
@@ -313,14 +413,14 @@ func windowStd(values []float64) float64 {
 	}
 
 	mean := windowMean(values)
-	var sumSq float64
+	var sumSq neumaierSum
 
 	for _, v := range values {
 		diff := v - mean
-		sumSq += diff * diff
+		sumSq.add(diff * diff)
 	}
 
-	return math.Sqrt(sumSq / float64(len(values)-1))
+	return math.Sqrt(sumSq.value() / float64(len(values)-1))
 }
 
 func windowMin(values []float64) any {
@@ -0,0 +1,73 @@
+package dataframe
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func TestRollingTime(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	days := []int{0, 1, 2, 5, 6, 13, 14}
+	times := make([]time.Time, len(days))
+	for i, d := range days {
+		times[i] = start.AddDate(0, 0, d)
+	}
+
+	df, _ := New(map[string]any{
+		"v": []float64{1, 2, 3, 4, 5, 6, 7},
+	})
+	if err := df.SetIndex(NewDatetimeIndex(times, nil)); err != nil {
+		t.Fatalf("SetIndex failed: %v", err)
+	}
+
+	// Each window holds the rows in (t-3 days, t].
+	window := df.RollingTime(3 * 24 * time.Hour)
+	sums, err := window.Sum("v")
+	if err != nil {
+		t.Fatalf("Sum failed: %v", err)
+	}
+	counts, err := window.Apply("v", func(values []float64) float64 { return float64(len(values)) })
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	wantSums := []float64{1, 3, 6, 4, 9, 6, 13}
+	wantCounts := []float64{1, 2, 3, 1, 2, 1, 2}
+	for i := range wantSums {
+		if got, _ := sums.Get(i); got != wantSums[i] {
+			t.Errorf("sum[%d] = %v, want %v", i, got, wantSums[i])
+		}
+		if got, _ := counts.Get(i); got != wantCounts[i] {
+			t.Errorf("count[%d] = %v, want %v", i, got, wantCounts[i])
+		}
+	}
+
+	means, err := df.RollingTime(3*24*time.Hour, MinPeriods(2)).Mean("v")
+	if err != nil {
+		t.Fatalf("Mean failed: %v", err)
+	}
+	if got, _ := means.Get(3); !math.IsNaN(got) {
+		t.Errorf("mean[3] = %v, want NaN below MinPeriods", got)
+	}
+	if got, _ := means.Get(4); got != 4.5 {
+		t.Errorf("mean[4] = %v, want 4.5", got)
+	}
+}
+
+func TestRollingTimeErrors(t *testing.T) {
+	df, _ := New(map[string]any{
+		"v": []float64{1, 2},
+	})
+	if _, err := df.RollingTime(time.Hour).Sum("v"); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument without a DatetimeIndex, got %v", err)
+	}
+
+	now := time.Now()
+	_ = df.SetIndex(NewDatetimeIndex([]time.Time{now, now.Add(-time.Hour)}, nil))
+	if _, err := df.RollingTime(time.Hour).Mean("v"); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for a decreasing index, got %v", err)
+	}
+}
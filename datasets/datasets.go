@@ -0,0 +1,155 @@
+// Package datasets provides labeled data for examples, tests and
+// benchmarks: generators of synthetic data, where the same arguments and
+// seed always give the same data, and small well-known datasets bundled
+// with the package.
+package datasets
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/series"
+	"gonum.org/v1/gonum/mat"
+)
+
+// MakeOptions configures MakeClassification and MakeRegression.
+type MakeOptions struct {
+	informative int
+}
+
+// MakeOption is a functional option for MakeClassification and MakeRegression.
+type MakeOption func(*MakeOptions)
+
+// WithInformative sets how many features carry signal (default: 2 for
+// MakeClassification, 10 for MakeRegression, at most nFeatures). The
+// informative features come first; the rest are pure noise.
+func WithInformative(n int) MakeOption {
+	return func(opts *MakeOptions) {
+		opts.informative = n
+	}
+}
+
+// MakeClassification generates nSamples samples of nFeatures features,
+// labelled "y" with int64 classes 0..nClasses-1 in near-equal numbers. Each
+// class is a unit-variance Gaussian blob around its own random centre in the
+// informative features; the other features are standard normal noise.
+// Features are named x0, x1, ...
+//
+// Non-positive nSamples and nFeatures default to 100 and 20, and nClasses
+// below 2 defaults to 2.
+func MakeClassification(nSamples, nFeatures, nClasses int, seed int64, opts ...MakeOption) (*dataframe.DataFrame, *series.Series[any]) {
+	if nSamples <= 0 {
+		nSamples = 100
+	}
+	if nFeatures <= 0 {
+		nFeatures = 20
+	}
+	if nClasses < 2 {
+		nClasses = 2
+	}
+	informative := informativeCount(opts, 2, nFeatures)
+	rng := rand.New(rand.NewSource(seed))
+
+	centres := make([][]float64, nClasses)
+	for k := range centres {
+		centres[k] = make([]float64, informative)
+		for j := range centres[k] {
+			centres[k][j] = 6*rng.Float64() - 3
+		}
+	}
+
+	// Deal classes out in turn, then shuffle so they are not in order
+	classes := make([]int, nSamples)
+	for i := range classes {
+		classes[i] = i % nClasses
+	}
+	rng.Shuffle(nSamples, func(i, j int) {
+		classes[i], classes[j] = classes[j], classes[i]
+	})
+
+	data := make([]float64, nSamples*nFeatures)
+	labels := make([]any, nSamples)
+	for i, class := range classes {
+		row := data[i*nFeatures : (i+1)*nFeatures]
+		for j := range row {
+			row[j] = rng.NormFloat64()
+			if j < informative {
+				row[j] += centres[class][j]
+			}
+		}
+		labels[i] = int64(class)
+	}
+
+	return features(nSamples, nFeatures, data), series.New("y", labels, core.DtypeInt64)
+}
+
+// MakeRegression generates nSamples samples of nFeatures standard normal
+// features and a float64 target "y" that is a random linear combination of
+// the informative features, with coefficients in [0, 100), plus Gaussian
+// noise of standard deviation noise. Features are named x0, x1, ...
+//
+// Non-positive nSamples and nFeatures default to 100, and a negative noise
+// is treated as 0.
+func MakeRegression(nSamples, nFeatures int, noise float64, seed int64, opts ...MakeOption) (*dataframe.DataFrame, *series.Series[any]) {
+	if nSamples <= 0 {
+		nSamples = 100
+	}
+	if nFeatures <= 0 {
+		nFeatures = 100
+	}
+	if noise < 0 {
+		noise = 0
+	}
+	informative := informativeCount(opts, 10, nFeatures)
+	rng := rand.New(rand.NewSource(seed))
+
+	coef := make([]float64, informative)
+	for j := range coef {
+		coef[j] = 100 * rng.Float64()
+	}
+
+	data := make([]float64, nSamples*nFeatures)
+	target := make([]any, nSamples)
+	for i := 0; i < nSamples; i++ {
+		row := data[i*nFeatures : (i+1)*nFeatures]
+		y := 0.0
+		for j := range row {
+			row[j] = rng.NormFloat64()
+			if j < informative {
+				y += coef[j] * row[j]
+			}
+		}
+		target[i] = y + noise*rng.NormFloat64()
+	}
+
+	return features(nSamples, nFeatures, data), series.New("y", target, core.DtypeFloat64)
+}
+
+// informativeCount applies opts and clamps the number of informative
+// features to [1, nFeatures].
+func informativeCount(opts []MakeOption, defaultCount, nFeatures int) int {
+	options := MakeOptions{informative: defaultCount}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.informative < 1 {
+		options.informative = 1
+	}
+	if options.informative > nFeatures {
+		options.informative = nFeatures
+	}
+	return options.informative
+}
+
+// features wraps row-major data as a DataFrame with columns x0, x1, ...
+func features(nSamples, nFeatures int, data []float64) *dataframe.DataFrame {
+	names := make([]string, nFeatures)
+	for j := range names {
+		names[j] = fmt.Sprintf("x%d", j)
+	}
+	// The names are distinct and match the matrix width, so this cannot fail
+	df, _ := dataframe.FromMatrix(mat.NewDense(nSamples, nFeatures, data), names)
+	return df
+}
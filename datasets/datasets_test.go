@@ -0,0 +1,135 @@
+package datasets
+
+import (
+	"math"
+	"testing"
+
+	"github.com/TIVerse/GopherData/models/linear"
+)
+
+func TestMakeClassification(t *testing.T) {
+	X, y := MakeClassification(90, 5, 3, 7)
+
+	if rows, cols := X.Shape(); rows != 90 || cols != 5 {
+		t.Fatalf("shape = (%d, %d), want (90, 5)", rows, cols)
+	}
+	if cols := X.Columns(); cols[0] != "x0" || cols[4] != "x4" {
+		t.Errorf("columns = %v, want x0..x4", cols)
+	}
+	if y.Len() != 90 {
+		t.Fatalf("y has %d values, want 90", y.Len())
+	}
+
+	counts := make(map[int64]int)
+	for i := 0; i < y.Len(); i++ {
+		val, _ := y.Get(i)
+		counts[val.(int64)]++
+	}
+	for class := int64(0); class < 3; class++ {
+		if counts[class] != 30 {
+			t.Errorf("class %d has %d samples, want 30", class, counts[class])
+		}
+	}
+
+	again, yAgain := MakeClassification(90, 5, 3, 7)
+	if !X.Equals(again) {
+		t.Error("same seed gave different features")
+	}
+	for i := 0; i < y.Len(); i++ {
+		a, _ := y.Get(i)
+		b, _ := yAgain.Get(i)
+		if a != b {
+			t.Fatalf("same seed gave different labels at row %d", i)
+		}
+	}
+	other, _ := MakeClassification(90, 5, 3, 8)
+	if X.Equals(other) {
+		t.Error("different seeds gave the same data")
+	}
+}
+
+func TestMakeRegressionRecoverable(t *testing.T) {
+	X, y := MakeRegression(200, 4, 0.1, 3, WithInformative(2))
+
+	if rows, cols := X.Shape(); rows != 200 || cols != 4 {
+		t.Fatalf("shape = (%d, %d), want (200, 4)", rows, cols)
+	}
+
+	model := linear.NewLinearRegression(true)
+	if err := model.Fit(X, y); err != nil {
+		t.Fatalf("Fit failed: %v", err)
+	}
+	score, err := model.Score(X, y)
+	if err != nil {
+		t.Fatalf("Score failed: %v", err)
+	}
+	if score < 0.99 {
+		t.Errorf("R² = %v, want >= 0.99", score)
+	}
+
+	// Only the first two features are informative.
+	coef := model.Coef()
+	for j, c := range coef {
+		if informative := j < 2; informative != (math.Abs(c) > 0.1) {
+			t.Errorf("coef[%d] = %v, informative = %v", j, c, informative)
+		}
+	}
+}
+
+func TestLoadIris(t *testing.T) {
+	X, y := LoadIris()
+
+	if rows, cols := X.Shape(); rows != 150 || cols != 4 {
+		t.Fatalf("shape = (%d, %d), want (150, 4)", rows, cols)
+	}
+	want := []string{"sepal_length", "sepal_width", "petal_length", "petal_width"}
+	for i, col := range X.Columns() {
+		if col != want[i] {
+			t.Errorf("columns = %v, want %v", X.Columns(), want)
+			break
+		}
+	}
+
+	counts := make(map[any]int)
+	for i := 0; i < y.Len(); i++ {
+		val, _ := y.Get(i)
+		counts[val]++
+	}
+	for _, species := range []string{"setosa", "versicolor", "virginica"} {
+		if counts[species] != 50 {
+			t.Errorf("%s has %d samples, want 50", species, counts[species])
+		}
+	}
+	if len(counts) != 3 {
+		t.Errorf("got classes %v, want 3", counts)
+	}
+
+	means, _ := X.Mean()
+	if math.Abs(means["petal_length"]-3.758) > 1e-9 {
+		t.Errorf("mean petal_length = %v, want 3.758", means["petal_length"])
+	}
+}
+
+func TestLoadMtcars(t *testing.T) {
+	X, y := LoadMtcars()
+
+	if rows, cols := X.Shape(); rows != 32 || cols != 10 {
+		t.Fatalf("shape = (%d, %d), want (32, 10)", rows, cols)
+	}
+	if X.HasColumn("mpg") || X.HasColumn("model") {
+		t.Errorf("columns = %v, want neither mpg nor model", X.Columns())
+	}
+	if first, _ := y.Get(0); first != 21.0 {
+		t.Errorf("mpg[0] = %v, want 21", first)
+	}
+
+	model := linear.NewLinearRegression(true)
+	if err := model.Fit(X.Select("wt", "hp"), y); err != nil {
+		t.Fatalf("Fit failed: %v", err)
+	}
+	score, _ := model.Score(X.Select("wt", "hp"), y)
+	// mpg ~ wt + hp has a well-known R² of 0.8268
+	if math.Abs(score-0.8268) > 1e-3 {
+		t.Errorf("R² = %v, want 0.8268", score)
+	}
+}
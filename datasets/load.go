@@ -0,0 +1,53 @@
+package datasets
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+
+	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/io/csv"
+	"github.com/TIVerse/GopherData/series"
+)
+
+//go:embed data/*.csv
+var data embed.FS
+
+// LoadIris returns Fisher's iris data: 150 flowers, 50 of each of the
+// species setosa, versicolor and virginica. X has the float64 columns
+// sepal_length, sepal_width, petal_length and petal_width, in centimetres,
+// and y is the species name.
+func LoadIris() (*dataframe.DataFrame, *series.Series[any]) {
+	return load("iris.csv", "species")
+}
+
+// LoadMtcars returns the 1974 Motor Trend road tests of 32 cars, a small
+// regression dataset. X is indexed by car model and has the columns cyl,
+// disp, hp, drat, wt, qsec, vs, am, gear and carb; y is fuel economy in
+// miles per US gallon (mpg).
+func LoadMtcars() (*dataframe.DataFrame, *series.Series[any]) {
+	X, y := load("mtcars.csv", "mpg")
+	X, err := X.SetIndexCol("model")
+	if err != nil {
+		panic(fmt.Sprintf("datasets: mtcars.csv: %v", err))
+	}
+	return X, y
+}
+
+// load reads an embedded CSV file and splits off its target column. The
+// files ship with the package, so a failure is a bug and panics.
+func load(name, target string) (*dataframe.DataFrame, *series.Series[any]) {
+	raw, err := data.ReadFile("data/" + name)
+	if err != nil {
+		panic(fmt.Sprintf("datasets: %s: %v", name, err))
+	}
+	df, err := csv.ReadCSVFrom(bytes.NewReader(raw))
+	if err != nil {
+		panic(fmt.Sprintf("datasets: %s: %v", name, err))
+	}
+	y, err := df.Column(target)
+	if err != nil {
+		panic(fmt.Sprintf("datasets: %s: %v", name, err))
+	}
+	return df.Drop(target), y
+}
@@ -205,13 +205,13 @@ func main() {
 	// =============================================================================
 	fmt.Println("=== 7. Reshape Operations ===")
 	
-	// Pivot table: product_id x region
-	pivotTable, err := sales.Pivot("product_id", "region", "amount")
+	// Pivot table: total amount per product_id x region
+	pivotTable, err := sales.PivotTable("product_id", "region", "amount", dataframe.AggSum)
 	if err != nil {
-		log.Fatalf("Pivot failed: %v", err)
+		log.Fatalf("PivotTable failed: %v", err)
 	}
 
-	fmt.Println("Pivot table (product_id x region):")
+	fmt.Println("Pivot table (total amount, product_id x region):")
 	fmt.Println(pivotTable)
 	fmt.Println()
 
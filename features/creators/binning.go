@@ -7,6 +7,7 @@ import (
 
 	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/features/internal/featureutil"
 	seriesPkg "github.com/TIVerse/GopherData/series"
 )
 
@@ -108,7 +109,7 @@ func (b *BinDiscretizer) Transform(df *dataframe.DataFrame) (*dataframe.DataFram
 				continue
 			}
 			
-			floatVal := toFloat64Creator(val)
+			floatVal, _ := core.ToFloat64(val)
 			binIdx := b.findBin(floatVal, edges)
 			
 			if b.Encode == "ordinal" {
@@ -154,6 +155,24 @@ func (b *BinDiscretizer) FitTransform(df *dataframe.DataFrame, target ...string)
 	return b.Transform(df)
 }
 
+// FeatureNamesOut returns in unchanged for ordinal encoding. For one-hot
+// encoding each discretized column is replaced by "<column>_bin<i>"
+// columns at the end.
+func (b *BinDiscretizer) FeatureNamesOut(in []string) []string {
+	out := append([]string(nil), in...)
+	if b.Encode != "onehot" {
+		return out
+	}
+	
+	for _, col := range b.Columns {
+		for binIdx := 0; binIdx < b.NBins; binIdx++ {
+			out = featureutil.WithColumnName(out, fmt.Sprintf("%s_bin%d", col, binIdx))
+		}
+		out = featureutil.WithoutColumnName(out, col)
+	}
+	return out
+}
+
 // IsFitted returns true if the discretizer has been fitted.
 func (b *BinDiscretizer) IsFitted() bool {
 	return b.fitted
@@ -257,7 +276,7 @@ func computeMinMax(series interface{ Len() int; Get(int) (any, bool) }) (float64
 			continue
 		}
 		
-		floatVal := toFloat64Creator(val)
+		floatVal, _ := core.ToFloat64(val)
 		if !found {
 			min = floatVal
 			max = floatVal
@@ -286,7 +305,8 @@ func collectValues(series interface{ Len() int; Get(int) (any, bool) }) []float6
 		if !ok {
 			continue
 		}
-		values = append(values, toFloat64Creator(val))
+		f, _ := core.ToFloat64(val)
+		values = append(values, f)
 	}
 	
 	return values
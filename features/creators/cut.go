@@ -0,0 +1,151 @@
+package creators
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/TIVerse/GopherData/core"
+	seriesPkg "github.com/TIVerse/GopherData/series"
+)
+
+// Cut bins the values of s into the intervals defined by explicit edges.
+// Intervals are closed on the right: (bins[0], bins[1]], (bins[1], bins[2]], ...
+// labels names each interval (len(bins)-1 labels); if nil, labels such as
+// "(0, 10]" are generated. Null values and values outside the edges become null.
+// Returns a string Series of bin labels, or an error wrapping
+// core.ErrTypeMismatch if s holds a non-numeric value.
+func Cut(s *seriesPkg.Series[any], bins []float64, labels []string) (*seriesPkg.Series[any], error) {
+	return cutEdges(s, bins, labels, false)
+}
+
+// QCut bins the values of s into q intervals with roughly equal counts,
+// using quantiles of the non-null values as edges.
+// The lowest interval also includes the minimum value.
+// labels names each interval (q labels); if nil, labels are generated as in Cut.
+// Returns a string Series of bin labels.
+func QCut(s *seriesPkg.Series[any], q int, labels []string) (*seriesPkg.Series[any], error) {
+	if q < 1 {
+		return nil, fmt.Errorf("q must be at least 1: %w", core.ErrInvalidArgument)
+	}
+
+	values, err := numericValues(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, core.ErrEmptySeries
+	}
+	sort.Float64s(values)
+
+	edges := make([]float64, q+1)
+	for i := 0; i <= q; i++ {
+		pos := float64(i) / float64(q) * float64(len(values)-1)
+		idx := int(pos)
+		if idx >= len(values)-1 {
+			edges[i] = values[len(values)-1]
+		} else {
+			fraction := pos - float64(idx)
+			edges[i] = values[idx]*(1-fraction) + values[idx+1]*fraction
+		}
+	}
+
+	for i := 1; i < len(edges); i++ {
+		if edges[i] <= edges[i-1] {
+			return nil, fmt.Errorf("quantile edges are not unique (too few distinct values for %d bins): %w", q, core.ErrInvalidArgument)
+		}
+	}
+
+	return cutEdges(s, edges, labels, true)
+}
+
+// cutEdges assigns each value to the right-closed interval it falls in.
+// When includeLowest is true, values equal to the first edge fall in the first interval.
+func cutEdges(s *seriesPkg.Series[any], edges []float64, labels []string, includeLowest bool) (*seriesPkg.Series[any], error) {
+	if len(edges) < 2 {
+		return nil, fmt.Errorf("at least 2 bin edges required: %w", core.ErrInvalidArgument)
+	}
+	for i := 1; i < len(edges); i++ {
+		if edges[i] <= edges[i-1] {
+			return nil, fmt.Errorf("bin edges must increase monotonically: %w", core.ErrInvalidArgument)
+		}
+	}
+
+	nBins := len(edges) - 1
+	if labels == nil {
+		labels = intervalLabels(edges, includeLowest)
+	} else if len(labels) != nBins {
+		return nil, fmt.Errorf("expected %d labels for %d bins, got %d: %w", nBins, nBins, len(labels), core.ErrInvalidArgument)
+	}
+
+	n := s.Len()
+	data := make([]any, n)
+	nulls := make([]int, 0)
+
+	for i := 0; i < n; i++ {
+		val, ok := s.Get(i)
+		if !ok || val == nil {
+			nulls = append(nulls, i)
+			continue
+		}
+
+		v, ok := core.ToFloat64(val)
+		if !ok {
+			return nil, fmt.Errorf("value %v at position %d is not numeric: %w", val, i, core.ErrTypeMismatch)
+		}
+		lowest := includeLowest && v == edges[0]
+		if (v <= edges[0] && !lowest) || v > edges[nBins] {
+			nulls = append(nulls, i)
+			continue
+		}
+
+		// First edge >= v closes the interval containing v
+		bin := sort.SearchFloat64s(edges, v) - 1
+		if bin < 0 {
+			bin = 0
+		}
+		data[i] = labels[bin]
+	}
+
+	result := seriesPkg.New(s.Name(), data, core.DtypeString)
+	for _, i := range nulls {
+		result.SetNull(i)
+	}
+
+	return result, nil
+}
+
+// numericValues returns the non-null values of s as float64, or an error
+// wrapping core.ErrTypeMismatch if any of them is not numeric.
+func numericValues(s *seriesPkg.Series[any]) ([]float64, error) {
+	values := make([]float64, 0, s.Len())
+	for i := 0; i < s.Len(); i++ {
+		val, ok := s.Get(i)
+		if !ok || val == nil {
+			continue
+		}
+		v, ok := core.ToFloat64(val)
+		if !ok {
+			return nil, fmt.Errorf("value %v at position %d is not numeric: %w", val, i, core.ErrTypeMismatch)
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// intervalLabels generates "(a, b]" labels for consecutive edges.
+func intervalLabels(edges []float64, includeLowest bool) []string {
+	labels := make([]string, len(edges)-1)
+	for i := range labels {
+		open := "("
+		if i == 0 && includeLowest {
+			open = "["
+		}
+		labels[i] = open + formatEdge(edges[i]) + ", " + formatEdge(edges[i+1]) + "]"
+	}
+	return labels
+}
+
+func formatEdge(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,85 @@
+package creators
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+	seriesPkg "github.com/TIVerse/GopherData/series"
+)
+
+func TestCut(t *testing.T) {
+	s := seriesPkg.New("age", []any{5.0, 10.0, 15.0, 0.0, 30.0, nil, int64(20)}, core.DtypeFloat64)
+
+	result, err := Cut(s, []float64{0, 10, 20, 25}, nil)
+	if err != nil {
+		t.Fatalf("Cut failed: %v", err)
+	}
+
+	expected := []any{"(0, 10]", "(0, 10]", "(10, 20]", nil, nil, nil, "(10, 20]"}
+	for i, want := range expected {
+		val, ok := result.Get(i)
+		if want == nil {
+			if ok {
+				t.Errorf("Index %d: expected null, got %v", i, val)
+			}
+			continue
+		}
+		if val != want {
+			t.Errorf("Index %d: expected %v, got %v", i, want, val)
+		}
+	}
+
+	if result.Name() != "age" {
+		t.Errorf("Expected name 'age', got %q", result.Name())
+	}
+
+	if _, err := Cut(s, []float64{0, 10}, []string{"a", "b"}); err == nil {
+		t.Error("Expected error for label count mismatch")
+	}
+	if _, err := Cut(s, []float64{10, 0}, nil); err == nil {
+		t.Error("Expected error for decreasing edges")
+	}
+
+	mixed := seriesPkg.New("mixed", []any{5.0, "n/a", 15.0}, core.DtypeString)
+	if _, err := Cut(mixed, []float64{0, 10, 20}, nil); !errors.Is(err, core.ErrTypeMismatch) {
+		t.Errorf("Cut: expected ErrTypeMismatch for a non-numeric value, got %v", err)
+	}
+	if _, err := QCut(mixed, 2, nil); !errors.Is(err, core.ErrTypeMismatch) {
+		t.Errorf("QCut: expected ErrTypeMismatch for a non-numeric value, got %v", err)
+	}
+}
+
+func TestQCut(t *testing.T) {
+	s := seriesPkg.New("score", []any{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0}, core.DtypeFloat64)
+
+	result, err := QCut(s, 3, []string{"low", "mid", "high"})
+	if err != nil {
+		t.Fatalf("QCut failed: %v", err)
+	}
+
+	// Edges are the 0, 1/3, 2/3, 1 quantiles: 1, 3.667, 6.333, 9
+	expected := []string{"low", "low", "low", "mid", "mid", "mid", "high", "high", "high"}
+	for i, want := range expected {
+		if val, _ := result.Get(i); val != want {
+			t.Errorf("Index %d: expected %s, got %v", i, want, val)
+		}
+	}
+
+	// Default labels include the minimum in the first interval
+	result, err = QCut(s, 2, nil)
+	if err != nil {
+		t.Fatalf("QCut failed: %v", err)
+	}
+	if val, _ := result.Get(0); val != "[1, 5]" {
+		t.Errorf("Expected first label [1, 5], got %v", val)
+	}
+	if val, _ := result.Get(8); val != "(5, 9]" {
+		t.Errorf("Expected last label (5, 9], got %v", val)
+	}
+
+	constant := seriesPkg.New("c", []any{1.0, 1.0, 1.0}, core.DtypeFloat64)
+	if _, err := QCut(constant, 2, nil); err == nil {
+		t.Error("Expected error for duplicate quantile edges")
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/features/internal/featureutil"
 	seriesPkg "github.com/TIVerse/GopherData/series"
 )
 
@@ -75,7 +76,9 @@ func (i *InteractionFeatures) Transform(df *dataframe.DataFrame) (*dataframe.Dat
 					continue
 				}
 				
-				interaction[j] = toFloat64Creator(val1) * toFloat64Creator(val2)
+				f1, _ := core.ToFloat64(val1)
+				f2, _ := core.ToFloat64(val2)
+				interaction[j] = f1 * f2
 			}
 			
 			newColName := fmt.Sprintf("%s*%s", col1, col2)
@@ -94,6 +97,26 @@ func (i *InteractionFeatures) FitTransform(df *dataframe.DataFrame, target ...st
 	return i.Transform(df)
 }
 
+// FeatureNamesOut returns in followed by an "a*b" column for each pair of
+// columns that are both in in.
+func (i *InteractionFeatures) FeatureNamesOut(in []string) []string {
+	present := make(map[string]bool, len(in))
+	for _, col := range in {
+		present[col] = true
+	}
+	
+	out := append([]string(nil), in...)
+	for idx1, col1 := range i.Columns {
+		for idx2 := idx1 + 1; idx2 < len(i.Columns); idx2++ {
+			col2 := i.Columns[idx2]
+			if present[col1] && present[col2] {
+				out = featureutil.WithColumnName(out, fmt.Sprintf("%s*%s", col1, col2))
+			}
+		}
+	}
+	return out
+}
+
 // IsFitted returns true if the transformer has been fitted.
 func (i *InteractionFeatures) IsFitted() bool {
 	return i.fitted
@@ -0,0 +1,42 @@
+package creators
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/TIVerse/GopherData/dataframe"
+)
+
+func TestPolynomialFeaturesDegree3(t *testing.T) {
+	df, _ := dataframe.New(map[string]any{
+		"a": []float64{1, 2},
+		"b": []float64{3, 4},
+	})
+	df = df.Select("a", "b")
+	b, _ := df.Column("b")
+	b.SetNull(1)
+
+	poly := NewPolynomialFeatures(3)
+	result, err := poly.FitTransform(df)
+	if err != nil {
+		t.Fatalf("FitTransform failed: %v", err)
+	}
+
+	want := "[a b bias a^2 b^2 a*b a^3 b^3 a^2*b b^2*a]"
+	if got := fmt.Sprint(result.Columns()); got != want {
+		t.Errorf("columns = %s, want %s", got, want)
+	}
+	if got := fmt.Sprint(poly.FeatureNamesOut(df.Columns())); got != want {
+		t.Errorf("FeatureNamesOut = %s, want %s", got, want)
+	}
+
+	for col, expected := range map[string]float64{"a^3": 1, "a^2*b": 3, "b^2*a": 9} {
+		s, _ := result.Column(col)
+		if v, _ := s.Get(0); v != expected {
+			t.Errorf("%s[0] = %v, want %v", col, v, expected)
+		}
+		if col != "a^3" && !s.IsNull(1) {
+			t.Errorf("%s[1] should be null where b is null", col)
+		}
+	}
+}
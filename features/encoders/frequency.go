@@ -122,6 +122,12 @@ func (f *FrequencyEncoder) FitTransform(df *dataframe.DataFrame, target ...strin
 	return f.Transform(df)
 }
 
+// FeatureNamesOut returns the input columns unchanged, as the encoder encodes
+// columns in place.
+func (f *FrequencyEncoder) FeatureNamesOut(in []string) []string {
+	return append([]string(nil), in...)
+}
+
 // IsFitted returns true if the encoder has been fitted.
 func (f *FrequencyEncoder) IsFitted() bool {
 	return f.fitted
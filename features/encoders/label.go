@@ -96,6 +96,12 @@ func (l *LabelEncoder) FitTransform(df *dataframe.DataFrame, target ...string) (
 	return l.Transform(df)
 }
 
+// FeatureNamesOut returns the input columns unchanged, as the encoder encodes
+// columns in place.
+func (l *LabelEncoder) FeatureNamesOut(in []string) []string {
+	return append([]string(nil), in...)
+}
+
 // IsFitted returns true if the encoder has been fitted.
 func (l *LabelEncoder) IsFitted() bool {
 	return l.fitted
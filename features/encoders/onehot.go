@@ -3,9 +3,11 @@ package encoders
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/features/internal/featureutil"
 	seriesPkg "github.com/TIVerse/GopherData/series"
 )
 
@@ -20,11 +22,24 @@ type OneHotEncoder struct {
 	DropFirst bool
 	
 	// HandleUnknown specifies how to handle unknown categories during transform.
-	// Options: "error" (default), "ignore"
+	// Options: "ignore" (default), which leaves all their indicators 0, and
+	// "error", which fails the Transform.
+	// Categories bucketed as infrequent during Fit are not unknown.
 	HandleUnknown string
 	
+	// MaxCategories keeps only the N most frequent categories of each column
+	// and buckets the rest into a single "<column>_other" indicator.
+	// Ties are broken by first appearance. Default: 0 (no limit)
+	MaxCategories int
+	
+	// MinFrequency buckets categories whose share of the non-null training
+	// values is below this fraction into the "other" indicator.
+	// Default: 0 (no threshold)
+	MinFrequency float64
+	
 	// Fitted categories for each column
 	categories map[string][]string
+	infrequent map[string][]string
 	fitted     bool
 }
 
@@ -33,19 +48,29 @@ func NewOneHotEncoder(columns []string) *OneHotEncoder {
 	return &OneHotEncoder{
 		Columns:       columns,
 		DropFirst:     false,
-		HandleUnknown: "error",
+		HandleUnknown: "ignore",
 		categories:    make(map[string][]string),
+		infrequent:    make(map[string][]string),
 		fitted:        false,
 	}
 }
 
 // Fit learns the unique categories for each column.
+// When MaxCategories or MinFrequency is set, rare categories are recorded as
+// infrequent instead of getting their own indicator.
 func (o *OneHotEncoder) Fit(df *dataframe.DataFrame, _ ...string) error {
 	if len(o.Columns) == 0 {
 		return fmt.Errorf("no columns specified for encoding")
 	}
+	if o.MaxCategories < 0 {
+		return fmt.Errorf("MaxCategories must be non-negative: %w", core.ErrInvalidArgument)
+	}
+	if o.MinFrequency < 0 || o.MinFrequency > 1 {
+		return fmt.Errorf("MinFrequency must be in [0, 1]: %w", core.ErrInvalidArgument)
+	}
 	
 	o.categories = make(map[string][]string)
+	o.infrequent = make(map[string][]string)
 	
 	for _, col := range o.Columns {
 		colSeries, err := df.Column(col)
@@ -55,13 +80,71 @@ func (o *OneHotEncoder) Fit(df *dataframe.DataFrame, _ ...string) error {
 		
 		// Get unique values
 		unique := getUniqueStrings(colSeries)
-		o.categories[col] = unique
+		kept, infrequent := o.splitInfrequent(colSeries, unique)
+		o.categories[col] = kept
+		
+		if len(infrequent) > 0 {
+			for _, category := range kept {
+				if category == "other" {
+					return fmt.Errorf("column %q: category \"other\" collides with the infrequent bucket: %w", col, core.ErrDuplicateColumn)
+				}
+			}
+			o.infrequent[col] = infrequent
+		}
 	}
 	
 	o.fitted = true
 	return nil
 }
 
+// splitInfrequent partitions unique (in first-appearance order) into the
+// categories that keep an indicator and those bucketed as infrequent.
+// Both results preserve first-appearance order.
+func (o *OneHotEncoder) splitInfrequent(series interface{ Len() int; Get(int) (any, bool) }, unique []string) ([]string, []string) {
+	if o.MaxCategories == 0 && o.MinFrequency == 0 {
+		return unique, nil
+	}
+	
+	counts := make(map[string]int, len(unique))
+	total := 0
+	for i := 0; i < series.Len(); i++ {
+		val, ok := series.Get(i)
+		if !ok {
+			continue
+		}
+		counts[toString(val)]++
+		total++
+	}
+	
+	// Rank by descending frequency; the stable sort keeps first appearance on ties
+	ranked := make([]string, len(unique))
+	copy(ranked, unique)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return counts[ranked[i]] > counts[ranked[j]]
+	})
+	
+	keep := make(map[string]bool, len(ranked))
+	for rank, category := range ranked {
+		if o.MaxCategories > 0 && rank >= o.MaxCategories {
+			break
+		}
+		if float64(counts[category]) < o.MinFrequency*float64(total) {
+			break
+		}
+		keep[category] = true
+	}
+	
+	var kept, infrequent []string
+	for _, category := range unique {
+		if keep[category] {
+			kept = append(kept, category)
+		} else {
+			infrequent = append(infrequent, category)
+		}
+	}
+	return kept, infrequent
+}
+
 // Transform applies one-hot encoding to the data.
 func (o *OneHotEncoder) Transform(df *dataframe.DataFrame) (*dataframe.DataFrame, error) {
 	if !o.fitted {
@@ -82,29 +165,49 @@ func (o *OneHotEncoder) Transform(df *dataframe.DataFrame) (*dataframe.DataFrame
 			startIdx = 1
 		}
 		
-		// Create binary column for each category
-		for i := startIdx; i < len(categories); i++ {
-			category := categories[i]
-			newColName := fmt.Sprintf("%s_%s", col, category)
+		// Map each known value to its indicator position; infrequent values
+		// share the position after the last category
+		position := make(map[string]int, len(categories)+len(o.infrequent[col]))
+		for i, category := range categories {
+			position[category] = i
+		}
+		otherIdx := len(categories)
+		for _, category := range o.infrequent[col] {
+			position[category] = otherIdx
+		}
+		
+		indicators := make([][]any, otherIdx+1)
+		for i := range indicators {
+			indicators[i] = make([]any, colSeries.Len())
+			for j := range indicators[i] {
+				indicators[i][j] = int64(0)
+			}
+		}
+		
+		for j := 0; j < colSeries.Len(); j++ {
+			val, ok := colSeries.Get(j)
+			if !ok {
+				continue // Treat nulls as 0
+			}
 			
-			// Create binary values
-			binary := make([]any, colSeries.Len())
-			for j := 0; j < colSeries.Len(); j++ {
-				val, ok := colSeries.Get(j)
-				if !ok {
-					binary[j] = int64(0) // Treat nulls as 0
+			idx, known := position[toString(val)]
+			if !known {
+				if o.HandleUnknown != "error" {
 					continue
 				}
-				
-				valStr := toString(val)
-				if valStr == category {
-					binary[j] = int64(1)
-				} else {
-					binary[j] = int64(0)
-				}
+				return nil, fmt.Errorf("column %q: unknown category %q at row %d", col, toString(val), j)
 			}
-			
-			result = result.WithColumn(newColName, seriesPkg.New(newColName, binary, core.DtypeInt64))
+			indicators[idx][j] = int64(1)
+		}
+		
+		// Create binary column for each category
+		for i := startIdx; i < len(categories); i++ {
+			newColName := fmt.Sprintf("%s_%s", col, categories[i])
+			result = result.WithColumn(newColName, seriesPkg.New(newColName, indicators[i], core.DtypeInt64))
+		}
+		if len(o.infrequent[col]) > 0 {
+			newColName := col + "_other"
+			result = result.WithColumn(newColName, seriesPkg.New(newColName, indicators[otherIdx], core.DtypeInt64))
 		}
 		
 		// Drop original column
@@ -122,6 +225,31 @@ func (o *OneHotEncoder) FitTransform(df *dataframe.DataFrame, target ...string)
 	return o.Transform(df)
 }
 
+// FeatureNamesOut returns the columns Transform produces from columns in:
+// each encoded column is replaced by its "<column>_<category>" indicators,
+// and "<column>_other" if it has infrequent categories, at the end.
+func (o *OneHotEncoder) FeatureNamesOut(in []string) []string {
+	out := append([]string(nil), in...)
+	startIdx := 0
+	if o.DropFirst {
+		startIdx = 1
+	}
+	
+	for _, col := range o.Columns {
+		categories := o.categories[col]
+		for i := startIdx; i < len(categories); i++ {
+			out = featureutil.WithColumnName(out, fmt.Sprintf("%s_%s", col, categories[i]))
+		}
+		if len(o.infrequent[col]) > 0 {
+			out = featureutil.WithColumnName(out, col+"_other")
+		}
+		
+		// Drop original column
+		out = featureutil.WithoutColumnName(out, col)
+	}
+	return out
+}
+
 // IsFitted returns true if the encoder has been fitted.
 func (o *OneHotEncoder) IsFitted() bool {
 	return o.fitted
@@ -138,6 +266,18 @@ func (o *OneHotEncoder) GetCategories() map[string][]string {
 	return categories
 }
 
+// GetInfrequentCategories returns, for each column, the categories bucketed
+// into the "other" indicator during Fit.
+func (o *OneHotEncoder) GetInfrequentCategories() map[string][]string {
+	infrequent := make(map[string][]string, len(o.infrequent))
+	for k, v := range o.infrequent {
+		cats := make([]string, len(v))
+		copy(cats, v)
+		infrequent[k] = cats
+	}
+	return infrequent
+}
+
 // Helper functions
 
 func getUniqueStrings(series interface{ Len() int; Get(int) (any, bool) }) []string {
@@ -170,3 +310,4 @@ func toString(val any) string {
 		return fmt.Sprintf("%v", v)
 	}
 }
+
@@ -1,6 +1,7 @@
 package encoders
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/TIVerse/GopherData/dataframe"
@@ -177,3 +178,91 @@ func TestOneHotEncoderFitTransform(t *testing.T) {
 		t.Errorf("Expected 3 columns, got %d", encoded.Ncols())
 	}
 }
+
+func TestOneHotEncoderInfrequent(t *testing.T) {
+	// Three common categories followed by 50 categories seen once each
+	values := []string{"a", "a", "a", "a", "b", "b", "b", "c", "c"}
+	for i := 0; i < 50; i++ {
+		values = append(values, fmt.Sprintf("rare%d", i))
+	}
+	df, _ := dataframe.New(map[string]any{"cat": values})
+
+	t.Run("MaxCategories", func(t *testing.T) {
+		encoder := NewOneHotEncoder([]string{"cat"})
+		encoder.MaxCategories = 2
+		encoded, err := encoder.FitTransform(df)
+		if err != nil {
+			t.Fatalf("FitTransform failed: %v", err)
+		}
+
+		// cat_a, cat_b, cat_other
+		if encoded.Ncols() != 3 {
+			t.Fatalf("Expected 3 columns, got %d: %v", encoded.Ncols(), encoded.Columns())
+		}
+		if got := encoder.GetCategories()["cat"]; len(got) != 2 || got[0] != "a" || got[1] != "b" {
+			t.Errorf("Expected kept categories [a b], got %v", got)
+		}
+		if got := encoder.GetInfrequentCategories()["cat"]; len(got) != 51 {
+			t.Errorf("Expected 51 infrequent categories, got %d", len(got))
+		}
+
+		other, err := encoded.Column("cat_other")
+		if err != nil {
+			t.Fatalf("Expected cat_other column: %v", err)
+		}
+		for i, v := range values {
+			got, _ := other.Get(i)
+			want := int64(0)
+			if v != "a" && v != "b" {
+				want = 1
+			}
+			if got != want {
+				t.Errorf("Row %d (%s): expected other=%d, got %v", i, v, want, got)
+			}
+		}
+	})
+
+	t.Run("MinFrequency", func(t *testing.T) {
+		encoder := NewOneHotEncoder([]string{"cat"})
+		encoder.MinFrequency = 0.05 // 3 of 59 rows
+		encoded, err := encoder.FitTransform(df)
+		if err != nil {
+			t.Fatalf("FitTransform failed: %v", err)
+		}
+
+		// cat_a, cat_b, cat_other; "c" (2 rows) falls below the threshold
+		if encoded.Ncols() != 3 {
+			t.Errorf("Expected 3 columns, got %d: %v", encoded.Ncols(), encoded.Columns())
+		}
+	})
+
+	t.Run("UnknownAfterBucketing", func(t *testing.T) {
+		encoder := NewOneHotEncoder([]string{"cat"})
+		encoder.MaxCategories = 2
+		if err := encoder.Fit(df); err != nil {
+			t.Fatalf("Fit failed: %v", err)
+		}
+
+		// Infrequent training categories are known, not unknown
+		seen, _ := dataframe.New(map[string]any{"cat": []string{"rare7", "a"}})
+		if _, err := encoder.Transform(seen); err != nil {
+			t.Errorf("Transform of infrequent category failed: %v", err)
+		}
+
+		// Unknown categories are ignored by default
+		unseen, _ := dataframe.New(map[string]any{"cat": []string{"never"}})
+		encoded, err := encoder.Transform(unseen)
+		if err != nil {
+			t.Fatalf("Transform with HandleUnknown=ignore failed: %v", err)
+		}
+		other, _ := encoded.Column("cat_other")
+		if v, _ := other.Get(0); v != int64(0) {
+			t.Errorf("Expected ignored unknown to be all zeros, got other=%v", v)
+		}
+
+		encoder.HandleUnknown = "error"
+		if _, err := encoder.Transform(unseen); err == nil {
+			t.Error("Expected error for unknown category with HandleUnknown=error")
+		}
+	})
+}
@@ -93,6 +93,12 @@ func (o *OrdinalEncoder) FitTransform(df *dataframe.DataFrame, target ...string)
 	return o.Transform(df)
 }
 
+// FeatureNamesOut returns the input columns unchanged, as the encoder encodes
+// columns in place.
+func (o *OrdinalEncoder) FeatureNamesOut(in []string) []string {
+	return append([]string(nil), in...)
+}
+
 // IsFitted returns true if the encoder has been fitted.
 func (o *OrdinalEncoder) IsFitted() bool {
 	return o.fitted
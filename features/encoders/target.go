@@ -86,7 +86,8 @@ func (t *TargetEncoder) Fit(df *dataframe.DataFrame, target ...string) error {
 				categoryStats[category] = &categoryTargetStats{}
 			}
 			
-			categoryStats[category].sum += toFloat64Target(targetVal)
+			f, _ := core.ToFloat64(targetVal)
+			categoryStats[category].sum += f
 			categoryStats[category].count++
 		}
 		
@@ -153,6 +154,12 @@ func (t *TargetEncoder) FitTransform(df *dataframe.DataFrame, target ...string)
 	return t.Transform(df)
 }
 
+// FeatureNamesOut returns the input columns unchanged, as the encoder encodes
+// columns in place.
+func (t *TargetEncoder) FeatureNamesOut(in []string) []string {
+	return append([]string(nil), in...)
+}
+
 // IsFitted returns true if the encoder has been fitted.
 func (t *TargetEncoder) IsFitted() bool {
 	return t.fitted
@@ -184,7 +191,8 @@ func computeTargetMean(series interface{ Len() int; Get(int) (any, bool) }) floa
 		if !ok {
 			continue
 		}
-		sum += toFloat64Target(val)
+		f, _ := core.ToFloat64(val)
+		sum += f
 		count++
 	}
 	
@@ -194,24 +202,3 @@ func computeTargetMean(series interface{ Len() int; Get(int) (any, bool) }) floa
 	return sum / float64(count)
 }
 
-func toFloat64Target(val any) float64 {
-	switch v := val.(type) {
-	case float64:
-		return v
-	case float32:
-		return float64(v)
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	case int32:
-		return float64(v)
-	case bool:
-		if v {
-			return 1.0
-		}
-		return 0.0
-	default:
-		return 0
-	}
-}
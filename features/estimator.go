@@ -2,19 +2,19 @@
 package features
 
 import (
+	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
 )
 
 // Estimator learns from data and transforms it.
-// This follows the sklearn fit/transform pattern.
+// This follows the sklearn fit/transform pattern; Transform is the
+// core.Transformer contract.
 type Estimator interface {
+	core.Transformer[*dataframe.DataFrame]
+
 	// Fit learns parameters from the training data.
 	// The target parameter is optional and used by supervised transformers (e.g., TargetEncoder).
 	Fit(df *dataframe.DataFrame, target ...string) error
-
-	// Transform applies the learned transformation to the data.
-	// Returns a new DataFrame with the transformation applied.
-	Transform(df *dataframe.DataFrame) (*dataframe.DataFrame, error)
 }
 
 // Transformer combines Fit and Transform operations.
@@ -41,3 +41,10 @@ type Fittable interface {
 	// IsFitted returns true if the estimator has been fitted.
 	IsFitted() bool
 }
+
+// FeatureNamer reports the columns a fitted transformer outputs.
+type FeatureNamer interface {
+	// FeatureNamesOut returns the columns Transform produces, in order,
+	// from a DataFrame with columns in.
+	FeatureNamesOut(in []string) []string
+}
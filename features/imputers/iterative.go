@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
 	seriesPkg "github.com/TIVerse/GopherData/series"
 )
@@ -120,7 +121,9 @@ func (i *IterativeImputer) Transform(df *dataframe.DataFrame) (*dataframe.DataFr
 					
 					// Track convergence
 					currVal, _ := currentSeries.Get(j)
-					change := math.Abs(toFloat64Impute(predicted) - toFloat64Impute(currVal))
+					p, _ := core.ToFloat64(predicted)
+					c, _ := core.ToFloat64(currVal)
+					change := math.Abs(p - c)
 					if change > maxChange {
 						maxChange = change
 					}
@@ -174,7 +177,8 @@ func (i *IterativeImputer) predictValue(df *dataframe.DataFrame, rowIdx int, tar
 		}
 		
 		// Use this row's target value (could weight by similarity)
-		sum += toFloat64Impute(targetVal)
+		f, _ := core.ToFloat64(targetVal)
+		sum += f
 		count++
 		
 		// Limit to reasonable number of rows for performance
@@ -198,7 +202,8 @@ func computeMean(series *seriesPkg.Series[any]) float64 {
 	for i := 0; i < series.Len(); i++ {
 		val, ok := series.Get(i)
 		if ok {
-			sum += toFloat64Impute(val)
+			f, _ := core.ToFloat64(val)
+			sum += f
 			count++
 		}
 	}
@@ -213,7 +218,8 @@ func computeMedian(series *seriesPkg.Series[any]) float64 {
 	for i := 0; i < series.Len(); i++ {
 		val, ok := series.Get(i)
 		if ok {
-			values = append(values, toFloat64Impute(val))
+			f, _ := core.ToFloat64(val)
+			values = append(values, f)
 		}
 	}
 	if len(values) == 0 {
@@ -244,6 +250,12 @@ func (i *IterativeImputer) FitTransform(df *dataframe.DataFrame, target ...strin
 	return i.Transform(df)
 }
 
+// FeatureNamesOut returns the input columns unchanged, as the imputer fills
+// columns in place.
+func (i *IterativeImputer) FeatureNamesOut(in []string) []string {
+	return append([]string(nil), in...)
+}
+
 // IsFitted returns true if the imputer has been fitted.
 func (i *IterativeImputer) IsFitted() bool {
 	return i.fitted
@@ -5,6 +5,7 @@ import (
 	"math"
 	"sort"
 
+	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
 	seriesPkg "github.com/TIVerse/GopherData/series"
 )
@@ -90,6 +91,12 @@ func (k *KNNImputer) FitTransform(df *dataframe.DataFrame, target ...string) (*d
 	return k.Transform(df)
 }
 
+// FeatureNamesOut returns the input columns unchanged, as the imputer fills
+// columns in place.
+func (k *KNNImputer) FeatureNamesOut(in []string) []string {
+	return append([]string(nil), in...)
+}
+
 // IsFitted returns true if the imputer has been fitted.
 func (k *KNNImputer) IsFitted() bool {
 	return k.fitted
@@ -137,7 +144,9 @@ func (k *KNNImputer) computeDistance(df1 *dataframe.DataFrame, idx1 int, df2 *da
 			continue // Skip if either is missing
 		}
 		
-		diff := toFloat64Impute(v1) - toFloat64Impute(v2)
+		f1, _ := core.ToFloat64(v1)
+		f2, _ := core.ToFloat64(v2)
+		diff := f1 - f2
 		sumSq += diff * diff
 		count++
 	}
@@ -172,7 +181,8 @@ func (k *KNNImputer) computeImputedValue(col string, neighbors []neighborInfo) f
 			}
 		}
 		
-		weightedSum += toFloat64Impute(val) * weight
+		f, _ := core.ToFloat64(val)
+		weightedSum += f * weight
 		weightSum += weight
 	}
 	
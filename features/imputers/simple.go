@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
 )
 
@@ -103,6 +104,12 @@ func (s *SimpleImputer) FitTransform(df *dataframe.DataFrame, target ...string)
 	return s.Transform(df)
 }
 
+// FeatureNamesOut returns the input columns unchanged, as the imputer fills
+// columns in place.
+func (s *SimpleImputer) FeatureNamesOut(in []string) []string {
+	return append([]string(nil), in...)
+}
+
 // IsFitted returns true if the imputer has been fitted.
 func (s *SimpleImputer) IsFitted() bool {
 	return s.fitted
@@ -117,6 +124,16 @@ func (s *SimpleImputer) GetStats() map[string]any {
 	return stats
 }
 
+// SetStats restores a fitted imputer from the statistics returned by
+// GetStats, for example when loading a saved pipeline.
+func (s *SimpleImputer) SetStats(stats map[string]any) {
+	s.stats = make(map[string]any, len(stats))
+	for k, v := range stats {
+		s.stats[k] = v
+	}
+	s.fitted = true
+}
+
 // Helper functions
 
 func hasNulls(series interface{ Len() int; Get(int) (any, bool) }) bool {
@@ -138,7 +155,8 @@ func computeSeriesMean(series interface{ Len() int; Get(int) (any, bool) }) floa
 		if !ok || val == nil {
 			continue
 		}
-		sum += toFloat64Impute(val)
+		f, _ := core.ToFloat64(val)
+		sum += f
 		count++
 	}
 	
@@ -156,7 +174,8 @@ func computeSeriesMedian(series interface{ Len() int; Get(int) (any, bool) }) fl
 		if !ok || val == nil {
 			continue
 		}
-		values = append(values, toFloat64Impute(val))
+		f, _ := core.ToFloat64(val)
+		values = append(values, f)
 	}
 	
 	if len(values) == 0 {
@@ -203,19 +222,3 @@ func computeMode(series interface{ Len() int; Get(int) (any, bool) }) any {
 	return values[mode]
 }
 
-func toFloat64Impute(val any) float64 {
-	switch v := val.(type) {
-	case float64:
-		return v
-	case float32:
-		return float64(v)
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	case int32:
-		return float64(v)
-	default:
-		return 0
-	}
-}
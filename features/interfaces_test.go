@@ -0,0 +1,79 @@
+package features
+
+import (
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/features/creators"
+	"github.com/TIVerse/GopherData/features/encoders"
+	"github.com/TIVerse/GopherData/features/imputers"
+	"github.com/TIVerse/GopherData/features/scalers"
+	"github.com/TIVerse/GopherData/features/selectors"
+	"github.com/TIVerse/GopherData/features/text"
+)
+
+// Compile-time checks that the transformers conform to features.Transformer
+// and so to core.Transformer.
+var (
+	_ core.Transformer[*dataframe.DataFrame] = Transformer(nil)
+	_ core.Transformer[*dataframe.DataFrame] = (*Pipeline)(nil)
+
+	_ Transformer = (*ColumnSelector)(nil)
+
+	_ Transformer = (*scalers.StandardScaler)(nil)
+	_ Transformer = (*scalers.MinMaxScaler)(nil)
+	_ Transformer = (*scalers.MaxAbsScaler)(nil)
+	_ Transformer = (*scalers.RobustScaler)(nil)
+
+	_ Transformer = (*encoders.OneHotEncoder)(nil)
+	_ Transformer = (*encoders.LabelEncoder)(nil)
+	_ Transformer = (*encoders.OrdinalEncoder)(nil)
+	_ Transformer = (*encoders.FrequencyEncoder)(nil)
+	_ Transformer = (*encoders.TargetEncoder)(nil)
+
+	_ Transformer = (*imputers.SimpleImputer)(nil)
+	_ Transformer = (*imputers.KNNImputer)(nil)
+	_ Transformer = (*imputers.IterativeImputer)(nil)
+
+	_ Transformer = (*selectors.VarianceThreshold)(nil)
+	_ Transformer = (*selectors.SelectKBest)(nil)
+	_ Transformer = (*selectors.SelectPercentile)(nil)
+	_ Transformer = (*selectors.RFE)(nil)
+
+	_ Transformer = (*creators.PolynomialFeatures)(nil)
+	_ Transformer = (*creators.InteractionFeatures)(nil)
+	_ Transformer = (*creators.BinDiscretizer)(nil)
+
+	_ Transformer = (*text.CountVectorizer)(nil)
+)
+
+// Every transformer reports the columns it outputs, so pipelines of them
+// support GetFeatureNames.
+var (
+	_ FeatureNamer = (*ColumnSelector)(nil)
+
+	_ FeatureNamer = (*scalers.StandardScaler)(nil)
+	_ FeatureNamer = (*scalers.MinMaxScaler)(nil)
+	_ FeatureNamer = (*scalers.MaxAbsScaler)(nil)
+	_ FeatureNamer = (*scalers.RobustScaler)(nil)
+
+	_ FeatureNamer = (*encoders.OneHotEncoder)(nil)
+	_ FeatureNamer = (*encoders.LabelEncoder)(nil)
+	_ FeatureNamer = (*encoders.OrdinalEncoder)(nil)
+	_ FeatureNamer = (*encoders.FrequencyEncoder)(nil)
+	_ FeatureNamer = (*encoders.TargetEncoder)(nil)
+
+	_ FeatureNamer = (*imputers.SimpleImputer)(nil)
+	_ FeatureNamer = (*imputers.KNNImputer)(nil)
+	_ FeatureNamer = (*imputers.IterativeImputer)(nil)
+
+	_ FeatureNamer = (*selectors.VarianceThreshold)(nil)
+	_ FeatureNamer = (*selectors.SelectKBest)(nil)
+	_ FeatureNamer = (*selectors.SelectPercentile)(nil)
+	_ FeatureNamer = (*selectors.RFE)(nil)
+
+	_ FeatureNamer = (*creators.PolynomialFeatures)(nil)
+	_ FeatureNamer = (*creators.InteractionFeatures)(nil)
+	_ FeatureNamer = (*creators.BinDiscretizer)(nil)
+
+	_ FeatureNamer = (*text.CountVectorizer)(nil)
+)
@@ -0,0 +1,41 @@
+// Package featureutil holds helpers shared by the feature packages.
+package featureutil
+
+// WithColumnName returns names with name added the way DataFrame.WithColumn
+// adds a column: appended if new, otherwise left where it is.
+func WithColumnName(names []string, name string) []string {
+	for _, existing := range names {
+		if existing == name {
+			return names
+		}
+	}
+	return append(names, name)
+}
+
+// WithoutColumnName returns names without name, as DataFrame.Drop does.
+func WithoutColumnName(names []string, name string) []string {
+	out := names[:0:0]
+	for _, existing := range names {
+		if existing != name {
+			out = append(out, existing)
+		}
+	}
+	return out
+}
+
+// PresentColumns returns the columns of cols that are in in, keeping the
+// order of cols as DataFrame.Select does.
+func PresentColumns(cols, in []string) []string {
+	present := make(map[string]bool, len(in))
+	for _, col := range in {
+		present[col] = true
+	}
+
+	out := make([]string, 0, len(cols))
+	for _, col := range cols {
+		if present[col] {
+			out = append(out, col)
+		}
+	}
+	return out
+}
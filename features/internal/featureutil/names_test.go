@@ -0,0 +1,27 @@
+package featureutil
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestColumnNames(t *testing.T) {
+	names := []string{"a", "b"}
+
+	if got := fmt.Sprint(WithColumnName(names, "c")); got != "[a b c]" {
+		t.Errorf("WithColumnName new = %s, want [a b c]", got)
+	}
+	if got := fmt.Sprint(WithColumnName(names, "a")); got != "[a b]" {
+		t.Errorf("WithColumnName existing = %s, want [a b]", got)
+	}
+	if got := fmt.Sprint(WithoutColumnName(names, "a")); got != "[b]" {
+		t.Errorf("WithoutColumnName = %s, want [b]", got)
+	}
+	if got := fmt.Sprint(names); got != "[a b]" {
+		t.Errorf("WithoutColumnName modified its input: %s", got)
+	}
+
+	if got := fmt.Sprint(PresentColumns([]string{"c", "b", "a"}, names)); got != "[b a]" {
+		t.Errorf("PresentColumns = %s, want [b a]", got)
+	}
+}
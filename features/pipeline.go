@@ -4,9 +4,15 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/series"
 )
 
+// Predictor is a model that can end a pipeline, such as
+// linear.LogisticRegression.
+type Predictor = core.Predictor[*dataframe.DataFrame, *series.Series[any]]
+
 // PipelineStep represents a single transformation step in a pipeline.
 type PipelineStep struct {
 	Name      string
@@ -15,10 +21,17 @@ type PipelineStep struct {
 }
 
 // Pipeline chains multiple transformers in sequence.
-// Each step is fitted and applied in order.
+// Each step is fitted and applied in order. A pipeline may end with a
+// model, set with SetModel, which is fitted on and predicts from the
+// output of the transformers.
 type Pipeline struct {
-	steps []PipelineStep
-	mu    sync.RWMutex
+	steps       []PipelineStep
+	model       Predictor
+	modelName   string
+	modelFitted bool
+	namesIn     []string // columns of the DataFrame the pipeline was fitted on
+	target      string   // target column the model was fitted with
+	mu          sync.RWMutex
 }
 
 // NewPipeline creates a new empty pipeline.
@@ -42,6 +55,28 @@ func (p *Pipeline) Add(name string, estimator Estimator) *Pipeline {
 	return p
 }
 
+// SetModel sets the model that ends the pipeline, replacing any previous
+// one. The model always runs after every transformer, however the calls to
+// Add and SetModel are ordered.
+// Returns the pipeline for method chaining.
+func (p *Pipeline) SetModel(name string, model Predictor) *Pipeline {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	
+	p.model = model
+	p.modelName = name
+	p.modelFitted = false
+	return p
+}
+
+// Model returns the model that ends the pipeline and its name, or nil if
+// there is none.
+func (p *Pipeline) Model() (Predictor, string) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.model, p.modelName
+}
+
 // Steps returns a copy of the pipeline steps.
 func (p *Pipeline) Steps() []PipelineStep {
 	p.mu.RLock()
@@ -54,14 +89,36 @@ func (p *Pipeline) Steps() []PipelineStep {
 
 // Fit trains all transformers in the pipeline.
 // Each transformer is fitted on the output of the previous transformer.
+//
+// If the pipeline has a model, target must name the target column of df.
+// The model is then fitted on the transformed data without that column,
+// with the column as y. The transformers still see the target column, as
+// supervised ones such as TargetEncoder need it, so give the others
+// explicit columns to keep them off the target.
 func (p *Pipeline) Fit(df *dataframe.DataFrame, target ...string) error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 	
-	if len(p.steps) == 0 {
+	if len(p.steps) == 0 && p.model == nil {
 		return fmt.Errorf("pipeline is empty")
 	}
 	
+	p.namesIn = df.Columns()
+	p.target = ""
+	
+	var y *series.Series[any]
+	if p.model != nil {
+		if len(target) == 0 {
+			return fmt.Errorf("model %q needs a target column: %w", p.modelName, core.ErrInvalidArgument)
+		}
+		var err error
+		if y, err = df.Column(target[0]); err != nil {
+			return fmt.Errorf("target column %q: %w", target[0], core.ErrColumnNotFound)
+		}
+		p.target = target[0]
+		p.modelFitted = false
+	}
+	
 	current := df
 	for i := range p.steps {
 		// Fit the current step
@@ -78,6 +135,13 @@ func (p *Pipeline) Fit(df *dataframe.DataFrame, target ...string) error {
 		current = transformed
 	}
 	
+	if p.model != nil {
+		if err := p.model.Fit(current.Drop(target[0]), y); err != nil {
+			return fmt.Errorf("model %q: %w", p.modelName, err)
+		}
+		p.modelFitted = true
+	}
+	
 	return nil
 }
 
@@ -107,6 +171,69 @@ func (p *Pipeline) Transform(df *dataframe.DataFrame) (*dataframe.DataFrame, err
 	return current, nil
 }
 
+// Predict transforms X through every transformer and returns the
+// predictions of the model that ends the pipeline. Returns an error if the
+// pipeline has no model or hasn't been fitted.
+func (p *Pipeline) Predict(X *dataframe.DataFrame) (*series.Series[any], error) {
+	p.mu.RLock()
+	model, name, fitted := p.model, p.modelName, p.modelFitted
+	p.mu.RUnlock()
+	
+	if model == nil {
+		return nil, fmt.Errorf("pipeline has no model to predict with")
+	}
+	if !fitted {
+		return nil, fmt.Errorf("pipeline not fitted: model %q not fitted", name)
+	}
+	
+	transformed, err := p.Transform(X)
+	if err != nil {
+		return nil, err
+	}
+	predictions, err := model.Predict(transformed)
+	if err != nil {
+		return nil, fmt.Errorf("model %q: %w", name, err)
+	}
+	return predictions, nil
+}
+
+// GetFeatureNames returns the columns the fitted pipeline outputs, found by
+// passing the columns it was fitted on through each step's
+// FeatureNamesOut. If the pipeline has a model, these are the features the
+// model sees, without the target column. Returns an error if the pipeline
+// hasn't been fitted or a step does not implement FeatureNamer.
+func (p *Pipeline) GetFeatureNames() ([]string, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
+	if p.namesIn == nil {
+		return nil, fmt.Errorf("pipeline not fitted")
+	}
+	
+	names := append([]string(nil), p.namesIn...)
+	for i, step := range p.steps {
+		if !step.fitted {
+			return nil, fmt.Errorf("pipeline not fitted: step %q (index %d) not fitted", step.Name, i)
+		}
+		namer, ok := step.Estimator.(FeatureNamer)
+		if !ok {
+			return nil, fmt.Errorf("step %q (index %d) does not report feature names: %w", step.Name, i, core.ErrInvalidArgument)
+		}
+		names = namer.FeatureNamesOut(names)
+	}
+	
+	if p.target != "" {
+		features := names[:0:0]
+		for _, name := range names {
+			if name != p.target {
+				features = append(features, name)
+			}
+		}
+		names = features
+	}
+	return names, nil
+}
+
 // FitTransform fits the pipeline and transforms the data in one operation.
 func (p *Pipeline) FitTransform(df *dataframe.DataFrame, target ...string) (*dataframe.DataFrame, error) {
 	if err := p.Fit(df, target...); err != nil {
@@ -115,7 +242,8 @@ func (p *Pipeline) FitTransform(df *dataframe.DataFrame, target ...string) (*dat
 	return p.Transform(df)
 }
 
-// IsFitted returns true if all steps in the pipeline are fitted.
+// IsFitted returns true if all steps in the pipeline, and its model if it
+// has one, are fitted.
 func (p *Pipeline) IsFitted() bool {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
@@ -125,10 +253,14 @@ func (p *Pipeline) IsFitted() bool {
 			return false
 		}
 	}
+	if p.model != nil {
+		return p.modelFitted
+	}
 	return len(p.steps) > 0
 }
 
-// Len returns the number of steps in the pipeline.
+// Len returns the number of transformer steps in the pipeline, not
+// counting its model.
 func (p *Pipeline) Len() int {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
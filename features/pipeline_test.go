@@ -1,13 +1,18 @@
 package features
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/features/creators"
 	"github.com/TIVerse/GopherData/features/encoders"
 	"github.com/TIVerse/GopherData/features/imputers"
 	"github.com/TIVerse/GopherData/features/scalers"
 	"github.com/TIVerse/GopherData/features/selectors"
+	"github.com/TIVerse/GopherData/models/linear"
+	"github.com/TIVerse/GopherData/series"
 )
 
 func TestPipeline(t *testing.T) {
@@ -345,3 +350,197 @@ func TestPipelineEdgeCases(t *testing.T) {
 		}
 	})
 }
+
+func TestPipelineWithModel(t *testing.T) {
+	train, _ := dataframe.New(map[string]any{
+		"x1": []float64{1, 2, 0, 3, 8, 9, 10, 0},
+		"x2": []float64{1, 1, 2, 2, 8, 9, 8, 9},
+		"y":  []string{"low", "low", "low", "low", "high", "high", "high", "high"},
+	})
+	train = train.Select("x1", "x2", "y")
+	x1, _ := train.Column("x1")
+	x1.SetNull(2)
+	x1.SetNull(7)
+
+	pipeline := NewPipeline().
+		SetModel("logistic", linear.NewLogisticRegression("l2", 1.0, 1000)).
+		Add("imputer", imputers.NewSimpleImputer([]string{"x1", "x2"}, "mean")).
+		Add("scaler", scalers.NewStandardScaler([]string{"x1", "x2"}))
+
+	if _, err := pipeline.Predict(train); err == nil {
+		t.Error("expected an error predicting before Fit")
+	}
+	if err := pipeline.Fit(train); err == nil {
+		t.Error("expected an error fitting a model without a target")
+	}
+	if err := pipeline.Fit(train, "y"); err != nil {
+		t.Fatalf("Fit failed: %v", err)
+	}
+	if !pipeline.IsFitted() {
+		t.Error("pipeline should be fitted")
+	}
+	if names, err := pipeline.GetFeatureNames(); err != nil || !equalStrings(names, []string{"x1", "x2"}) {
+		t.Errorf("GetFeatureNames = %v, %v; want [x1 x2] without the target", names, err)
+	}
+
+	test, _ := dataframe.New(map[string]any{
+		"x1": []float64{1.5, 0, 9.5},
+		"x2": []float64{1.5, 8.5, 8.5},
+	})
+	test = test.Select("x1", "x2")
+	x1Test, _ := test.Column("x1")
+	x1Test.SetNull(1)
+
+	predictions, err := pipeline.Predict(test)
+	if err != nil {
+		t.Fatalf("Predict failed: %v", err)
+	}
+	for i, want := range []string{"low", "high", "high"} {
+		if got, _ := predictions.Get(i); got != want {
+			t.Errorf("prediction %d = %v, want %s", i, got, want)
+		}
+	}
+
+	path := t.TempDir() + "/pipeline.json"
+	if err := pipeline.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	loaded, err := LoadPipeline(path)
+	if err != nil {
+		t.Fatalf("LoadPipeline failed: %v", err)
+	}
+	if _, name := loaded.Model(); name != "logistic" {
+		t.Errorf("loaded model name = %q, want logistic", name)
+	}
+	reloaded, err := loaded.Predict(test)
+	if err != nil {
+		t.Fatalf("Predict after LoadPipeline failed: %v", err)
+	}
+	for i := 0; i < predictions.Len(); i++ {
+		want, _ := predictions.Get(i)
+		if got, _ := reloaded.Get(i); got != want {
+			t.Errorf("loaded prediction %d = %v, want %v", i, got, want)
+		}
+	}
+	if names, err := loaded.GetFeatureNames(); err != nil || !equalStrings(names, []string{"x1", "x2"}) {
+		t.Errorf("loaded GetFeatureNames = %v, %v; want [x1 x2]", names, err)
+	}
+
+	unsaved := NewPipeline().SetModel("stub", stubModel{})
+	if err := unsaved.Save(t.TempDir() + "/stub.json"); err == nil {
+		t.Error("expected an error saving a model that cannot be serialized")
+	}
+}
+
+// stubModel is a Predictor without a JSON encoding.
+type stubModel struct{}
+
+func (stubModel) Fit(*dataframe.DataFrame, *series.Series[any]) error { return nil }
+
+func (stubModel) Predict(X *dataframe.DataFrame) (*series.Series[any], error) {
+	return series.New("y", make([]any, X.Nrows()), core.DtypeString), nil
+}
+
+func TestPipelineGetFeatureNames(t *testing.T) {
+	df, _ := dataframe.New(map[string]any{
+		"x1":    []float64{1, 2, 3, 4},
+		"x2":    []float64{4, 3, 1, 2},
+		"color": []string{"red", "blue", "red", "blue"},
+	})
+	df = df.Select("x1", "x2", "color")
+
+	pipeline := NewPipeline()
+	if _, err := pipeline.GetFeatureNames(); err == nil {
+		t.Error("expected an error before Fit")
+	}
+
+	poly := creators.NewPolynomialFeatures(2)
+	poly.Columns = []string{"x1", "x2"}
+	poly.IncludeBias = false
+
+	stages := []struct {
+		name      string
+		estimator Estimator
+		want      []string
+	}{
+		{"scaler", scalers.NewStandardScaler([]string{"x1", "x2"}), []string{"x1", "x2", "color"}},
+		{"onehot", encoders.NewOneHotEncoder([]string{"color"}), []string{"x1", "x2", "color_red", "color_blue"}},
+		{"poly", poly, []string{"x1", "x2", "color_red", "color_blue", "x1^2", "x2^2", "x1*x2"}},
+	}
+	for _, stage := range stages {
+		pipeline.Add(stage.name, stage.estimator)
+		result, err := pipeline.FitTransform(df)
+		if err != nil {
+			t.Fatalf("after %s: FitTransform failed: %v", stage.name, err)
+		}
+
+		names, err := pipeline.GetFeatureNames()
+		if err != nil {
+			t.Fatalf("after %s: GetFeatureNames failed: %v", stage.name, err)
+		}
+		if !equalStrings(names, stage.want) {
+			t.Errorf("after %s: names = %v, want %v", stage.name, names, stage.want)
+		}
+		if !equalStrings(names, result.Columns()) {
+			t.Errorf("after %s: names = %v, Transform gave %v", stage.name, names, result.Columns())
+		}
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPipelineColumnSelector(t *testing.T) {
+	df, _ := dataframe.New(map[string]any{
+		"x1":    []float64{1, 2, 3, 4},
+		"x2":    []float64{10, 20, 30, 40},
+		"id":    []int64{101, 102, 103, 104},
+		"color": []string{"red", "blue", "red", "blue"},
+	})
+	df = df.Select("x1", "x2", "id", "color")
+
+	scaler := scalers.NewStandardScaler(nil) // all numeric columns it is given
+	pipeline := NewPipeline().
+		Add("select", NewColumnSelector([]string{"x2", "x1"})).
+		Add("scaler", scaler)
+
+	result, err := pipeline.FitTransform(df)
+	if err != nil {
+		t.Fatalf("FitTransform failed: %v", err)
+	}
+	if cols := result.Columns(); !equalStrings(cols, []string{"x2", "x1"}) {
+		t.Errorf("columns = %v, want [x2 x1]", cols)
+	}
+	if means := scaler.GetMeans(); len(means) != 2 || means["x1"] != 2.5 || means["x2"] != 25 {
+		t.Errorf("scaler should be fitted on x1 and x2 only, got means %v", means)
+	}
+	if names, err := pipeline.GetFeatureNames(); err != nil || !equalStrings(names, []string{"x2", "x1"}) {
+		t.Errorf("GetFeatureNames = %v, %v; want [x2 x1]", names, err)
+	}
+
+	if _, err := pipeline.Transform(df.Drop("x1")); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("expected ErrColumnNotFound for a missing column, got %v", err)
+	}
+
+	path := t.TempDir() + "/pipeline.json"
+	if err := pipeline.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	loaded, err := LoadPipeline(path)
+	if err != nil {
+		t.Fatalf("LoadPipeline failed: %v", err)
+	}
+	selector, ok := loaded.GetStep(0).Estimator.(*ColumnSelector)
+	if !ok || !equalStrings(selector.Columns, []string{"x2", "x1"}) {
+		t.Errorf("loaded first step = %#v, want a ColumnSelector of [x2 x1]", loaded.GetStep(0).Estimator)
+	}
+}
@@ -84,7 +84,7 @@ func (m *MaxAbsScaler) Transform(df *dataframe.DataFrame) (*dataframe.DataFrame,
 				continue
 			}
 			
-			floatVal := toFloat64(val)
+			floatVal, _ := core.ToFloat64(val)
 			scaled[i] = floatVal / maxAbs
 		}
 		
@@ -102,6 +102,12 @@ func (m *MaxAbsScaler) FitTransform(df *dataframe.DataFrame, target ...string) (
 	return m.Transform(df)
 }
 
+// FeatureNamesOut returns the input columns unchanged, as the scaler scales
+// columns in place.
+func (m *MaxAbsScaler) FeatureNamesOut(in []string) []string {
+	return append([]string(nil), in...)
+}
+
 // IsFitted returns true if the scaler has been fitted.
 func (m *MaxAbsScaler) IsFitted() bool {
 	return m.fitted
@@ -125,7 +131,8 @@ func computeMaxAbs(series interface{ Len() int; Get(int) (any, bool) }) float64
 			continue
 		}
 		
-		absVal := math.Abs(toFloat64(val))
+		f, _ := core.ToFloat64(val)
+		absVal := math.Abs(f)
 		if absVal > maxAbs {
 			maxAbs = absVal
 		}
@@ -99,7 +99,7 @@ func (m *MinMaxScaler) Transform(df *dataframe.DataFrame) (*dataframe.DataFrame,
 				continue
 			}
 			
-			floatVal := toFloat64(val)
+			floatVal, _ := core.ToFloat64(val)
 			// Scale to [0, 1] then to [FeatureMin, FeatureMax]
 			stdVal := (floatVal - min) / dataRange
 			scaled[i] = stdVal*(m.FeatureMax-m.FeatureMin) + m.FeatureMin
@@ -119,6 +119,12 @@ func (m *MinMaxScaler) FitTransform(df *dataframe.DataFrame, target ...string) (
 	return m.Transform(df)
 }
 
+// FeatureNamesOut returns the input columns unchanged, as the scaler scales
+// columns in place.
+func (m *MinMaxScaler) FeatureNamesOut(in []string) []string {
+	return append([]string(nil), in...)
+}
+
 // IsFitted returns true if the scaler has been fitted.
 func (m *MinMaxScaler) IsFitted() bool {
 	return m.fitted
@@ -153,7 +159,7 @@ func computeMinMax(series interface{ Len() int; Get(int) (any, bool) }) (float64
 			continue
 		}
 		
-		floatVal := toFloat64(val)
+		floatVal, _ := core.ToFloat64(val)
 		if !found {
 			min = floatVal
 			max = floatVal
@@ -22,10 +22,13 @@ type RobustScaler struct {
 	// WithScaling scales the data to IQR. Default: true
 	WithScaling bool
 	
-	// QuantileRange for IQR calculation. Default: [25.0, 75.0]
+	// QuantileRange for IQR calculation, as percentiles in [0, 100].
+	// Default: [25.0, 75.0]. A wider range such as [10.0, 90.0] suits
+	// heavier-tailed data.
 	QuantileRange [2]float64
 	
 	// Fitted statistics
+	columns []string
 	medians map[string]float64
 	iqrs    map[string]float64
 	fitted  bool
@@ -45,7 +48,13 @@ func NewRobustScaler(columns []string) *RobustScaler {
 }
 
 // Fit computes the median and IQR for each column.
+// Nulls and NaNs are skipped, so the statistics describe the observed values only.
 func (r *RobustScaler) Fit(df *dataframe.DataFrame, _ ...string) error {
+	lo, hi := r.QuantileRange[0], r.QuantileRange[1]
+	if lo < 0 || hi > 100 || lo >= hi {
+		return fmt.Errorf("invalid quantile range [%g, %g]: %w", lo, hi, core.ErrInvalidArgument)
+	}
+	
 	cols := r.Columns
 	if cols == nil {
 		cols = getNumericColumns(df)
@@ -55,6 +64,7 @@ func (r *RobustScaler) Fit(df *dataframe.DataFrame, _ ...string) error {
 		return fmt.Errorf("no numeric columns to scale")
 	}
 	
+	r.columns = append([]string(nil), cols...)
 	r.medians = make(map[string]float64)
 	r.iqrs = make(map[string]float64)
 	
@@ -71,8 +81,8 @@ func (r *RobustScaler) Fit(df *dataframe.DataFrame, _ ...string) error {
 		
 		// Compute IQR
 		if r.WithScaling {
-			q1 := computeQuantile(series, r.QuantileRange[0]/100.0)
-			q3 := computeQuantile(series, r.QuantileRange[1]/100.0)
+			q1 := computeQuantile(series, lo/100.0)
+			q3 := computeQuantile(series, hi/100.0)
 			r.iqrs[col] = q3 - q1
 		}
 	}
@@ -82,6 +92,8 @@ func (r *RobustScaler) Fit(df *dataframe.DataFrame, _ ...string) error {
 }
 
 // Transform applies the robust scaling to the data.
+// Columns with a zero IQR (such as constant columns) are centered but not
+// divided, so they never produce Inf.
 func (r *RobustScaler) Transform(df *dataframe.DataFrame) (*dataframe.DataFrame, error) {
 	if !r.fitted {
 		return nil, fmt.Errorf("scaler not fitted")
@@ -89,7 +101,7 @@ func (r *RobustScaler) Transform(df *dataframe.DataFrame) (*dataframe.DataFrame,
 	
 	result := df.Copy()
 	
-	for col := range r.medians {
+	for _, col := range r.columns {
 		colSeries, err := result.Column(col)
 		if err != nil {
 			return nil, fmt.Errorf("column %q: %w", col, err)
@@ -98,21 +110,15 @@ func (r *RobustScaler) Transform(df *dataframe.DataFrame) (*dataframe.DataFrame,
 		median := r.medians[col]
 		iqr := r.iqrs[col]
 		
-		// Skip columns with zero IQR
-		if r.WithScaling && iqr == 0 {
-			continue
-		}
-		
 		// Apply transformation
 		scaled := make([]any, colSeries.Len())
 		for i := 0; i < colSeries.Len(); i++ {
-			val, ok := colSeries.Get(i)
+			floatVal, ok := numericValue(colSeries.Get(i))
 			if !ok {
 				scaled[i] = nil
 				continue
 			}
 			
-			floatVal := toFloat64(val)
 			transformed := floatVal
 			
 			if r.WithCentering {
@@ -139,6 +145,12 @@ func (r *RobustScaler) FitTransform(df *dataframe.DataFrame, target ...string) (
 	return r.Transform(df)
 }
 
+// FeatureNamesOut returns the input columns unchanged, as the scaler scales
+// columns in place.
+func (r *RobustScaler) FeatureNamesOut(in []string) []string {
+	return append([]string(nil), in...)
+}
+
 // IsFitted returns true if the scaler has been fitted.
 func (r *RobustScaler) IsFitted() bool {
 	return r.fitted
@@ -170,11 +182,11 @@ func computeQuantile(series interface{ Len() int; Get(int) (any, bool) }, q floa
 	// Collect non-null values
 	values := make([]float64, 0, series.Len())
 	for i := 0; i < series.Len(); i++ {
-		val, ok := series.Get(i)
+		val, ok := numericValue(series.Get(i))
 		if !ok {
 			continue
 		}
-		values = append(values, toFloat64(val))
+		values = append(values, val)
 	}
 	
 	if len(values) == 0 {
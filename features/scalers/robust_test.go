@@ -0,0 +1,105 @@
+package scalers
+
+import (
+	"math"
+	"testing"
+
+	"github.com/TIVerse/GopherData/dataframe"
+)
+
+func TestRobustScaler(t *testing.T) {
+	t.Run("CustomQuantileRange", func(t *testing.T) {
+		values := make([]float64, 11)
+		for i := range values {
+			values[i] = float64(i * 10) // 0, 10, ..., 100
+		}
+		df, _ := dataframe.New(map[string]any{"col": values})
+
+		scaler := NewRobustScaler([]string{"col"})
+		scaler.QuantileRange = [2]float64{10, 90}
+		scaled, err := scaler.FitTransform(df)
+		if err != nil {
+			t.Fatalf("FitTransform failed: %v", err)
+		}
+
+		if m := scaler.GetMedians()["col"]; !almostEqual(m, 50, 1e-9) {
+			t.Errorf("Expected median 50, got %f", m)
+		}
+		if iqr := scaler.GetIQRs()["col"]; !almostEqual(iqr, 80, 1e-9) {
+			t.Errorf("Expected 10-90 range 80, got %f", iqr)
+		}
+
+		col, _ := scaled.Column("col")
+		if v, _ := col.Get(10); !almostEqual(v.(float64), 50.0/80.0, 1e-9) {
+			t.Errorf("Expected scaled max %f, got %v", 50.0/80.0, v)
+		}
+	})
+
+	t.Run("InvalidQuantileRange", func(t *testing.T) {
+		df, _ := dataframe.New(map[string]any{"col": []float64{1, 2, 3}})
+
+		scaler := NewRobustScaler([]string{"col"})
+		scaler.QuantileRange = [2]float64{75, 25}
+		if err := scaler.Fit(df); err == nil {
+			t.Error("Expected error for reversed quantile range")
+		}
+	})
+
+	t.Run("ConstantColumn", func(t *testing.T) {
+		df, _ := dataframe.New(map[string]any{"col": []float64{4, 4, 4, 4}})
+
+		scaler := NewRobustScaler([]string{"col"})
+		scaled, err := scaler.FitTransform(df)
+		if err != nil {
+			t.Fatalf("FitTransform failed: %v", err)
+		}
+
+		col, _ := scaled.Column("col")
+		for i := 0; i < col.Len(); i++ {
+			v, _ := col.Get(i)
+			f := v.(float64)
+			if math.IsInf(f, 0) || math.IsNaN(f) || f != 0 {
+				t.Errorf("Row %d: expected centered value 0, got %v", i, v)
+			}
+		}
+	})
+
+	t.Run("WithNulls", func(t *testing.T) {
+		df, _ := dataframe.New(map[string]any{"col": []any{1.0, nil, 2.0, math.NaN(), 3.0, 4.0, 5.0}})
+
+		scaler := NewRobustScaler([]string{"col"})
+		scaled, err := scaler.FitTransform(df)
+		if err != nil {
+			t.Fatalf("FitTransform failed: %v", err)
+		}
+
+		// Statistics come from [1, 2, 3, 4, 5] only
+		if m := scaler.GetMedians()["col"]; !almostEqual(m, 3, 1e-9) {
+			t.Errorf("Expected median 3, got %f", m)
+		}
+		if iqr := scaler.GetIQRs()["col"]; !almostEqual(iqr, 2, 1e-9) {
+			t.Errorf("Expected IQR 2, got %f", iqr)
+		}
+
+		col, _ := scaled.Column("col")
+		if v, _ := col.Get(1); v != nil {
+			t.Errorf("Expected null to stay null, got %v", v)
+		}
+	})
+
+	t.Run("WithoutCentering", func(t *testing.T) {
+		df, _ := dataframe.New(map[string]any{"col": []float64{1, 2, 3, 4, 5}})
+
+		scaler := NewRobustScaler([]string{"col"})
+		scaler.WithCentering = false
+		scaled, err := scaler.FitTransform(df)
+		if err != nil {
+			t.Fatalf("FitTransform failed: %v", err)
+		}
+
+		col, _ := scaled.Column("col")
+		if v, _ := col.Get(4); !almostEqual(v.(float64), 2.5, 1e-9) {
+			t.Errorf("Expected 5/IQR = 2.5, got %v", v)
+		}
+	})
+}
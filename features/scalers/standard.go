@@ -18,15 +18,22 @@ type StandardScaler struct {
 	Columns []string
 	
 	// WithMean centers the data before scaling. Default: true
+	// Disable it to scale sparse data without destroying its zeros.
 	WithMean bool
 	
 	// WithStd scales the data to unit variance. Default: true
 	WithStd bool
 	
 	// Fitted statistics
-	means  map[string]float64
-	stds   map[string]float64
-	fitted bool
+	columns []string
+	means   map[string]float64
+	stds    map[string]float64
+	fitted  bool
+	
+	// Running statistics accumulated by PartialFit
+	counts   map[string]int
+	runMeans map[string]float64
+	m2s      map[string]float64
 }
 
 // NewStandardScaler creates a new StandardScaler with default settings.
@@ -42,37 +49,75 @@ func NewStandardScaler(columns []string) *StandardScaler {
 }
 
 // Fit computes the mean and standard deviation for each column.
+// Nulls and NaNs are skipped rather than treated as zeros.
+// Any statistics accumulated by PartialFit are discarded.
 func (s *StandardScaler) Fit(df *dataframe.DataFrame, _ ...string) error {
-	cols := s.Columns
+	s.columns = nil
+	s.fitted = false
+	return s.PartialFit(df)
+}
+
+// PartialFit updates the running mean and variance of each column with one
+// batch of rows, so a scaler can be fitted over data read in chunks (for
+// example with the streaming CSV reader). Batches are merged with Chan's
+// parallel variance update, and the means and standard deviations are
+// refreshed after every call. The columns are fixed by the first call.
+func (s *StandardScaler) PartialFit(df *dataframe.DataFrame) error {
+	cols := s.columns
 	if cols == nil {
-		// Get all numeric columns
-		cols = getNumericColumns(df)
+		cols = s.Columns
+		if cols == nil {
+			// Get all numeric columns
+			cols = getNumericColumns(df)
+		}
+		
+		if len(cols) == 0 {
+			return fmt.Errorf("no numeric columns to scale")
+		}
+	}
+	
+	// Validate every column before updating any statistics
+	batch := make([]*seriesPkg.Series[any], len(cols))
+	for i, col := range cols {
+		series, err := df.Column(col)
+		if err != nil {
+			return fmt.Errorf("column %q: %w", col, err)
+		}
+		batch[i] = series
 	}
 	
-	if len(cols) == 0 {
-		return fmt.Errorf("no numeric columns to scale")
+	if s.columns == nil {
+		s.columns = append([]string(nil), cols...)
+		s.counts = make(map[string]int)
+		s.runMeans = make(map[string]float64)
+		s.m2s = make(map[string]float64)
 	}
 	
 	s.means = make(map[string]float64)
 	s.stds = make(map[string]float64)
 	
-	for _, col := range cols {
-		series, err := df.Column(col)
-		if err != nil {
-			return fmt.Errorf("column %q: %w", col, err)
+	for i, col := range cols {
+		nb, meanB, m2B := computeMoments(batch[i])
+		na, meanA, m2A := s.counts[col], s.runMeans[col], s.m2s[col]
+		
+		n := na + nb
+		if nb > 0 {
+			delta := meanB - meanA
+			s.runMeans[col] = meanA + delta*float64(nb)/float64(n)
+			s.m2s[col] = m2A + m2B + delta*delta*float64(na)*float64(nb)/float64(n)
+			s.counts[col] = n
 		}
 		
-		// Compute mean
-		var mean float64
+		// The standard deviation is always taken around the mean, even
+		// when the data is not centered.
 		if s.WithMean {
-			mean = computeMean(series)
-			s.means[col] = mean
+			s.means[col] = s.runMeans[col]
 		}
-		
-		// Compute standard deviation
-		var std float64
 		if s.WithStd {
-			std = computeStd(series, mean)
+			std := 0.0
+			if n >= 2 {
+				std = math.Sqrt(s.m2s[col] / float64(n-1))
+			}
 			s.stds[col] = std
 		}
 	}
@@ -82,6 +127,8 @@ func (s *StandardScaler) Fit(df *dataframe.DataFrame, _ ...string) error {
 }
 
 // Transform applies the standardization to the data.
+// Zero-variance columns are centered but not divided, so a constant column
+// maps to all zeros (or is left untouched when WithMean is false).
 func (s *StandardScaler) Transform(df *dataframe.DataFrame) (*dataframe.DataFrame, error) {
 	if !s.fitted {
 		return nil, fmt.Errorf("scaler not fitted")
@@ -89,7 +136,7 @@ func (s *StandardScaler) Transform(df *dataframe.DataFrame) (*dataframe.DataFram
 	
 	result := df.Copy()
 	
-	for col := range s.means {
+	for _, col := range s.columns {
 		colSeries, err := result.Column(col)
 		if err != nil {
 			return nil, fmt.Errorf("column %q: %w", col, err)
@@ -98,21 +145,15 @@ func (s *StandardScaler) Transform(df *dataframe.DataFrame) (*dataframe.DataFram
 		mean := s.means[col]
 		std := s.stds[col]
 		
-		// Skip columns with zero variance
-		if s.WithStd && std == 0 {
-			continue
-		}
-		
 		// Apply transformation
 		scaled := make([]any, colSeries.Len())
 		for i := 0; i < colSeries.Len(); i++ {
-			val, ok := colSeries.Get(i)
+			floatVal, ok := numericValue(colSeries.Get(i))
 			if !ok {
 				scaled[i] = nil
 				continue
 			}
 			
-			floatVal := toFloat64(val)
 			transformed := floatVal
 			
 			if s.WithMean {
@@ -139,6 +180,12 @@ func (s *StandardScaler) FitTransform(df *dataframe.DataFrame, target ...string)
 	return s.Transform(df)
 }
 
+// FeatureNamesOut returns the input columns unchanged, as the scaler scales
+// columns in place.
+func (s *StandardScaler) FeatureNamesOut(in []string) []string {
+	return append([]string(nil), in...)
+}
+
 // IsFitted returns true if the scaler has been fitted.
 func (s *StandardScaler) IsFitted() bool {
 	return s.fitted
@@ -153,6 +200,16 @@ func (s *StandardScaler) GetMeans() map[string]float64 {
 	return means
 }
 
+// GetSampleCounts returns the number of non-null values seen in each column
+// across all Fit and PartialFit calls.
+func (s *StandardScaler) GetSampleCounts() map[string]int {
+	counts := make(map[string]int, len(s.counts))
+	for k, v := range s.counts {
+		counts[k] = v
+	}
+	return counts
+}
+
 // GetStds returns the computed standard deviations.
 func (s *StandardScaler) GetStds() map[string]float64 {
 	stds := make(map[string]float64, len(s.stds))
@@ -162,95 +219,78 @@ func (s *StandardScaler) GetStds() map[string]float64 {
 	return stds
 }
 
+// GetColumns returns the columns the scaler was fitted on, in the order
+// Transform scales them.
+func (s *StandardScaler) GetColumns() []string {
+	return append([]string(nil), s.columns...)
+}
+
+// SetStats restores a fitted scaler from the statistics returned by
+// GetColumns, GetMeans and GetStds, for example when loading a saved
+// pipeline. A later PartialFit starts its running statistics afresh.
+func (s *StandardScaler) SetStats(columns []string, means, stds map[string]float64) {
+	s.columns = append([]string(nil), columns...)
+	s.means = make(map[string]float64, len(means))
+	for k, v := range means {
+		s.means[k] = v
+	}
+	s.stds = make(map[string]float64, len(stds))
+	for k, v := range stds {
+		s.stds[k] = v
+	}
+	s.counts = make(map[string]int)
+	s.runMeans = make(map[string]float64)
+	s.m2s = make(map[string]float64)
+	s.fitted = true
+}
+
 // Helper functions
 
-func computeMean(series interface{ Len() int; Get(int) (any, bool) }) float64 {
+// computeMoments returns the number of non-null values, their mean, and the
+// sum of squared deviations from the mean.
+func computeMoments(series interface{ Len() int; Get(int) (any, bool) }) (int, float64, float64) {
 	var sum float64
 	count := 0
 	
 	for i := 0; i < series.Len(); i++ {
-		val, ok := series.Get(i)
-		if !ok || val == nil {
+		val, ok := numericValue(series.Get(i))
+		if !ok {
 			continue
 		}
-		sum += toFloat64(val)
+		sum += val
 		count++
 	}
 	
 	if count == 0 {
-		return 0
+		return 0, 0, 0
 	}
-	return sum / float64(count)
-}
-
-func computeStd(series interface{ Len() int; Get(int) (any, bool) }, mean float64) float64 {
-	var sumSq float64
-	count := 0
+	mean := sum / float64(count)
 	
+	var sumSq float64
 	for i := 0; i < series.Len(); i++ {
-		val, ok := series.Get(i)
-		if !ok || val == nil {
+		val, ok := numericValue(series.Get(i))
+		if !ok {
 			continue
 		}
-		diff := toFloat64(val) - mean
+		diff := val - mean
 		sumSq += diff * diff
-		count++
 	}
 	
-	if count < 2 {
-		return 0
-	}
-	
-	return math.Sqrt(sumSq / float64(count-1))
+	return count, mean, sumSq
 }
 
-func toFloat64(val any) float64 {
-	switch v := val.(type) {
-	case float64:
-		return v
-	case float32:
-		return float64(v)
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	case int32:
-		return float64(v)
-	case int16:
-		return float64(v)
-	case int8:
-		return float64(v)
-	default:
-		return 0
+// numericValue converts the result of a Series Get to float64.
+// It reports false for nulls, nil values, non-numeric values, and NaN.
+func numericValue(val any, ok bool) (float64, bool) {
+	if !ok {
+		return 0, false
 	}
+	f, isNum := core.ToFloat64(val)
+	return f, isNum && !math.IsNaN(f)
 }
 
 func getNumericColumns(df *dataframe.DataFrame) []string {
-	numericCols := make([]string, 0)
-	cols := df.Columns()
-	
-	for _, col := range cols {
-		series, err := df.Column(col)
-		if err != nil {
-			continue
-		}
-		
-		// Check if column is numeric by examining first non-null value
-		for i := 0; i < series.Len(); i++ {
-			val, ok := series.Get(i)
-			if !ok {
-				continue
-			}
-			
-			switch val.(type) {
-			case float64, float32, int, int64, int32, int16, int8:
-				numericCols = append(numericCols, col)
-			}
-			break
-		}
-	}
-	
-	return numericCols
+	return df.SelectDtypes(core.NumericDtypes(), nil).Columns()
 }
 
 func createFloatSeries(name string, data []any) *seriesPkg.Series[any] {
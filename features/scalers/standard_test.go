@@ -151,6 +151,124 @@ func TestStandardScaler(t *testing.T) {
 	})
 }
 
+func TestStandardScalerToggles(t *testing.T) {
+	df, _ := dataframe.New(map[string]any{"col": []float64{0, 0, 2, 4, 4}})
+
+	t.Run("WithMeanFalse", func(t *testing.T) {
+		scaler := NewStandardScaler([]string{"col"})
+		scaler.WithMean = false
+		scaled, err := scaler.FitTransform(df)
+		if err != nil {
+			t.Fatalf("FitTransform failed: %v", err)
+		}
+
+		// Sample std of [0, 0, 2, 4, 4] around its mean 2 is 2
+		col, _ := scaled.Column("col")
+		expected := []float64{0, 0, 1, 2, 2}
+		for i, want := range expected {
+			v, _ := col.Get(i)
+			if !almostEqual(v.(float64), want, 1e-9) {
+				t.Errorf("Row %d: expected %f, got %v", i, want, v)
+			}
+		}
+	})
+
+	t.Run("WithStdFalse", func(t *testing.T) {
+		scaler := NewStandardScaler([]string{"col"})
+		scaler.WithStd = false
+		scaled, err := scaler.FitTransform(df)
+		if err != nil {
+			t.Fatalf("FitTransform failed: %v", err)
+		}
+
+		col, _ := scaled.Column("col")
+		if v, _ := col.Get(4); !almostEqual(v.(float64), 2, 1e-9) {
+			t.Errorf("Expected centered value 2, got %v", v)
+		}
+	})
+
+	t.Run("ConstantColumn", func(t *testing.T) {
+		constant, _ := dataframe.New(map[string]any{"col": []float64{7, 7, 7}})
+
+		scaler := NewStandardScaler([]string{"col"})
+		scaled, err := scaler.FitTransform(constant)
+		if err != nil {
+			t.Fatalf("FitTransform failed: %v", err)
+		}
+
+		col, _ := scaled.Column("col")
+		for i := 0; i < col.Len(); i++ {
+			v, _ := col.Get(i)
+			if f := v.(float64); f != 0 {
+				t.Errorf("Row %d: expected 0, got %v", i, v)
+			}
+		}
+	})
+
+	t.Run("SkipsNaN", func(t *testing.T) {
+		withNaN, _ := dataframe.New(map[string]any{"col": []float64{1, math.NaN(), 3}})
+
+		scaler := NewStandardScaler([]string{"col"})
+		if err := scaler.Fit(withNaN); err != nil {
+			t.Fatalf("Fit failed: %v", err)
+		}
+		if m := scaler.GetMeans()["col"]; !almostEqual(m, 2, 1e-9) {
+			t.Errorf("Expected mean 2, got %f", m)
+		}
+		if sd := scaler.GetStds()["col"]; !almostEqual(sd, math.Sqrt2, 1e-9) {
+			t.Errorf("Expected std %f, got %f", math.Sqrt2, sd)
+		}
+	})
+}
+
+func TestStandardScalerPartialFit(t *testing.T) {
+	n := 1000
+	a := make([]float64, n)
+	b := make([]any, n)
+	for i := 0; i < n; i++ {
+		a[i] = math.Sin(float64(i)) * 100
+		b[i] = float64(i%17) + 1000
+		if i%9 == 0 {
+			b[i] = nil
+		}
+	}
+	df, _ := dataframe.New(map[string]any{"a": a, "b": b})
+
+	full := NewStandardScaler([]string{"a", "b"})
+	if err := full.Fit(df); err != nil {
+		t.Fatalf("Fit failed: %v", err)
+	}
+
+	partial := NewStandardScaler([]string{"a", "b"})
+	for _, bounds := range [][2]int{{0, 1}, {1, 250}, {250, 600}, {600, 600}, {600, n}} {
+		if err := partial.PartialFit(df.SliceRows(bounds[0], bounds[1])); err != nil {
+			t.Fatalf("PartialFit failed: %v", err)
+		}
+	}
+
+	counts := partial.GetSampleCounts()
+	if counts["a"] != n || counts["b"] != n-(n+8)/9 {
+		t.Errorf("Unexpected sample counts %v", counts)
+	}
+
+	for _, col := range []string{"a", "b"} {
+		if got, want := partial.GetMeans()[col], full.GetMeans()[col]; !almostEqual(got, want, 1e-9) {
+			t.Errorf("%s: expected mean %f, got %f", col, want, got)
+		}
+		if got, want := partial.GetStds()[col], full.GetStds()[col]; !almostEqual(got, want, 1e-9) {
+			t.Errorf("%s: expected std %f, got %f", col, want, got)
+		}
+	}
+
+	// Fit starts over instead of accumulating
+	if err := partial.Fit(df.SliceRows(0, 10)); err != nil {
+		t.Fatalf("Fit failed: %v", err)
+	}
+	if c := partial.GetSampleCounts()["a"]; c != 10 {
+		t.Errorf("Expected Fit to reset the sample count to 10, got %d", c)
+	}
+}
+
 func TestStandardScalerFitTransform(t *testing.T) {
 	data := map[string]any{
 		"col": []float64{1.0, 2.0, 3.0, 4.0, 5.0},
@@ -0,0 +1,56 @@
+package features
+
+import (
+	"fmt"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/features/internal/featureutil"
+)
+
+// ColumnSelector keeps only the named columns, in the order given. It learns
+// nothing, so it is typically the first step of a Pipeline, narrowing the
+// input to the columns the later steps should see.
+type ColumnSelector struct {
+	// Columns to keep
+	Columns []string
+}
+
+// NewColumnSelector creates a new ColumnSelector keeping cols.
+func NewColumnSelector(cols []string) *ColumnSelector {
+	return &ColumnSelector{
+		Columns: append([]string(nil), cols...),
+	}
+}
+
+// Fit does nothing; a ColumnSelector has no parameters to learn.
+func (c *ColumnSelector) Fit(_ *dataframe.DataFrame, _ ...string) error {
+	return nil
+}
+
+// Transform returns a DataFrame with only the selected columns.
+// Returns an error wrapping core.ErrColumnNotFound if df lacks any of them.
+func (c *ColumnSelector) Transform(df *dataframe.DataFrame) (*dataframe.DataFrame, error) {
+	for _, col := range c.Columns {
+		if !df.HasColumn(col) {
+			return nil, fmt.Errorf("column %q: %w", col, core.ErrColumnNotFound)
+		}
+	}
+	return df.Select(c.Columns...), nil
+}
+
+// FitTransform fits the selector and transforms the data in one step.
+func (c *ColumnSelector) FitTransform(df *dataframe.DataFrame, target ...string) (*dataframe.DataFrame, error) {
+	return BaseFitTransform(c, df, target...)
+}
+
+// FeatureNamesOut returns the selected columns found in in, in the order
+// Transform selects them.
+func (c *ColumnSelector) FeatureNamesOut(in []string) []string {
+	return featureutil.PresentColumns(c.Columns, in)
+}
+
+// IsFitted always returns true, as a ColumnSelector needs no fitting.
+func (c *ColumnSelector) IsFitted() bool {
+	return true
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/features/internal/featureutil"
 )
 
 // SelectKBest selects the K best features based on a scoring function.
@@ -81,6 +83,12 @@ func (s *SelectKBest) FitTransform(df *dataframe.DataFrame, target ...string) (*
 	return s.Transform(df)
 }
 
+// FeatureNamesOut returns the selected features found in in, in the order
+// Transform selects them.
+func (s *SelectKBest) FeatureNamesOut(in []string) []string {
+	return featureutil.PresentColumns(s.selected, in)
+}
+
 // IsFitted returns true if the selector has been fitted.
 func (s *SelectKBest) IsFitted() bool {
 	return s.fitted
@@ -119,8 +127,8 @@ func computeCorrelation(x, y interface{ Len() int; Get(int) (any, bool) }) float
 			continue
 		}
 		
-		xFloat := toFloat64Selector(xVal)
-		yFloat := toFloat64Selector(yVal)
+		xFloat, _ := core.ToFloat64(xVal)
+		yFloat, _ := core.ToFloat64(yVal)
 		
 		sumX += xFloat
 		sumY += yFloat
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/features/internal/featureutil"
 )
 
 // SelectPercentile selects features based on a percentile of the highest scores.
@@ -68,6 +69,12 @@ func (s *SelectPercentile) FitTransform(df *dataframe.DataFrame, target ...strin
 	return s.Transform(df)
 }
 
+// FeatureNamesOut returns the selected features found in in, in the order
+// Transform selects them.
+func (s *SelectPercentile) FeatureNamesOut(in []string) []string {
+	return featureutil.PresentColumns(s.selected, in)
+}
+
 // IsFitted returns true if the selector has been fitted.
 func (s *SelectPercentile) IsFitted() bool {
 	return s.fitted
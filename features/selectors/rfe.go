@@ -5,7 +5,9 @@ import (
 	"math"
 	"sort"
 
+	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/features/internal/featureutil"
 )
 
 // RFE (Recursive Feature Elimination) selects features by recursively removing the least important features.
@@ -169,8 +171,10 @@ func computeCorrelationRFE(df *dataframe.DataFrame, col1, col2 string) float64 {
 		v1, ok1 := series1.Get(i)
 		v2, ok2 := series2.Get(i)
 		if ok1 && ok2 {
-			x = append(x, toFloat64RFE(v1))
-			y = append(y, toFloat64RFE(v2))
+			f1, _ := core.ToFloat64(v1)
+			f2, _ := core.ToFloat64(v2)
+			x = append(x, f1)
+			y = append(y, f2)
 		}
 	}
 	
@@ -204,23 +208,6 @@ func computeCorrelationRFE(df *dataframe.DataFrame, col1, col2 string) float64 {
 	return numerator / math.Sqrt(denomX*denomY)
 }
 
-func toFloat64RFE(val any) float64 {
-	switch v := val.(type) {
-	case float64:
-		return v
-	case float32:
-		return float64(v)
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	case int32:
-		return float64(v)
-	default:
-		return 0
-	}
-}
-
 // Transform returns a DataFrame with selected features.
 func (r *RFE) Transform(df *dataframe.DataFrame) (*dataframe.DataFrame, error) {
 	if !r.fitted {
@@ -238,6 +225,12 @@ func (r *RFE) FitTransform(df *dataframe.DataFrame, target ...string) (*datafram
 	return r.Transform(df)
 }
 
+// FeatureNamesOut returns the selected features found in in, in the order
+// Transform selects them.
+func (r *RFE) FeatureNamesOut(in []string) []string {
+	return featureutil.PresentColumns(r.selected, in)
+}
+
 // IsFitted returns true if the selector has been fitted.
 func (r *RFE) IsFitted() bool {
 	return r.fitted
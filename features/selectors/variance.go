@@ -4,7 +4,9 @@ package selectors
 import (
 	"fmt"
 
+	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/features/internal/featureutil"
 )
 
 // VarianceThreshold removes features with variance below a threshold.
@@ -69,6 +71,12 @@ func (v *VarianceThreshold) FitTransform(df *dataframe.DataFrame, target ...stri
 	return v.Transform(df)
 }
 
+// FeatureNamesOut returns the selected features found in in, in the order
+// Transform selects them.
+func (v *VarianceThreshold) FeatureNamesOut(in []string) []string {
+	return featureutil.PresentColumns(v.selected, in)
+}
+
 // IsFitted returns true if the selector has been fitted.
 func (v *VarianceThreshold) IsFitted() bool {
 	return v.fitted
@@ -84,39 +92,7 @@ func (v *VarianceThreshold) GetSelectedFeatures() []string {
 // Helper functions
 
 func getNumericColumns(df *dataframe.DataFrame) []string {
-	numericCols := make([]string, 0)
-	cols := df.Columns()
-	
-	for _, col := range cols {
-		series, err := df.Column(col)
-		if err != nil {
-			continue
-		}
-		
-		// Check if column is numeric
-		if isNumericSeries(series) {
-			numericCols = append(numericCols, col)
-		}
-	}
-	
-	return numericCols
-}
-
-func isNumericSeries(series interface{ Len() int; Get(int) (any, bool) }) bool {
-	for i := 0; i < series.Len() && i < 10; i++ {
-		val, ok := series.Get(i)
-		if !ok {
-			continue
-		}
-		
-		switch val.(type) {
-		case float64, float32, int, int64, int32, int16, int8:
-			return true
-		default:
-			return false
-		}
-	}
-	return false
+	return df.SelectDtypes(core.NumericDtypes(), nil).Columns()
 }
 
 func computeVariance(series interface{ Len() int; Get(int) (any, bool) }) float64 {
@@ -129,7 +105,8 @@ func computeVariance(series interface{ Len() int; Get(int) (any, bool) }) float6
 		if !ok {
 			continue
 		}
-		sum += toFloat64Selector(val)
+		f, _ := core.ToFloat64(val)
+		sum += f
 		count++
 	}
 	
@@ -146,26 +123,11 @@ func computeVariance(series interface{ Len() int; Get(int) (any, bool) }) float6
 		if !ok {
 			continue
 		}
-		diff := toFloat64Selector(val) - mean
+		f, _ := core.ToFloat64(val)
+		diff := f - mean
 		sumSq += diff * diff
 	}
 	
 	return sumSq / float64(count-1)
 }
 
-func toFloat64Selector(val any) float64 {
-	switch v := val.(type) {
-	case float64:
-		return v
-	case float32:
-		return float64(v)
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	case int32:
-		return float64(v)
-	default:
-		return 0
-	}
-}
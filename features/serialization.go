@@ -11,6 +11,7 @@ import (
 	"github.com/TIVerse/GopherData/features/imputers"
 	"github.com/TIVerse/GopherData/features/scalers"
 	"github.com/TIVerse/GopherData/features/selectors"
+	"github.com/TIVerse/GopherData/models/linear"
 )
 
 // PipelineMetadata contains metadata about a saved pipeline.
@@ -29,13 +30,27 @@ type SerializedStep struct {
 	State  map[string]any `json:"state,omitempty"`
 }
 
+// SerializedModel represents the model that ends a pipeline in JSON
+// format. Model holds the model's own JSON encoding.
+type SerializedModel struct {
+	Name   string          `json:"name"`
+	Type   string          `json:"type"`
+	Fitted bool            `json:"fitted"`
+	Model  json.RawMessage `json:"model"`
+}
+
 // SerializedPipeline represents a complete pipeline in JSON format.
 type SerializedPipeline struct {
 	Metadata PipelineMetadata `json:"metadata"`
 	Steps    []SerializedStep `json:"steps"`
+	Model    *SerializedModel `json:"model,omitempty"`
+	NamesIn  []string         `json:"names_in,omitempty"`
+	Target   string           `json:"target,omitempty"`
 }
 
-// Save saves the pipeline to a JSON file.
+// Save saves the pipeline to a JSON file. A model ending the pipeline is
+// saved with it, and must implement json.Marshaler, as the linear models
+// do.
 func (p *Pipeline) Save(path string) error {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
@@ -59,6 +74,16 @@ func (p *Pipeline) Save(path string) error {
 		serialized.Steps[i] = serializedStep
 	}
 	
+	if p.model != nil {
+		model, err := serializeModel(p.model, p.modelName, p.modelFitted)
+		if err != nil {
+			return fmt.Errorf("model %q: %w", p.modelName, err)
+		}
+		serialized.Model = model
+		serialized.NamesIn = p.namesIn
+		serialized.Target = p.target
+	}
+	
 	// Write to file
 	file, err := os.Create(path)
 	if err != nil {
@@ -110,6 +135,17 @@ func LoadPipeline(path string) (*Pipeline, error) {
 		}
 	}
 	
+	if serialized.Model != nil {
+		model, err := deserializeModel(serialized.Model)
+		if err != nil {
+			return nil, fmt.Errorf("model %q: %w", serialized.Model.Name, err)
+		}
+		pipeline.SetModel(serialized.Model.Name, model)
+		pipeline.modelFitted = serialized.Model.Fitted
+		pipeline.namesIn = serialized.NamesIn
+		pipeline.target = serialized.Target
+	}
+	
 	return pipeline, nil
 }
 
@@ -125,12 +161,30 @@ func serializeStep(step PipelineStep) (SerializedStep, error) {
 	
 	// Extract parameters based on estimator type
 	switch est := step.Estimator.(type) {
+	case *ColumnSelector:
+		serialized.Params["columns"] = est.Columns
+		
 	case *scalers.StandardScaler:
-		serialized.Params["with_mean"] = true
-		serialized.Params["with_std"] = true
+		serialized.Params["columns"] = est.Columns
+		serialized.Params["with_mean"] = est.WithMean
+		serialized.Params["with_std"] = est.WithStd
+		if est.IsFitted() {
+			serialized.State["columns"] = est.GetColumns()
+			serialized.State["means"] = est.GetMeans()
+			serialized.State["stds"] = est.GetStds()
+		}
+		
+	case *scalers.RobustScaler:
+		serialized.Params["with_centering"] = est.WithCentering
+		serialized.Params["with_scaling"] = est.WithScaling
+		serialized.Params["quantile_range"] = []float64{est.QuantileRange[0], est.QuantileRange[1]}
 		
 	case *imputers.SimpleImputer:
-		serialized.Params["strategy"] = "mean" // Would extract actual strategy
+		serialized.Params["columns"] = est.Columns
+		serialized.Params["strategy"] = est.Strategy
+		if est.IsFitted() {
+			serialized.State["stats"] = est.GetStats()
+		}
 		
 	case *imputers.KNNImputer:
 		serialized.Params["n_neighbors"] = est.NNeighbors
@@ -151,14 +205,23 @@ func serializeStep(step PipelineStep) (SerializedStep, error) {
 // deserializeStep reconstructs an estimator from serialized data.
 func deserializeStep(step SerializedStep) (Estimator, error) {
 	switch step.Type {
+	case "*features.ColumnSelector":
+		return NewColumnSelector(stateStrings(step.Params["columns"])), nil
+	
 	case "*scalers.StandardScaler":
-		scaler := scalers.NewStandardScaler(nil)
+		scaler := scalers.NewStandardScaler(stateStrings(step.Params["columns"]))
+		if v, ok := step.Params["with_mean"].(bool); ok {
+			scaler.WithMean = v
+		}
+		if v, ok := step.Params["with_std"].(bool); ok {
+			scaler.WithStd = v
+		}
 		if state, ok := step.State["fitted"].(bool); ok && state {
-			// Reconstruct state if saved
-			if means, ok := step.State["means"].(map[string]interface{}); ok {
-				// Would restore means and stds here
-				_ = means
-			}
+			scaler.SetStats(
+				stateStrings(step.State["columns"]),
+				stateFloats(step.State["means"]),
+				stateFloats(step.State["stds"]),
+			)
 		}
 		return scaler, nil
 	
@@ -168,6 +231,19 @@ func deserializeStep(step SerializedStep) (Estimator, error) {
 	
 	case "*scalers.RobustScaler":
 		scaler := scalers.NewRobustScaler(nil)
+		if v, ok := step.Params["with_centering"].(bool); ok {
+			scaler.WithCentering = v
+		}
+		if v, ok := step.Params["with_scaling"].(bool); ok {
+			scaler.WithScaling = v
+		}
+		if r, ok := step.Params["quantile_range"].([]any); ok && len(r) == 2 {
+			lo, loOK := r[0].(float64)
+			hi, hiOK := r[1].(float64)
+			if loOK && hiOK {
+				scaler.QuantileRange = [2]float64{lo, hi}
+			}
+		}
 		return scaler, nil
 	
 	case "*encoders.OneHotEncoder":
@@ -187,7 +263,11 @@ func deserializeStep(step SerializedStep) (Estimator, error) {
 		if s, ok := step.Params["strategy"].(string); ok {
 			strategy = s
 		}
-		imputer := imputers.NewSimpleImputer(nil, strategy)
+		imputer := imputers.NewSimpleImputer(stateStrings(step.Params["columns"]), strategy)
+		if state, ok := step.State["fitted"].(bool); ok && state {
+			stats, _ := step.State["stats"].(map[string]any)
+			imputer.SetStats(stats)
+		}
 		return imputer, nil
 	
 	case "*imputers.KNNImputer":
@@ -211,6 +291,78 @@ func deserializeStep(step SerializedStep) (Estimator, error) {
 	}
 }
 
+// serializeModel encodes the model that ends a pipeline.
+func serializeModel(model Predictor, name string, fitted bool) (*SerializedModel, error) {
+	marshaler, ok := model.(json.Marshaler)
+	if !ok {
+		return nil, fmt.Errorf("model type %T cannot be serialized", model)
+	}
+	data, err := marshaler.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	
+	return &SerializedModel{
+		Name:   name,
+		Type:   reflect.TypeOf(model).String(),
+		Fitted: fitted,
+		Model:  data,
+	}, nil
+}
+
+// deserializeModel reconstructs the model that ends a pipeline.
+func deserializeModel(sm *SerializedModel) (Predictor, error) {
+	var model interface {
+		Predictor
+		json.Unmarshaler
+	}
+	switch sm.Type {
+	case "*linear.LinearRegression":
+		model = &linear.LinearRegression{}
+	case "*linear.Ridge":
+		model = &linear.Ridge{}
+	case "*linear.Lasso":
+		model = &linear.Lasso{}
+	case "*linear.LogisticRegression":
+		model = &linear.LogisticRegression{}
+	default:
+		return nil, fmt.Errorf("unknown model type: %s", sm.Type)
+	}
+	
+	if err := model.UnmarshalJSON(sm.Model); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// stateStrings converts a decoded JSON array to strings; it is nil for a
+// missing or null value.
+func stateStrings(v any) []string {
+	list, ok := v.([]any)
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(list))
+	for _, item := range list {
+		if str, ok := item.(string); ok {
+			out = append(out, str)
+		}
+	}
+	return out
+}
+
+// stateFloats converts a decoded JSON object to a map of floats.
+func stateFloats(v any) map[string]float64 {
+	raw, _ := v.(map[string]any)
+	out := make(map[string]float64, len(raw))
+	for k, item := range raw {
+		if f, ok := item.(float64); ok {
+			out[k] = f
+		}
+	}
+	return out
+}
+
 // getEstimatorType returns the type name of an estimator using reflection.
 func getEstimatorType(est Estimator) string {
 	return reflect.TypeOf(est).String()
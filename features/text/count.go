@@ -0,0 +1,251 @@
+// Package text provides transformers that turn free-text columns into numeric features.
+package text
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+	seriesPkg "github.com/TIVerse/GopherData/series"
+)
+
+// CountVectorizer converts a text column into token-count columns.
+// Each document is split on white space, and every token in the fitted
+// vocabulary becomes an integer column "<column>_<token>" holding the number
+// of times the token occurs in the row. Tokens not in the vocabulary are
+// ignored at transform time.
+type CountVectorizer struct {
+	// Column holds the text to vectorize
+	Column string
+
+	// Lowercase converts text to lower case before tokenizing. Default: true
+	Lowercase bool
+
+	// MinDF drops tokens that appear in fewer than this many documents.
+	// Default: 1
+	MinDF int
+
+	// MaxDF drops tokens that appear in more than this fraction of documents,
+	// such as stop words. Default: 1.0
+	MaxDF float64
+
+	// MaxFeatures keeps only the most frequent tokens across the corpus.
+	// Ties are broken alphabetically. Default: 0 (no limit)
+	MaxFeatures int
+
+	// Fitted vocabulary in alphabetical order
+	vocabulary []string
+	fitted     bool
+}
+
+// CountVectorizerOption configures a CountVectorizer.
+type CountVectorizerOption func(*CountVectorizer)
+
+// WithLowercase sets whether text is lowercased before tokenizing.
+func WithLowercase(lowercase bool) CountVectorizerOption {
+	return func(c *CountVectorizer) {
+		c.Lowercase = lowercase
+	}
+}
+
+// WithMinDF sets the minimum number of documents a token must appear in.
+func WithMinDF(n int) CountVectorizerOption {
+	return func(c *CountVectorizer) {
+		c.MinDF = n
+	}
+}
+
+// WithMaxDF sets the maximum fraction of documents a token may appear in.
+func WithMaxDF(fraction float64) CountVectorizerOption {
+	return func(c *CountVectorizer) {
+		c.MaxDF = fraction
+	}
+}
+
+// WithMaxFeatures limits the vocabulary to the n most frequent tokens.
+func WithMaxFeatures(n int) CountVectorizerOption {
+	return func(c *CountVectorizer) {
+		c.MaxFeatures = n
+	}
+}
+
+// NewCountVectorizer creates a new CountVectorizer for the given text column.
+func NewCountVectorizer(column string, opts ...CountVectorizerOption) *CountVectorizer {
+	c := &CountVectorizer{
+		Column:    column,
+		Lowercase: true,
+		MinDF:     1,
+		MaxDF:     1.0,
+		fitted:    false,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Fit builds the vocabulary from the text column.
+func (c *CountVectorizer) Fit(df *dataframe.DataFrame, _ ...string) error {
+	if c.MinDF < 1 {
+		return fmt.Errorf("MinDF must be at least 1: %w", core.ErrInvalidArgument)
+	}
+	if c.MaxDF <= 0 || c.MaxDF > 1 {
+		return fmt.Errorf("MaxDF must be in (0, 1]: %w", core.ErrInvalidArgument)
+	}
+	if c.MaxFeatures < 0 {
+		return fmt.Errorf("MaxFeatures must be non-negative: %w", core.ErrInvalidArgument)
+	}
+
+	docs, err := c.documents(df)
+	if err != nil {
+		return err
+	}
+
+	// Count documents containing each token and total occurrences
+	docFreq := make(map[string]int)
+	termFreq := make(map[string]int)
+	for _, tokens := range docs {
+		seen := make(map[string]bool)
+		for _, token := range tokens {
+			termFreq[token]++
+			if !seen[token] {
+				seen[token] = true
+				docFreq[token]++
+			}
+		}
+	}
+
+	maxDocs := c.MaxDF * float64(len(docs))
+	vocabulary := make([]string, 0, len(docFreq))
+	for token, n := range docFreq {
+		if n >= c.MinDF && float64(n) <= maxDocs {
+			vocabulary = append(vocabulary, token)
+		}
+	}
+	sort.Strings(vocabulary)
+
+	if c.MaxFeatures > 0 && len(vocabulary) > c.MaxFeatures {
+		// The stable sort keeps alphabetical order among equal counts
+		sort.SliceStable(vocabulary, func(i, j int) bool {
+			return termFreq[vocabulary[i]] > termFreq[vocabulary[j]]
+		})
+		vocabulary = vocabulary[:c.MaxFeatures]
+		sort.Strings(vocabulary)
+	}
+
+	c.vocabulary = vocabulary
+	c.fitted = true
+	return nil
+}
+
+// Transform replaces the text column with one count column per vocabulary token.
+// Null rows produce zero counts.
+func (c *CountVectorizer) Transform(df *dataframe.DataFrame) (*dataframe.DataFrame, error) {
+	if !c.fitted {
+		return nil, fmt.Errorf("vectorizer not fitted")
+	}
+
+	docs, err := c.documents(df)
+	if err != nil {
+		return nil, err
+	}
+
+	position := make(map[string]int, len(c.vocabulary))
+	for i, token := range c.vocabulary {
+		position[token] = i
+	}
+
+	counts := make([][]int64, len(c.vocabulary))
+	for i := range counts {
+		counts[i] = make([]int64, len(docs))
+	}
+	for row, tokens := range docs {
+		for _, token := range tokens {
+			if i, ok := position[token]; ok {
+				counts[i][row]++
+			}
+		}
+	}
+
+	result := df.Drop(c.Column)
+	for i, token := range c.vocabulary {
+		name := c.Column + "_" + token
+		if result.HasColumn(name) {
+			return nil, fmt.Errorf("column %q: %w", name, core.ErrDuplicateColumn)
+		}
+		result = result.WithColumn(name, seriesPkg.New(name, toAny(counts[i]), core.DtypeInt64))
+	}
+
+	return result, nil
+}
+
+// FitTransform fits the vectorizer and transforms the data in one step.
+func (c *CountVectorizer) FitTransform(df *dataframe.DataFrame, target ...string) (*dataframe.DataFrame, error) {
+	if err := c.Fit(df, target...); err != nil {
+		return nil, err
+	}
+	return c.Transform(df)
+}
+
+// FeatureNamesOut returns in without the text column, followed by a
+// "<column>_<token>" column for each vocabulary token.
+func (c *CountVectorizer) FeatureNamesOut(in []string) []string {
+	out := make([]string, 0, len(in)+len(c.vocabulary))
+	for _, col := range in {
+		if col != c.Column {
+			out = append(out, col)
+		}
+	}
+	for _, token := range c.vocabulary {
+		out = append(out, c.Column+"_"+token)
+	}
+	return out
+}
+
+// IsFitted returns true if the vectorizer has been fitted.
+func (c *CountVectorizer) IsFitted() bool {
+	return c.fitted
+}
+
+// Vocabulary returns the fitted tokens in column order.
+func (c *CountVectorizer) Vocabulary() []string {
+	vocabulary := make([]string, len(c.vocabulary))
+	copy(vocabulary, c.vocabulary)
+	return vocabulary
+}
+
+// documents tokenizes every row of the text column. Null rows have no tokens.
+func (c *CountVectorizer) documents(df *dataframe.DataFrame) ([][]string, error) {
+	colSeries, err := df.Column(c.Column)
+	if err != nil {
+		return nil, fmt.Errorf("column %q: %w", c.Column, err)
+	}
+
+	docs := make([][]string, colSeries.Len())
+	for i := range docs {
+		val, ok := colSeries.Get(i)
+		if !ok || val == nil {
+			continue
+		}
+		docs[i] = c.tokenize(fmt.Sprint(val))
+	}
+	return docs, nil
+}
+
+// tokenize splits text on white space, lowercasing it first if configured.
+func (c *CountVectorizer) tokenize(text string) []string {
+	if c.Lowercase {
+		text = strings.ToLower(text)
+	}
+	return strings.Fields(text)
+}
+
+func toAny(values []int64) []any {
+	result := make([]any, len(values))
+	for i, v := range values {
+		result[i] = v
+	}
+	return result
+}
@@ -0,0 +1,119 @@
+package text
+
+import (
+	"testing"
+
+	"github.com/TIVerse/GopherData/dataframe"
+)
+
+func TestCountVectorizer(t *testing.T) {
+	docs := []any{
+		"The cat sat on the mat",
+		"the dog sat",
+		"A cat and a dog",
+		nil,
+	}
+	df, _ := dataframe.New(map[string]any{"text": docs, "id": []int64{1, 2, 3, 4}})
+
+	t.Run("Counts", func(t *testing.T) {
+		vec := NewCountVectorizer("text")
+		result, err := vec.FitTransform(df)
+		if err != nil {
+			t.Fatalf("FitTransform failed: %v", err)
+		}
+
+		vocab := vec.Vocabulary()
+		expectedVocab := []string{"a", "and", "cat", "dog", "mat", "on", "sat", "the"}
+		if len(vocab) != len(expectedVocab) {
+			t.Fatalf("Expected vocabulary %v, got %v", expectedVocab, vocab)
+		}
+		for i := range expectedVocab {
+			if vocab[i] != expectedVocab[i] {
+				t.Errorf("Vocabulary %d: expected %s, got %s", i, expectedVocab[i], vocab[i])
+			}
+		}
+
+		if result.HasColumn("text") {
+			t.Error("Expected text column to be replaced")
+		}
+		if result.Ncols() != len(expectedVocab)+1 {
+			t.Errorf("Expected %d columns, got %d", len(expectedVocab)+1, result.Ncols())
+		}
+
+		expected := map[string][]int64{
+			"text_the": {2, 1, 0, 0},
+			"text_cat": {1, 0, 1, 0},
+			"text_a":   {0, 0, 2, 0},
+			"text_sat": {1, 1, 0, 0},
+		}
+		for col, want := range expected {
+			s, err := result.Column(col)
+			if err != nil {
+				t.Fatalf("Missing column %s: %v", col, err)
+			}
+			for i, w := range want {
+				if v, _ := s.Get(i); v != w {
+					t.Errorf("%s row %d: expected %d, got %v", col, i, w, v)
+				}
+			}
+		}
+	})
+
+	t.Run("UnknownTokensIgnored", func(t *testing.T) {
+		vec := NewCountVectorizer("text")
+		if err := vec.Fit(df); err != nil {
+			t.Fatalf("Fit failed: %v", err)
+		}
+
+		other, _ := dataframe.New(map[string]any{"text": []string{"a bird and a cat"}})
+		result, err := vec.Transform(other)
+		if err != nil {
+			t.Fatalf("Transform failed: %v", err)
+		}
+		if result.HasColumn("text_bird") {
+			t.Error("Unknown token should not create a column")
+		}
+		s, _ := result.Column("text_a")
+		if v, _ := s.Get(0); v != int64(2) {
+			t.Errorf("Expected count 2 for 'a', got %v", v)
+		}
+	})
+
+	t.Run("VocabularyLimits", func(t *testing.T) {
+		vec := NewCountVectorizer("text", WithMaxFeatures(3))
+		if err := vec.Fit(df); err != nil {
+			t.Fatalf("Fit failed: %v", err)
+		}
+		// the (3), a (2), cat (2), dog (2), sat (2): ties broken alphabetically
+		vocab := vec.Vocabulary()
+		if len(vocab) != 3 || vocab[0] != "a" || vocab[1] != "cat" || vocab[2] != "the" {
+			t.Errorf("Expected [a cat the], got %v", vocab)
+		}
+
+		vec = NewCountVectorizer("text", WithMinDF(2))
+		if err := vec.Fit(df); err != nil {
+			t.Fatalf("Fit failed: %v", err)
+		}
+		if vocab := vec.Vocabulary(); len(vocab) != 4 {
+			t.Errorf("Expected 4 tokens in at least 2 documents, got %v", vocab)
+		}
+
+		// "the" appears in 2 of 4 documents
+		vec = NewCountVectorizer("text", WithMaxDF(0.4))
+		if err := vec.Fit(df); err != nil {
+			t.Fatalf("Fit failed: %v", err)
+		}
+		for _, token := range vec.Vocabulary() {
+			if token == "the" || token == "cat" {
+				t.Errorf("Expected %q to exceed MaxDF", token)
+			}
+		}
+	})
+
+	t.Run("NotFitted", func(t *testing.T) {
+		vec := NewCountVectorizer("text")
+		if _, err := vec.Transform(df); err == nil {
+			t.Error("Transform should fail when not fitted")
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package parallel
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	// limit is the process-wide parallelism; 0 means runtime.NumCPU().
+	limit atomic.Int64
+
+	// helpers counts the goroutines started by Run and handed out by
+	// Acquire that are still running, across every caller.
+	helpers atomic.Int64
+)
+
+// SetLimit sets how many goroutines parallel operations may keep busy in
+// total, including the goroutines that call them. n <= 0 restores the
+// default of runtime.NumCPU(); 1 makes every operation serial.
+func SetLimit(n int) {
+	if n < 0 {
+		n = 0
+	}
+	limit.Store(int64(n))
+}
+
+// Limit returns the parallelism set by SetLimit.
+func Limit() int {
+	if n := int(limit.Load()); n > 0 {
+		return n
+	}
+	return runtime.NumCPU()
+}
+
+// Workers returns how many workers an operation asking for requested
+// should plan for: requested capped at Limit, or Limit if requested <= 0.
+func Workers(requested int) int {
+	n := Limit()
+	if requested > 0 && requested < n {
+		return requested
+	}
+	return n
+}
+
+// Acquire reserves up to n helper goroutines from the shared budget of
+// Limit()-1 (the calling goroutine is the remaining one) and returns how
+// many it got, possibly 0. The caller must Release them when they finish.
+func Acquire(n int) int {
+	got := 0
+	for got < n {
+		cur := helpers.Load()
+		if cur >= int64(Limit()-1) {
+			break
+		}
+		if helpers.CompareAndSwap(cur, cur+1) {
+			got++
+		}
+	}
+	return got
+}
+
+// Release returns n helpers reserved by Acquire to the shared budget.
+func Release(n int) {
+	helpers.Add(int64(-n))
+}
+
+// Run calls fn for every task in [0, tasks), on the calling goroutine and
+// on up to workers-1 helper goroutines, and returns when all calls have
+// returned. Helpers come from the budget shared by all parallel operations,
+// so a Run nested inside another's tasks, or one started while others are
+// busy, gets only the helpers left over and otherwise runs on its caller.
+// Tasks are handed out in order but may finish in any order.
+func Run(tasks, workers int, fn func(task int)) {
+	if tasks <= 0 {
+		return
+	}
+
+	extra := Acquire(min(Workers(workers), tasks) - 1)
+	if extra == 0 {
+		for task := 0; task < tasks; task++ {
+			fn(task)
+		}
+		return
+	}
+
+	var next atomic.Int64
+	work := func() {
+		for {
+			task := int(next.Add(1)) - 1
+			if task >= tasks {
+				return
+			}
+			fn(task)
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(extra)
+	for i := 0; i < extra; i++ {
+		go func() {
+			defer wg.Done()
+			defer Release(1)
+			work()
+		}()
+	}
+	work()
+	wg.Wait()
+}
@@ -0,0 +1,75 @@
+package parallel
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+func TestRunLimitOneIsSerial(t *testing.T) {
+	SetLimit(1)
+	defer SetLimit(0)
+
+	var active, peak atomic.Int64
+	order := make([]int, 0, 100)
+	Run(100, 8, func(task int) {
+		if n := active.Add(1); n > peak.Load() {
+			peak.Store(n)
+		}
+		order = append(order, task)
+		active.Add(-1)
+	})
+
+	if peak.Load() != 1 {
+		t.Errorf("peak concurrency = %d, want 1", peak.Load())
+	}
+	for i, task := range order {
+		if task != i {
+			t.Fatalf("task %d ran at position %d, want in order", task, i)
+		}
+	}
+}
+
+func TestRunNestedSharesLimit(t *testing.T) {
+	SetLimit(3)
+	defer SetLimit(0)
+
+	var peak atomic.Int64
+	notePeak := func() {
+		for {
+			n, p := helpers.Load(), peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				return
+			}
+		}
+	}
+
+	var sum atomic.Int64
+	Run(8, 8, func(outer int) {
+		notePeak()
+		Run(8, 8, func(inner int) {
+			notePeak()
+			sum.Add(int64(outer*8 + inner))
+		})
+	})
+
+	if want := int64(64 * 63 / 2); sum.Load() != want {
+		t.Errorf("sum = %d, want %d", sum.Load(), want)
+	}
+	if peak.Load() > 2 {
+		t.Errorf("peak helpers = %d, want at most 2 for a limit of 3", peak.Load())
+	}
+	if helpers.Load() != 0 {
+		t.Errorf("%d helpers still reserved after Run returned", helpers.Load())
+	}
+}
+
+func TestWorkers(t *testing.T) {
+	SetLimit(4)
+	defer SetLimit(0)
+
+	for requested, want := range map[int]int{0: 4, -1: 4, 2: 2, 4: 4, 16: 4} {
+		if got := Workers(requested); got != want {
+			t.Errorf("Workers(%d) = %d, want %d", requested, got, want)
+		}
+	}
+}
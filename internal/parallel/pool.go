@@ -3,7 +3,6 @@ package parallel
 
 import (
 	"context"
-	"runtime"
 	"sync"
 )
 
@@ -17,10 +16,11 @@ type Pool struct {
 }
 
 // NewPool creates a new worker pool with the specified number of workers.
-// If workers <= 0, it defaults to runtime.NumCPU().
+// If workers <= 0, it defaults to Limit(). A Pool's workers are its own and
+// do not count against the shared budget that Run draws from.
 func NewPool(workers int) *Pool {
 	if workers <= 0 {
-		workers = runtime.NumCPU()
+		workers = Limit()
 	}
 	
 	ctx, cancel := context.WithCancel(context.Background())
@@ -71,86 +71,48 @@ func (p *Pool) Close() {
 }
 
 // ParallelMap applies a function to each element in parallel and returns results.
+// The work is split into workers chunks run with Run, so it shares the
+// process-wide limit set by SetLimit.
 func ParallelMap[T, R any](data []T, fn func(T) R, workers int) []R {
-	if workers <= 0 {
-		workers = runtime.NumCPU()
-	}
+	workers = Workers(workers)
 	
 	if len(data) == 0 {
 		return []R{}
 	}
 	
 	result := make([]R, len(data))
-	chunkSize := (len(data) + workers - 1) / workers
-	
-	var wg sync.WaitGroup
-	for w := 0; w < workers; w++ {
-		start := w * chunkSize
-		end := start + chunkSize
-		if start >= len(data) {
-			break
+	chunks := splitChunks(len(data), workers)
+	Run(len(chunks), workers, func(c int) {
+		for i := chunks[c][0]; i < chunks[c][1]; i++ {
+			result[i] = fn(data[i])
 		}
-		if end > len(data) {
-			end = len(data)
-		}
-		
-		wg.Add(1)
-		go func(s, e int) {
-			defer wg.Done()
-			for i := s; i < e; i++ {
-				result[i] = fn(data[i])
-			}
-		}(start, end)
-	}
-	
-	wg.Wait()
+	})
 	return result
 }
 
 // ParallelReduce reduces data using a function in parallel.
+// Partial results are combined in chunk order.
 func ParallelReduce[T, R any](data []T, fn func(T, R) R, initial R, combiner func(R, R) R, workers int) R {
-	if workers <= 0 {
-		workers = runtime.NumCPU()
-	}
+	workers = Workers(workers)
 	
 	if len(data) == 0 {
 		return initial
 	}
 	
-	chunkSize := (len(data) + workers - 1) / workers
-	partialResults := make([]R, workers)
-	
-	var wg sync.WaitGroup
-	actualWorkers := 0
-	
-	for w := 0; w < workers; w++ {
-		start := w * chunkSize
-		end := start + chunkSize
-		if start >= len(data) {
-			break
+	chunks := splitChunks(len(data), workers)
+	partialResults := make([]R, len(chunks))
+	Run(len(chunks), workers, func(c int) {
+		result := initial
+		for i := chunks[c][0]; i < chunks[c][1]; i++ {
+			result = fn(data[i], result)
 		}
-		if end > len(data) {
-			end = len(data)
-		}
-		
-		actualWorkers++
-		wg.Add(1)
-		go func(s, e, idx int) {
-			defer wg.Done()
-			result := initial
-			for i := s; i < e; i++ {
-				result = fn(data[i], result)
-			}
-			partialResults[idx] = result
-		}(start, end, w)
-	}
-	
-	wg.Wait()
+		partialResults[c] = result
+	})
 	
 	// Combine partial results
 	final := initial
-	for i := 0; i < actualWorkers; i++ {
-		final = combiner(final, partialResults[i])
+	for _, partial := range partialResults {
+		final = combiner(final, partial)
 	}
 	
 	return final
@@ -158,35 +120,26 @@ func ParallelReduce[T, R any](data []T, fn func(T, R) R, initial R, combiner fun
 
 // ParallelForEach applies a function to each element in parallel (no return value).
 func ParallelForEach[T any](data []T, fn func(T), workers int) {
-	if workers <= 0 {
-		workers = runtime.NumCPU()
-	}
+	workers = Workers(workers)
 	
 	if len(data) == 0 {
 		return
 	}
 	
-	chunkSize := (len(data) + workers - 1) / workers
-	
-	var wg sync.WaitGroup
-	for w := 0; w < workers; w++ {
-		start := w * chunkSize
-		end := start + chunkSize
-		if start >= len(data) {
-			break
-		}
-		if end > len(data) {
-			end = len(data)
+	chunks := splitChunks(len(data), workers)
+	Run(len(chunks), workers, func(c int) {
+		for i := chunks[c][0]; i < chunks[c][1]; i++ {
+			fn(data[i])
 		}
-		
-		wg.Add(1)
-		go func(s, e int) {
-			defer wg.Done()
-			for i := s; i < e; i++ {
-				fn(data[i])
-			}
-		}(start, end)
+	})
+}
+
+// splitChunks splits [0, n) into at most workers contiguous [start, end) ranges.
+func splitChunks(n, workers int) [][2]int {
+	chunkSize := (n + workers - 1) / workers
+	chunks := make([][2]int, 0, workers)
+	for start := 0; start < n; start += chunkSize {
+		chunks = append(chunks, [2]int{start, min(start+chunkSize, n)})
 	}
-	
-	wg.Wait()
+	return chunks
 }
@@ -0,0 +1,46 @@
+// Package progress reports how much of an input has been consumed.
+package progress
+
+import (
+	"io"
+	"os"
+)
+
+// Interval is the minimum number of bytes read between two reports.
+const Interval = 256 << 10
+
+// Reader wraps an io.Reader and calls a callback with the number of bytes
+// read so far and the total size (-1 if unknown). The callback runs at most
+// once per Interval bytes, plus once when the input is exhausted.
+type Reader struct {
+	r        io.Reader
+	fn       func(read, total int64)
+	total    int64
+	read     int64
+	reported int64
+}
+
+// NewReader returns a Reader reporting progress through r to fn.
+func NewReader(r io.Reader, total int64, fn func(read, total int64)) *Reader {
+	return &Reader{r: r, fn: fn, total: total}
+}
+
+// Read reads from the underlying reader, reporting progress as it goes.
+func (p *Reader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	p.read += int64(n)
+	if p.read-p.reported >= Interval || (err == io.EOF && p.read > p.reported) {
+		p.reported = p.read
+		p.fn(p.read, p.total)
+	}
+	return n, err
+}
+
+// FileSize returns the size of f, or -1 if it cannot be determined.
+func FileSize(f *os.File) int64 {
+	info, err := f.Stat()
+	if err != nil || !info.Mode().IsRegular() {
+		return -1
+	}
+	return info.Size()
+}
@@ -1,10 +1,13 @@
 // Package csv provides CSV reading and writing functionality for DataFrames.
 //
 // Features:
-//   - Automatic type inference (int64, float64, bool, string)
-//   - Configurable NA value detection
-//   - Support for custom delimiters
+//   - Automatic type inference (int64, float64, bool, string) from a sample
+//     of rows, widening the type if later values do not fit
+//   - Configurable NA value detection, globally and per column
+//   - Support for custom delimiters, and SniffDialect to guess the delimiter,
+//     quote character and header of an unknown file
 //   - Header row handling
+//   - Cancellation with ReadCSVContext and progress callbacks with WithProgress
 //   - Streaming support for large files (phase 5)
 //
 // Performance targets:
@@ -19,5 +22,18 @@
 //	    csv.WithNA([]string{"NA", "NULL"}),
 //	)
 //
+//	// Guess the layout of a file from an unknown source
+//	d, err := csv.SniffDialect("export.txt")
+//	df, err = csv.ReadCSV("export.txt", csv.WithDialect(d))
+//
+//	// Read from any io.Reader, e.g. an HTTP response body
+//	df, err = csv.ReadCSVFrom(resp.Body)
+//
 //	err = csv.WriteCSV(df, "output.csv")
+//
+//	// Add a batch of rows to an existing file, without repeating the header
+//	err = csv.WriteCSV(batch, "output.csv", csv.WithAppend(true))
+//
+//	// Write to any io.Writer, e.g. a gzip.Writer
+//	err = csv.WriteCSVTo(df, gz)
 package csv
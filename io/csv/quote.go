@@ -0,0 +1,95 @@
+package csv
+
+import (
+	"bufio"
+	"io"
+	"unicode/utf8"
+)
+
+// quoteReader rewrites CSV quoted with quote into standard '"' quoting for
+// encoding/csv: quote opening and closing a field becomes '"', a doubled
+// quote inside a field becomes a single literal quote, and '"' becomes an
+// ordinary character, escaped as '""' inside a quoted field. A field that
+// starts with '"' is quoted on the fly so that encoding/csv does not take
+// the '"' as an opening quote.
+type quoteReader struct {
+	in    *bufio.Reader
+	delim rune
+	quote rune
+
+	inQuote    bool // inside a field quoted with quote
+	wrapped    bool // inside an unquoted field quoted on the fly
+	fieldStart bool
+	err        error
+	buf        []byte // translated bytes not yet returned
+}
+
+// newQuoteReader returns a reader translating in from quote to '"' quoting.
+func newQuoteReader(in io.Reader, delim, quote rune) *quoteReader {
+	return &quoteReader{
+		in:         bufio.NewReader(in),
+		delim:      delim,
+		quote:      quote,
+		fieldStart: true,
+	}
+}
+
+// Read implements io.Reader.
+func (q *quoteReader) Read(p []byte) (int, error) {
+	for len(q.buf) < len(p) && q.err == nil {
+		q.translate()
+	}
+	n := copy(p, q.buf)
+	q.buf = q.buf[n:]
+	if n == 0 && q.err != nil {
+		return 0, q.err
+	}
+	return n, nil
+}
+
+// translate reads one rune and appends its translation to buf.
+func (q *quoteReader) translate() {
+	c, _, err := q.in.ReadRune()
+	if err != nil {
+		if q.wrapped {
+			q.buf = append(q.buf, '"')
+			q.wrapped = false
+		}
+		q.err = err
+		return
+	}
+
+	switch {
+	case q.inQuote && c == q.quote:
+		if next, _, err := q.in.ReadRune(); err == nil && next == q.quote {
+			q.buf = utf8.AppendRune(q.buf, q.quote)
+			return
+		} else if err == nil {
+			_ = q.in.UnreadRune()
+		}
+		q.buf = append(q.buf, '"')
+		q.inQuote = false
+	case q.inQuote || q.wrapped:
+		if c == '"' {
+			q.buf = append(q.buf, '"', '"')
+		} else if q.wrapped && (c == q.delim || c == '\n' || c == '\r') {
+			q.buf = append(q.buf, '"')
+			q.buf = utf8.AppendRune(q.buf, c)
+			q.wrapped = false
+			q.fieldStart = c != '\r'
+		} else {
+			q.buf = utf8.AppendRune(q.buf, c)
+		}
+	case q.fieldStart && c == q.quote:
+		q.buf = append(q.buf, '"')
+		q.inQuote = true
+		q.fieldStart = false
+	case q.fieldStart && c == '"':
+		q.buf = append(q.buf, '"', '"', '"')
+		q.wrapped = true
+		q.fieldStart = false
+	default:
+		q.buf = utf8.AppendRune(q.buf, c)
+		q.fieldStart = c == q.delim || c == '\n'
+	}
+}
@@ -2,6 +2,7 @@
 package csv
 
 import (
+	"context"
 	"encoding/csv"
 	"fmt"
 	"io"
@@ -11,18 +12,28 @@ import (
 
 	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/internal/bitset"
+	"github.com/TIVerse/GopherData/internal/progress"
 	"github.com/TIVerse/GopherData/series"
 )
 
 // CSVReader reads CSV files into DataFrames.
 type CSVReader struct {
-	path      string
 	delimiter rune
+	quote     rune // quote character other than '"'; see WithDialect
 	header    bool
 	naValues  []string
+	naPerCol  map[string][]string
 	chunkSize int
 	parallel  int
 	dtypes    map[string]core.Dtype
+	onError   string // "", "raise", "coerce" or "skip"; see WithParseErrors
+	progress  func(bytesRead, totalBytes int64)
+
+	// Writer settings, shared through CSVOption
+	writeHeader  bool // see WithWriteHeader
+	appendMode   bool // see WithAppend
+	trustColumns bool // see WithTrustColumns
 }
 
 // CSVOption is a functional option for configuring CSVReader.
@@ -52,6 +63,17 @@ func WithNA(naValues []string) CSVOption {
 	}
 }
 
+// WithNAPerColumn adds column-specific strings to treat as null values.
+// A value is null in a column if it matches the global list (see WithNA) or
+// that column's own list, so per-column tokens extend the global set rather
+// than replace it. Columns not in the map use the global list alone.
+func WithNAPerColumn(naValues map[string][]string) CSVOption {
+	return func(r *CSVReader) error {
+		r.naPerCol = naValues
+		return nil
+	}
+}
+
 // WithChunkSize sets the chunk size for reading large files.
 func WithChunkSize(size int) CSVOption {
 	return func(r *CSVReader) error {
@@ -61,6 +83,9 @@ func WithChunkSize(size int) CSVOption {
 }
 
 // WithParallel sets the number of parallel workers (default: runtime.NumCPU()).
+// When writing, n > 1 formats blocks of rows on up to n goroutines, as many
+// as core.SetParallelism leaves free, producing the same bytes as a serial
+// write; writes are serial by default.
 func WithParallel(n int) CSVOption {
 	return func(r *CSVReader) error {
 		r.parallel = n
@@ -76,16 +101,89 @@ func WithDtypes(dtypes map[string]core.Dtype) CSVOption {
 	}
 }
 
+// WithParseErrors sets how values that do not parse as their column's type
+// are handled:
+//
+//   - "raise": fail the read.
+//   - "coerce": store the value as null.
+//   - "skip": drop the row containing it.
+//
+// Without this option, an inferred column is widened to a type that fits
+// every value (int64 to float64, then string), and a column given an
+// explicit dtype with WithDtypes fails the read. With any mode set, an
+// inferred column is still widened from int64 to float64 if a value needs
+// it, but never to string: the mode applies to values that parse as no
+// numeric type, and to every value that does not parse as an explicit
+// dtype.
+func WithParseErrors(mode string) CSVOption {
+	return func(r *CSVReader) error {
+		switch mode {
+		case "raise", "coerce", "skip":
+			r.onError = mode
+			return nil
+		default:
+			return fmt.Errorf("parse errors mode %q must be raise, coerce or skip: %w", mode, core.ErrInvalidArgument)
+		}
+	}
+}
+
+// WithProgress sets a callback that reports how much of the input has been
+// read. It is called at most once every 256KiB and once at the end of the
+// input, so it may do cheap work such as redrawing a progress bar.
+// totalBytes is the file size, or -1 for an io.Reader of unknown size.
+func WithProgress(fn func(bytesRead, totalBytes int64)) CSVOption {
+	return func(r *CSVReader) error {
+		r.progress = fn
+		return nil
+	}
+}
+
 // ReadCSV reads a CSV file and returns a DataFrame.
 func ReadCSV(path string, opts ...CSVOption) (*dataframe.DataFrame, error) {
+	return ReadCSVContext(context.Background(), path, opts...)
+}
+
+// ReadCSVContext reads a CSV file like ReadCSV, stopping early if ctx is
+// canceled or its deadline passes. The context is checked every few
+// thousand records and before each column is parsed; on cancellation the
+// read returns ctx.Err().
+func ReadCSVContext(ctx context.Context, path string, opts ...CSVOption) (*dataframe.DataFrame, error) {
+	reader, err := newCSVReader(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	return reader.read(ctx, reader.track(file, progress.FileSize(file)))
+}
+
+// ReadCSVFrom reads CSV data from r, such as an HTTP response body or a
+// strings.Reader, and returns a DataFrame. It does not close r.
+func ReadCSVFrom(r io.Reader, opts ...CSVOption) (*dataframe.DataFrame, error) {
+	reader, err := newCSVReader(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return reader.read(context.Background(), reader.track(r, -1))
+}
+
+// newCSVReader returns a CSVReader with the defaults and opts applied.
+func newCSVReader(opts []CSVOption) (*CSVReader, error) {
 	reader := &CSVReader{
-		path:      path,
 		delimiter: ',',
 		header:    true,
 		naValues:  core.DefaultNAValues,
 		chunkSize: 0,
 		parallel:  0,
 		dtypes:    nil,
+
+		writeHeader: true,
 	}
 
 	// Apply options
@@ -95,20 +193,31 @@ func ReadCSV(path string, opts ...CSVOption) (*dataframe.DataFrame, error) {
 		}
 	}
 
-	return reader.read()
+	return reader, nil
 }
 
-// read performs the actual CSV reading.
-func (r *CSVReader) read() (*dataframe.DataFrame, error) {
-	file, err := os.Open(r.path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open file: %w", err)
+// track wraps in to report progress, if a callback is set.
+func (r *CSVReader) track(in io.Reader, total int64) io.Reader {
+	if r.progress == nil {
+		return in
 	}
-	defer func() { _ = file.Close() }()
+	return progress.NewReader(in, total, r.progress)
+}
+
+// contextCheckRows is how many records are read between context checks.
+const contextCheckRows = 4096
 
-	csvReader := csv.NewReader(file)
+// read performs the actual CSV reading.
+func (r *CSVReader) read(ctx context.Context, in io.Reader) (*dataframe.DataFrame, error) {
+	quoted := r.quote != 0 && r.quote != '"'
+	if quoted {
+		in = newQuoteReader(in, r.delimiter, r.quote)
+	}
+	csvReader := csv.NewReader(in)
 	csvReader.Comma = r.delimiter
 	csvReader.ReuseRecord = true
+	// With another quote character, '"' is an ordinary character anywhere
+	csvReader.LazyQuotes = quoted
 
 	// Read header or generate column names
 	var columns []string
@@ -124,6 +233,12 @@ func (r *CSVReader) read() (*dataframe.DataFrame, error) {
 	// Read all records
 	var records [][]string
 	for {
+		if len(records)%contextCheckRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
 		record, err := csvReader.Read()
 		if err == io.EOF {
 			break
@@ -152,68 +267,118 @@ func (r *CSVReader) read() (*dataframe.DataFrame, error) {
 	}
 
 	// Collect column values as strings first
-	rawData := make(map[string][]string, len(columns))
-	for i, col := range columns {
-		rawData[col] = make([]string, len(records))
+	rawData := make([][]string, len(columns))
+	for i := range columns {
+		rawData[i] = make([]string, len(records))
 		for j, record := range records {
 			if i < len(record) {
-				rawData[col][j] = record[i]
-			} else {
-				rawData[col][j] = ""
+				rawData[i][j] = record[i]
 			}
 		}
 	}
 
-	// Infer types and parse data
-	columnData := make(map[string]any, len(columns))
+	df, err := dataframe.New(map[string]any{columns[0]: make([]any, len(records))})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dataframe: %w", err)
+	}
 
-	// Infer types and parse
-	for _, col := range columns {
-		var dtype core.Dtype
-		if r.dtypes != nil {
-			if dt, exists := r.dtypes[col]; exists {
-				dtype = dt
-			} else {
-				dtype = r.inferType(rawData[col])
+	var badRows []int // Rows to drop under "skip"
+	for i, col := range columns {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		values := rawData[i]
+		dtype, explicit := r.dtypes[col]
+		if !explicit {
+			sample := values
+			if len(sample) > inferSampleRows {
+				sample = sample[:inferSampleRows]
 			}
-		} else {
-			dtype = r.inferType(rawData[col])
+			dtype = r.inferType(col, sample)
 		}
 
-		parsed, err := r.parseColumn(rawData[col], dtype)
+		// Inference only saw a sample, so widen the type until the whole
+		// column parses. With a parse errors mode set, a column is widened
+		// only as far as its values are numbers, and the mode handles the
+		// rest. Explicit dtypes must parse as given.
+		if !explicit && r.onError != "" {
+			dtype = r.widenNumeric(col, values, dtype)
+		}
+		lenient := r.onError == "coerce" || r.onError == "skip"
+		data, nulls, bad, err := r.parseColumn(col, values, dtype, lenient)
+		for err != nil && !explicit && r.onError == "" {
+			dtype = widenDtype(dtype)
+			data, nulls, bad, err = r.parseColumn(col, values, dtype, lenient)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse column %q: %w", col, err)
 		}
-		columnData[col] = parsed
+		if r.onError == "skip" {
+			badRows = append(badRows, bad...)
+		}
+
+		df = df.WithColumn(col, series.NewWithNulls(col, data, dtype, nulls))
 	}
 
-	// Create DataFrame
-	df, err := dataframe.New(columnData)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create dataframe: %w", err)
+	if len(badRows) > 0 {
+		df = df.DropRows(badRows...)
+	}
+
+	return df, nil
+}
+
+// inferSampleRows bounds how many rows inferType looks at per column.
+const inferSampleRows = 1000
+
+// widenDtype returns the next type to try when a column fails to parse as
+// dtype: int64 widens to float64, and everything else to string.
+func widenDtype(dtype core.Dtype) core.Dtype {
+	if dtype == core.DtypeInt64 {
+		return core.DtypeFloat64
 	}
+	return core.DtypeString
+}
 
-	// Mark null values
-	for col, values := range rawData {
-		s, _ := df.Column(col)
-		for i, val := range values {
-			if r.isNA(val) {
-				s.SetNull(i)
+// widenNumeric widens dtype short of string while some value of the column
+// does not parse as dtype but does parse as the wider type, so a float
+// after the inference sample turns an int64 column into float64 rather
+// than an error.
+func (r *CSVReader) widenNumeric(col string, values []string, dtype core.Dtype) core.Dtype {
+	for {
+		next := widenDtype(dtype)
+		if next == core.DtypeString {
+			return dtype
+		}
+
+		fits := true
+		for _, val := range values {
+			if r.isNA(col, val) {
+				continue
+			}
+			if _, err := parseValue(val, dtype); err == nil {
+				continue
 			}
+			if _, err := parseValue(val, next); err == nil {
+				fits = false
+				break
+			}
+		}
+		if fits {
+			return dtype
 		}
+		dtype = next
 	}
-
-	return df, nil
 }
 
 // inferType infers the data type from a column of string values.
-func (r *CSVReader) inferType(values []string) core.Dtype {
+func (r *CSVReader) inferType(col string, values []string) core.Dtype {
 	hasInt := true
 	hasFloat := true
 	hasBool := true
 
 	for _, val := range values {
-		if r.isNA(val) {
+		if r.isNA(col, val) {
 			continue
 		}
 
@@ -258,70 +423,93 @@ func (r *CSVReader) inferType(values []string) core.Dtype {
 }
 
 // parseColumn parses a column of string values into the specified type.
-func (r *CSVReader) parseColumn(values []string, dtype core.Dtype) ([]any, error) {
+// NA values are set in the returned null mask, which is nil if there are
+// none, and hold the type's zero value. A value that does not parse is an
+// error, unless lenient is set: then it is treated as NA and its row is
+// returned in bad.
+func (r *CSVReader) parseColumn(col string, values []string, dtype core.Dtype, lenient bool) ([]any, *bitset.BitSet, []int, error) {
 	result := make([]any, len(values))
+	var nulls *bitset.BitSet
+	var bad []int
 
 	for i, val := range values {
-		if r.isNA(val) {
-			// Will be marked as null later
-			switch dtype {
-			case core.DtypeInt64:
-				result[i] = int64(0)
-			case core.DtypeFloat64:
-				result[i] = float64(0)
-			case core.DtypeBool:
-				result[i] = false
-			case core.DtypeString:
-				result[i] = ""
-			default:
-				result[i] = ""
+		if !r.isNA(col, val) {
+			parsed, err := parseValue(val, dtype)
+			if err == nil {
+				result[i] = parsed
+				continue
 			}
-			continue
+			if !lenient {
+				return nil, nil, nil, err
+			}
+			bad = append(bad, i)
 		}
 
+		// NA or unparseable: null, holding the type's zero value
+		if nulls == nil {
+			nulls = bitset.New(len(values))
+		}
+		nulls.Set(i)
+
 		switch dtype {
 		case core.DtypeInt64:
-			parsed, err := strconv.ParseInt(val, 10, 64)
-			if err != nil {
-				return nil, fmt.Errorf("failed to parse %q as int64: %w", val, err)
-			}
-			result[i] = parsed
-
+			result[i] = int64(0)
 		case core.DtypeFloat64:
-			parsed, err := strconv.ParseFloat(val, 64)
-			if err != nil {
-				return nil, fmt.Errorf("failed to parse %q as float64: %w", val, err)
-			}
-			result[i] = parsed
-
+			result[i] = float64(0)
 		case core.DtypeBool:
-			switch strings.ToLower(val) {
-			case "true":
-				result[i] = true
-			case "false":
-				result[i] = false
-			default:
-				return nil, fmt.Errorf("failed to parse %q as bool", val)
-			}
+			result[i] = false
+		default:
+			result[i] = ""
+		}
+	}
 
-		case core.DtypeString:
-			result[i] = val
+	return result, nulls, bad, nil
+}
+
+// parseValue parses a single non-NA string value as dtype.
+func parseValue(val string, dtype core.Dtype) (any, error) {
+	switch dtype {
+	case core.DtypeInt64:
+		parsed, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %q as int64: %w", val, err)
+		}
+		return parsed, nil
 
+	case core.DtypeFloat64:
+		parsed, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %q as float64: %w", val, err)
+		}
+		return parsed, nil
+
+	case core.DtypeBool:
+		switch strings.ToLower(val) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
 		default:
-			result[i] = val
+			return nil, fmt.Errorf("failed to parse %q as bool", val)
 		}
-	}
 
-	return result, nil
+	default:
+		return val, nil
+	}
 }
 
-// isNA checks if a value should be treated as null/NA.
-func (r *CSVReader) isNA(val string) bool {
+// isNA checks if a value in the given column should be treated as null/NA.
+func (r *CSVReader) isNA(col, val string) bool {
 	for _, na := range r.naValues {
 		if val == na {
 			return true
 		}
 	}
+	for _, na := range r.naPerCol[col] {
+		if val == na {
+			return true
+		}
+	}
 	return false
 }
 
@@ -0,0 +1,370 @@
+package csv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestReadCSVFrom(t *testing.T) {
+	df, err := ReadCSVFrom(strings.NewReader("a;b\n1;x\n2;\n"), WithDelimiter(';'))
+	if err != nil {
+		t.Fatalf("ReadCSVFrom() error = %v", err)
+	}
+	if cols := df.Columns(); len(cols) != 2 || cols[0] != "a" || cols[1] != "b" {
+		t.Errorf("columns = %v, want [a b]", cols)
+	}
+	a, _ := df.Column("a")
+	if a.Dtype() != core.DtypeInt64 {
+		t.Errorf("a dtype = %v, want int64", a.Dtype())
+	}
+	b, _ := df.Column("b")
+	if !b.IsNull(1) {
+		t.Error("empty b[1] should be null")
+	}
+}
+
+func TestReadCSVNAPerColumn(t *testing.T) {
+	path := writeFile(t, "sensors.csv", "reading,status\n"+
+		"1.5,ok\n"+
+		"-999,missing\n"+
+		"2.5,-999\n"+
+		"NA,missing\n"+
+		"3.5,NA\n")
+
+	df, err := ReadCSV(path,
+		WithNA([]string{"NA"}),
+		WithNAPerColumn(map[string][]string{
+			"reading": {"-999"},
+			"status":  {"missing"},
+		}),
+	)
+	if err != nil {
+		t.Fatalf("ReadCSV() error = %v", err)
+	}
+
+	tests := []struct {
+		col   string
+		dtype core.Dtype
+		nulls []bool
+	}{
+		{"reading", core.DtypeFloat64, []bool{false, true, false, true, false}},
+		{"status", core.DtypeString, []bool{false, true, false, true, true}},
+	}
+	for _, tt := range tests {
+		s, err := df.Column(tt.col)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.Dtype() != tt.dtype {
+			t.Errorf("column %s dtype = %v, want %v", tt.col, s.Dtype(), tt.dtype)
+		}
+		for i, want := range tt.nulls {
+			if got := s.IsNull(i); got != want {
+				t.Errorf("%s[%d] null = %v, want %v", tt.col, i, got, want)
+			}
+		}
+	}
+
+	// A sentinel of one column is an ordinary value in another
+	status, _ := df.Column("status")
+	if val, _ := status.Get(2); val != "-999" {
+		t.Errorf("status[2] = %v, want -999", val)
+	}
+}
+
+func TestReadCSVFallsBackOnParseFailure(t *testing.T) {
+	// Stray values sit past the inference sample, so the sample alone
+	// suggests int64 for both columns.
+	var sb strings.Builder
+	sb.WriteString("code,amount\n")
+	for i := 0; i < inferSampleRows+10; i++ {
+		code, amount := fmt.Sprint(i), fmt.Sprint(i)
+		if i == inferSampleRows+5 {
+			code, amount = "unknown", "12.5"
+		}
+		fmt.Fprintf(&sb, "%s,%s\n", code, amount)
+	}
+	path := writeFile(t, "mostly_int.csv", sb.String())
+
+	df, err := ReadCSV(path)
+	if err != nil {
+		t.Fatalf("ReadCSV() error = %v", err)
+	}
+
+	code, _ := df.Column("code")
+	if code.Dtype() != core.DtypeString {
+		t.Errorf("code dtype = %v, want string", code.Dtype())
+	}
+	if val, _ := code.Get(inferSampleRows + 5); val != "unknown" {
+		t.Errorf("code[%d] = %v, want unknown", inferSampleRows+5, val)
+	}
+	if val, _ := code.Get(3); val != "3" {
+		t.Errorf("code[3] = %v, want \"3\"", val)
+	}
+
+	amount, _ := df.Column("amount")
+	if amount.Dtype() != core.DtypeFloat64 {
+		t.Errorf("amount dtype = %v, want float64", amount.Dtype())
+	}
+
+	if cols := df.Columns(); len(cols) != 2 || cols[0] != "code" || cols[1] != "amount" {
+		t.Errorf("columns = %v, want header order [code amount]", cols)
+	}
+
+	// An explicit dtype is not widened
+	_, err = ReadCSV(path, WithDtypes(map[string]core.Dtype{"code": core.DtypeInt64}))
+	if err == nil {
+		t.Error("expected an error for an explicit int64 column with a text value")
+	}
+}
+
+func TestReadCSVProgress(t *testing.T) {
+	var sb strings.Builder
+	sb.WriteString("id,label\n")
+	for i := 0; i < 100000; i++ {
+		fmt.Fprintf(&sb, "%d,row-%d\n", i, i)
+	}
+	path := writeFile(t, "progress.csv", sb.String())
+	size := int64(sb.Len())
+
+	var calls [][2]int64
+	record := func(read, total int64) { calls = append(calls, [2]int64{read, total}) }
+
+	if _, err := ReadCSV(path, WithProgress(record)); err != nil {
+		t.Fatalf("ReadCSV() error = %v", err)
+	}
+	if len(calls) < 2 {
+		t.Fatalf("got %d progress calls for a %d byte file, want several", len(calls), size)
+	}
+	if len(calls) > int(size)/(128<<10) {
+		t.Errorf("got %d progress calls for a %d byte file, want them throttled", len(calls), size)
+	}
+	for i, c := range calls {
+		if c[1] != size {
+			t.Errorf("call %d total = %d, want %d", i, c[1], size)
+		}
+		if i > 0 && c[0] <= calls[i-1][0] {
+			t.Errorf("call %d read %d bytes, not more than the previous %d", i, c[0], calls[i-1][0])
+		}
+	}
+	if last := calls[len(calls)-1][0]; last != size {
+		t.Errorf("last call read %d bytes, want %d", last, size)
+	}
+
+	// Unknown size for a plain io.Reader
+	calls = nil
+	if _, err := ReadCSVFrom(strings.NewReader("a\n1\n"), WithProgress(record)); err != nil {
+		t.Fatalf("ReadCSVFrom() error = %v", err)
+	}
+	if len(calls) != 1 || calls[0] != [2]int64{4, -1} {
+		t.Errorf("progress calls = %v, want [[4 -1]]", calls)
+	}
+}
+
+// cancelingReader cancels a context once more than after bytes have been
+// read through it.
+type cancelingReader struct {
+	r      io.Reader
+	after  int
+	read   int
+	cancel context.CancelFunc
+}
+
+func (c *cancelingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.read += n
+	if c.read > c.after {
+		c.cancel()
+	}
+	return n, err
+}
+
+func TestReadCSVContextCanceledMidRead(t *testing.T) {
+	var sb strings.Builder
+	sb.WriteString("id,value\n")
+	for i := 0; i < 200000; i++ {
+		fmt.Fprintf(&sb, "%d,%d\n", i, i*2)
+	}
+	content := sb.String()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	input := &cancelingReader{r: strings.NewReader(content), after: len(content) / 10, cancel: cancel}
+
+	reader, err := newCSVReader(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := reader.read(ctx, input); !errors.Is(err, context.Canceled) {
+		t.Fatalf("read() error = %v, want context.Canceled", err)
+	}
+	if input.read >= len(content)/2 {
+		t.Errorf("read %d of %d bytes after cancellation, want a prompt stop", input.read, len(content))
+	}
+}
+
+func TestReadCSVContextDeadline(t *testing.T) {
+	path := writeFile(t, "small.csv", "a\n1\n2\n")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	if _, err := ReadCSVContext(ctx, path); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("ReadCSVContext() error = %v, want context.DeadlineExceeded", err)
+	}
+
+	df, err := ReadCSVContext(context.Background(), path)
+	if err != nil {
+		t.Fatalf("ReadCSVContext() error = %v", err)
+	}
+	if df.Nrows() != 2 {
+		t.Errorf("got %d rows, want 2", df.Nrows())
+	}
+}
+
+func BenchmarkReadCSV(b *testing.B) {
+	var sb strings.Builder
+	sb.WriteString("id,price,active,name\n")
+	for i := 0; i < 100000; i++ {
+		fmt.Fprintf(&sb, "%d,%d.25,%t,item-%d\n", i, i%1000, i%2 == 0, i)
+	}
+	path := filepath.Join(b.TempDir(), "bench.csv")
+	if err := os.WriteFile(path, []byte(sb.String()), 0o644); err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(sb.Len()))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ReadCSV(path); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestReadCSVParseErrors(t *testing.T) {
+	path := writeFile(t, "dirty.csv", "id,qty\n1,10\n2,ten\n3,30\n")
+	qtyInt := WithDtypes(map[string]core.Dtype{"qty": core.DtypeInt64})
+
+	t.Run("raise", func(t *testing.T) {
+		if _, err := ReadCSV(path, WithParseErrors("raise"), qtyInt); err == nil {
+			t.Error("expected an error for the bad value")
+		}
+	})
+
+	t.Run("coerce", func(t *testing.T) {
+		df, err := ReadCSV(path, WithParseErrors("coerce"), qtyInt)
+		if err != nil {
+			t.Fatalf("ReadCSV() error = %v", err)
+		}
+		if df.Nrows() != 3 {
+			t.Fatalf("got %d rows, want 3", df.Nrows())
+		}
+		qty, _ := df.Column("qty")
+		if qty.Dtype() != core.DtypeInt64 {
+			t.Errorf("qty dtype = %v, want int64", qty.Dtype())
+		}
+		for i, want := range []bool{false, true, false} {
+			if got := qty.IsNull(i); got != want {
+				t.Errorf("qty[%d] null = %v, want %v", i, got, want)
+			}
+		}
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		df, err := ReadCSV(path, WithParseErrors("skip"), qtyInt)
+		if err != nil {
+			t.Fatalf("ReadCSV() error = %v", err)
+		}
+		if df.Nrows() != 2 {
+			t.Fatalf("got %d rows, want 2", df.Nrows())
+		}
+		id, _ := df.Column("id")
+		qty, _ := df.Column("qty")
+		for i, want := range []int64{1, 3} {
+			if val, _ := id.Get(i); val != want {
+				t.Errorf("id[%d] = %v, want %d", i, val, want)
+			}
+			if val, _ := qty.Get(i); val != want*10 {
+				t.Errorf("qty[%d] = %v, want %d", i, val, want*10)
+			}
+		}
+	})
+
+	t.Run("inferred", func(t *testing.T) {
+		// The sample is the whole file, so qty infers as string and parses
+		_, err := ReadCSV(path, WithParseErrors("raise"))
+		if err != nil {
+			t.Errorf("ReadCSV() error = %v", err)
+		}
+	})
+
+	if _, err := ReadCSV(path, WithParseErrors("ignore")); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("unknown mode error = %v, want ErrInvalidArgument", err)
+	}
+}
+
+func TestReadCSVParseErrorsWidens(t *testing.T) {
+	// A float and a bad value after the inference sample: the column still
+	// widens to float64, and only the bad value is left to the mode
+	var sb strings.Builder
+	sb.WriteString("qty\n")
+	for i := 0; i < inferSampleRows+500; i++ {
+		sb.WriteString("1\n")
+	}
+	sb.WriteString("2.5\noops\n")
+	path := writeFile(t, "late.csv", sb.String())
+	n := inferSampleRows + 502
+
+	for _, mode := range []string{"raise", "coerce", "skip"} {
+		t.Run(mode, func(t *testing.T) {
+			df, err := ReadCSV(path, WithParseErrors(mode))
+			if mode == "raise" {
+				if err == nil {
+					t.Error("expected an error for the bad value")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReadCSV() error = %v", err)
+			}
+			qty, _ := df.Column("qty")
+			if qty.Dtype() != core.DtypeFloat64 {
+				t.Fatalf("qty dtype = %v, want float64", qty.Dtype())
+			}
+			if val, ok := qty.Get(n - 2); !ok || val != 2.5 {
+				t.Errorf("qty[%d] = %v, want 2.5", n-2, val)
+			}
+			if mode == "coerce" && (df.Nrows() != n || !qty.IsNull(n-1)) {
+				t.Errorf("coerce: want %d rows with the last null, got %d rows", n, df.Nrows())
+			}
+			if mode == "skip" && df.Nrows() != n-1 {
+				t.Errorf("skip: got %d rows, want %d", df.Nrows(), n-1)
+			}
+		})
+	}
+
+	df, err := ReadCSV(writeFile(t, "late_ok.csv", strings.TrimSuffix(sb.String(), "oops\n")), WithParseErrors("raise"))
+	if err != nil {
+		t.Fatalf("ReadCSV() error = %v", err)
+	}
+	if qty, _ := df.Column("qty"); qty.Dtype() != core.DtypeFloat64 {
+		t.Errorf("raise: qty dtype = %v, want float64", qty.Dtype())
+	}
+}
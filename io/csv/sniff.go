@@ -0,0 +1,238 @@
+package csv
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+// sniffSampleBytes is how much of a file SniffDialect inspects.
+const sniffSampleBytes = 64 << 10
+
+// sniffDelimiters are the delimiters SniffDialect chooses between, in the
+// order ties are broken.
+var sniffDelimiters = []rune{',', '\t', ';', '|'}
+
+// Dialect describes how a CSV file is laid out, as guessed by SniffDialect.
+// Pass it to ReadCSV with WithDialect.
+type Dialect struct {
+	Delimiter rune // Field delimiter: ',', '\t', ';' or '|'
+	Quote     rune // Quote character: '"' or '\''
+	Header    bool // Whether the first row holds column names
+}
+
+// WithDialect sets the delimiter, quote character and header handling from
+// a Dialect. With a Quote other than '"', fields are quoted with Quote,
+// doubled to escape it, and '"' is an ordinary character. A Quote that is
+// the delimiter or a line break returns an error wrapping
+// core.ErrInvalidArgument.
+func WithDialect(d Dialect) CSVOption {
+	return func(r *CSVReader) error {
+		if d.Delimiter != 0 {
+			r.delimiter = d.Delimiter
+		}
+		if d.Quote == r.delimiter || d.Quote == '\n' || d.Quote == '\r' {
+			return fmt.Errorf("quote character %q cannot be the delimiter or a line break: %w", d.Quote, core.ErrInvalidArgument)
+		}
+		r.quote = d.Quote
+		r.header = d.Header
+		return nil
+	}
+}
+
+// SniffDialect guesses the Dialect of the CSV file at path from its first
+// 64KiB, for files from unknown sources.
+//
+// The delimiter is the candidate that splits the sampled records into the
+// most consistent number of fields, preferring more fields on a tie and
+// comma when no candidate splits them at all. The quote character is '"'
+// unless more fields open with a single quote ('). The first row is taken
+// as a header unless its cells look like the data below them: a number
+// heading a numeric column counts against a header, and text heading one
+// counts for it. With no evidence either way, such as a file of text
+// columns, Header is true, matching ReadCSV's default.
+//
+// Returns an error wrapping io.EOF if the file is empty.
+func SniffDialect(path string) (Dialect, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Dialect{}, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	sample := make([]byte, sniffSampleBytes)
+	n, err := io.ReadFull(file, sample)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return Dialect{}, fmt.Errorf("failed to read sample: %w", err)
+	}
+	sample = sample[:n]
+
+	// A full sample most likely ends partway through a record
+	if n == sniffSampleBytes {
+		if end := bytes.LastIndexByte(sample, '\n'); end >= 0 {
+			sample = sample[:end+1]
+		}
+	}
+
+	return sniff(string(sample))
+}
+
+// sniff guesses the Dialect of a sample of CSV text.
+func sniff(sample string) (Dialect, error) {
+	if strings.TrimSpace(sample) == "" {
+		return Dialect{}, fmt.Errorf("failed to sniff dialect: %w", io.EOF)
+	}
+
+	d := Dialect{Delimiter: ',', Quote: sniffQuote(sample, ',')}
+
+	bestScore, bestFields := 0.0, 1
+	for _, delim := range sniffDelimiters {
+		quote := sniffQuote(sample, delim)
+		records := splitRecords(sample, delim, quote)
+		fields, share := modalFieldCount(records)
+		if fields < 2 {
+			continue
+		}
+		if share > bestScore || (share == bestScore && fields > bestFields) {
+			d.Delimiter, d.Quote, bestScore, bestFields = delim, quote, share, fields
+		}
+	}
+
+	d.Header = sniffHeader(splitRecords(sample, d.Delimiter, d.Quote))
+	return d, nil
+}
+
+// sniffQuote returns a single quote (') if more fields of the sample, split
+// by delim, open with a single quote than with a double quote, and '"'
+// otherwise.
+func sniffQuote(sample string, delim rune) rune {
+	counts := map[rune]int{}
+	atFieldStart := true
+	for _, c := range sample {
+		if atFieldStart && (c == '"' || c == '\'') {
+			counts[c]++
+		}
+		atFieldStart = c == '\n' || c == delim
+	}
+	if counts['\''] > counts['"'] {
+		return '\''
+	}
+	return '"'
+}
+
+// splitRecords splits a sample into records of fields by delim, honoring
+// quote so that delimiters and newlines inside quoted fields do not split.
+// Quotes are removed from the fields and blank lines are skipped.
+func splitRecords(sample string, delim, quote rune) [][]string {
+	var records [][]string
+	var record []string
+	var field strings.Builder
+	inQuote := false
+
+	endRecord := func() {
+		record = append(record, field.String())
+		field.Reset()
+		if len(record) > 1 || record[0] != "" {
+			records = append(records, record)
+		}
+		record = nil
+	}
+
+	for _, c := range sample {
+		switch {
+		case c == quote:
+			// A doubled quote inside a quoted field toggles twice, which
+			// keeps the state right without keeping the escaped quote.
+			inQuote = !inQuote
+		case inQuote:
+			field.WriteRune(c)
+		case c == delim:
+			record = append(record, field.String())
+			field.Reset()
+		case c == '\n':
+			endRecord()
+		case c != '\r':
+			field.WriteRune(c)
+		}
+	}
+	if field.Len() > 0 || len(record) > 0 {
+		endRecord()
+	}
+	return records
+}
+
+// modalFieldCount returns the most common number of fields per record and
+// the share of records that have it. Ties go to the larger count.
+func modalFieldCount(records [][]string) (int, float64) {
+	if len(records) == 0 {
+		return 0, 0
+	}
+
+	counts := make(map[int]int)
+	for _, record := range records {
+		counts[len(record)]++
+	}
+	mode := 0
+	for fields, count := range counts {
+		if count > counts[mode] || (count == counts[mode] && fields > mode) {
+			mode = fields
+		}
+	}
+	return mode, float64(counts[mode]) / float64(len(records))
+}
+
+// sniffHeader reports whether the first record looks like column names
+// rather than data. Each column whose data cells are all numbers votes: for
+// a header if its first cell is not a number, against if it is.
+func sniffHeader(records [][]string) bool {
+	if len(records) < 2 {
+		return true
+	}
+
+	first, data := records[0], records[1:]
+	votes := 0
+	for j, cell := range first {
+		numeric, seen := true, false
+		for _, record := range data {
+			if j >= len(record) || isSniffNA(record[j]) {
+				continue
+			}
+			seen = true
+			if !isNumber(record[j]) {
+				numeric = false
+				break
+			}
+		}
+		if !seen || !numeric {
+			continue
+		}
+		if isNumber(cell) {
+			votes--
+		} else {
+			votes++
+		}
+	}
+	return votes >= 0
+}
+
+// isSniffNA reports whether a cell is one of the default null markers.
+func isSniffNA(cell string) bool {
+	cell = strings.TrimSpace(cell)
+	for _, na := range core.DefaultNAValues {
+		if cell == na {
+			return true
+		}
+	}
+	return false
+}
+
+// isNumber reports whether a cell parses as a number.
+func isNumber(cell string) bool {
+	_, err := strconv.ParseFloat(strings.TrimSpace(cell), 64)
+	return err == nil
+}
@@ -0,0 +1,132 @@
+package csv
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func TestSniffDialect(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    Dialect
+	}{
+		{
+			name: "tab",
+			content: "id\tname\tscore\n" +
+				"1\tAda, Countess\t9.5\n" +
+				"2\tGrace\t8\n" +
+				"3\tLinus\t7.25\n",
+			want: Dialect{Delimiter: '\t', Quote: '"', Header: true},
+		},
+		{
+			name: "semicolon",
+			content: "city;population;area\n" +
+				"\"Paris; France\";2148000;105,4\n" +
+				"Lyon;513000;47,87\n" +
+				"Nice;342000;71,92\n",
+			want: Dialect{Delimiter: ';', Quote: '"', Header: true},
+		},
+		{
+			name:    "pipe without header",
+			content: "1|2.5|3\r\n4|5.5|6\r\n7|8.5|9\r\n",
+			want:    Dialect{Delimiter: '|', Quote: '"', Header: false},
+		},
+		{
+			name:    "single quotes",
+			content: "'a','b'\n'x,1','y'\n'z','w'\n",
+			want:    Dialect{Delimiter: ',', Quote: '\'', Header: true},
+		},
+		{
+			// Quotes after '|' do not open fields when ',' is the delimiter
+			name: "quotes after another delimiter",
+			content: "name,note\n" +
+				"\"Ann, B\",x|'a|'b|'c\n" +
+				"\"Cy, D\",y|'d|'e|'f\n",
+			want: Dialect{Delimiter: ',', Quote: '"', Header: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SniffDialect(writeFile(t, "data.csv", tt.content))
+			if err != nil {
+				t.Fatalf("SniffDialect() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("SniffDialect() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSniffDialectReadCSV(t *testing.T) {
+	path := writeFile(t, "scores.tsv", "id\tscore\n1\t9.5\n2\t8\n3\t\n")
+
+	d, err := SniffDialect(path)
+	if err != nil {
+		t.Fatalf("SniffDialect() error = %v", err)
+	}
+	df, err := ReadCSV(path, WithDialect(d))
+	if err != nil {
+		t.Fatalf("ReadCSV() error = %v", err)
+	}
+	if cols := df.Columns(); len(cols) != 2 || cols[0] != "id" || cols[1] != "score" {
+		t.Errorf("columns = %v, want [id score]", cols)
+	}
+	if df.Nrows() != 3 {
+		t.Errorf("rows = %d, want 3", df.Nrows())
+	}
+	score, _ := df.Column("score")
+	if score.Dtype() != core.DtypeFloat64 || !score.IsNull(2) {
+		t.Errorf("score should be float64 with a null at 2, got %v", score.Dtype())
+	}
+}
+
+func TestSniffDialectErrors(t *testing.T) {
+	if _, err := SniffDialect(writeFile(t, "empty.csv", "\n\n")); !errors.Is(err, io.EOF) {
+		t.Errorf("expected io.EOF, got %v", err)
+	}
+	if _, err := SniffDialect("missing.csv"); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+	_, err := ReadCSVFrom(nil, WithDialect(Dialect{Delimiter: ',', Quote: ','}))
+	if !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument, got %v", err)
+	}
+}
+
+func TestSniffDialectReadCSVSingleQuotes(t *testing.T) {
+	path := writeFile(t, "quoted.csv", "'name','note'\n"+
+		"'Smith, J','it''s \"fine\"'\n"+
+		"\"quoted\",'a\nb'\n")
+
+	d, err := SniffDialect(path)
+	if err != nil {
+		t.Fatalf("SniffDialect() error = %v", err)
+	}
+	if d.Quote != '\'' {
+		t.Fatalf("Quote = %q, want '\\''", d.Quote)
+	}
+	df, err := ReadCSV(path, WithDialect(d))
+	if err != nil {
+		t.Fatalf("ReadCSV() error = %v", err)
+	}
+	if cols := df.Columns(); len(cols) != 2 || cols[0] != "name" || cols[1] != "note" {
+		t.Fatalf("columns = %v, want [name note]", cols)
+	}
+
+	name, _ := df.Column("name")
+	note, _ := df.Column("note")
+	for i, want := range [][2]string{{"Smith, J", `it's "fine"`}, {`"quoted"`, "a\nb"}} {
+		if got, _ := name.Get(i); got != want[0] {
+			t.Errorf("name[%d] = %q, want %q", i, got, want[0])
+		}
+		if got, _ := note.Get(i); got != want[1] {
+			t.Errorf("note[%d] = %q, want %q", i, got, want[1])
+		}
+	}
+}
@@ -1,19 +1,37 @@
 package csv
 
 import (
+	"bytes"
 	"encoding/csv"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	"strconv"
 
+	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/internal/bitset"
+	"github.com/TIVerse/GopherData/internal/parallel"
 )
 
-// CSVWriter writes DataFrames to CSV files.
+// CSVWriter writes DataFrames as CSV.
 type CSVWriter struct {
-	path      string
 	delimiter rune
 	header    bool
 	naValue   string
+	parallel  int // goroutines formatting rows; serial if below 2
+}
+
+// writeBlockRows is how many rows a parallel writer formats per block.
+const writeBlockRows = 4096
+
+// writeColumn is a snapshot of a column's values and null mask, so rows
+// can be formatted without locking the Series for every cell.
+type writeColumn struct {
+	data  []any
+	nulls *bitset.BitSet // nil if the column has no nulls
 }
 
 // WithWriteDelimiter sets the field delimiter for writing.
@@ -25,9 +43,38 @@ func WithWriteDelimiter(delim rune) CSVOption {
 	}
 }
 
-// WithWriteHeader specifies whether to write column names as the first row.
+// WithWriteHeader specifies whether to write column names as the first row
+// (default: true). When appending to a file that already has content, the
+// header is never repeated.
 func WithWriteHeader(header bool) CSVOption {
 	return func(r *CSVReader) error {
+		r.writeHeader = header
+		return nil
+	}
+}
+
+// WithAppend makes WriteCSV add rows to the end of an existing file instead
+// of replacing it, so a long-running job can write its results in batches.
+// A missing or empty file is created and gets a header as usual; a file
+// with content gets rows only, after its first record is checked against
+// the DataFrame's columns (see WithTrustColumns). The first record must be
+// the column names in order, or, with WithWriteHeader(false), have one
+// field per column.
+//
+// WriteCSVTo cannot see what w already holds, so for it WithAppend only
+// leaves out the header.
+func WithAppend(appendMode bool) CSVOption {
+	return func(r *CSVReader) error {
+		r.appendMode = appendMode
+		return nil
+	}
+}
+
+// WithTrustColumns skips the check WithAppend makes that the existing file
+// has the DataFrame's columns, for when the caller guarantees it.
+func WithTrustColumns(trust bool) CSVOption {
+	return func(r *CSVReader) error {
+		r.trustColumns = trust
 		return nil
 	}
 }
@@ -39,34 +86,123 @@ func WithNAValue(naValue string) CSVOption {
 	}
 }
 
-// WriteCSV writes a DataFrame to a CSV file.
+// WriteCSV writes a DataFrame to a CSV file, replacing it unless
+// WithAppend is set.
 func WriteCSV(df *dataframe.DataFrame, path string, opts ...CSVOption) error {
-	writer := &CSVWriter{
-		path:      path,
-		delimiter: ',',
-		header:    true,
-		naValue:   "",
+	config, err := newCSVReader(opts)
+	if err != nil {
+		return err
 	}
+	writer := newWriter(config)
 
-	// Apply options (would need separate writer options in production)
-	// For now, use defaults
+	flag := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if config.appendMode {
+		flag = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+		first, err := firstRecord(path, writer.delimiter)
+		if err != nil {
+			return err
+		}
+		if first != nil && !config.trustColumns {
+			if err := checkAppendColumns(first, df.Columns(), config.writeHeader); err != nil {
+				return err
+			}
+		}
+		writer.header = writer.header && first == nil
+	}
 
-	return writer.write(df)
+	file, err := os.OpenFile(path, flag, 0o666)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+
+	if err := writer.write(df, file); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
 }
 
-// write performs the actual CSV writing.
-func (w *CSVWriter) write(df *dataframe.DataFrame) error {
-	file, err := os.Create(w.path)
+// WriteCSVTo writes a DataFrame as CSV to w, such as an HTTP response, a
+// compression writer or a bytes.Buffer. It does not close w.
+func WriteCSVTo(df *dataframe.DataFrame, w io.Writer, opts ...CSVOption) error {
+	config, err := newCSVReader(opts)
 	if err != nil {
-		return fmt.Errorf("failed to create file: %w", err)
+		return err
+	}
+	writer := newWriter(config)
+	if config.appendMode {
+		writer.header = false
+	}
+
+	return writer.write(df, w)
+}
+
+// newWriter returns a CSVWriter with the writer settings of config.
+// Only WithParallel, WithWriteHeader and WithAppend apply to writing for
+// now; the other options configure reading.
+func newWriter(config *CSVReader) *CSVWriter {
+	return &CSVWriter{
+		delimiter: ',',
+		header:    config.writeHeader,
+		naValue:   "",
+		parallel:  config.parallel,
+	}
+}
+
+// firstRecord returns the first record of the CSV file at path, or nil if
+// the file does not exist or is empty.
+func firstRecord(path string, delimiter rune) ([]string, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
 	}
 	defer func() { _ = file.Close() }()
 
-	csvWriter := csv.NewWriter(file)
-	csvWriter.Comma = w.delimiter
-	defer csvWriter.Flush()
+	csvReader := csv.NewReader(file)
+	csvReader.Comma = delimiter
+	csvReader.FieldsPerRecord = -1
+	record, err := csvReader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read existing header: %w", err)
+	}
+	return record, nil
+}
+
+// checkAppendColumns checks that the first record of a file being appended
+// to matches columns: the same names in order if the file has a header, or
+// else the same number of fields.
+func checkAppendColumns(first, columns []string, header bool) error {
+	if len(first) != len(columns) {
+		return fmt.Errorf("file has %d columns, DataFrame has %d: %w", len(first), len(columns), core.ErrInvalidShape)
+	}
+	if !header {
+		return nil
+	}
+	for j, name := range first {
+		if name != columns[j] {
+			return fmt.Errorf("file has column %q at position %d, DataFrame has %q: %w", name, j, columns[j], core.ErrInvalidShape)
+		}
+	}
+	return nil
+}
 
+// write performs the actual CSV writing.
+func (w *CSVWriter) write(df *dataframe.DataFrame, out io.Writer) error {
 	columns := df.Columns()
+	cols := make([]writeColumn, len(columns))
+	for j, col := range columns {
+		s, _ := df.Column(col)
+		cols[j] = writeColumn{data: s.Data(), nulls: s.NullMask()}
+	}
+	nrows, _ := df.Shape()
+
+	csvWriter := w.newCSVWriter(out)
 
 	// Write header
 	if w.header {
@@ -75,16 +211,121 @@ func (w *CSVWriter) write(df *dataframe.DataFrame) error {
 		}
 	}
 
-	// Write rows
-	nrows, _ := df.Shape()
-	for i := 0; i < nrows; i++ {
-		record := make([]string, len(columns))
-		for j, col := range columns {
-			s, _ := df.Column(col)
-			val, ok := s.Get(i)
-			if !ok {
+	// Formatting goroutines come from the shared parallelism budget; with
+	// none to spare the rows are written serially.
+	if w.parallel > 1 && nrows > writeBlockRows {
+		if formatters := parallel.Acquire(w.parallel); formatters > 0 {
+			defer parallel.Release(formatters)
+			csvWriter.Flush()
+			if err := csvWriter.Error(); err != nil {
+				return fmt.Errorf("failed to write CSV: %w", err)
+			}
+			return w.writeParallel(cols, nrows, out, formatters)
+		}
+	}
+
+	if err := w.writeRows(csvWriter, cols, 0, nrows); err != nil {
+		return err
+	}
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+// writeParallel formats blocks of writeBlockRows rows on formatters
+// goroutines and writes them to out in order. At most two blocks per
+// goroutine are held in memory at once. Each block is formatted by its own
+// csv.Writer, which quotes every record on its own, so the output is the
+// same as writing serially.
+func (w *CSVWriter) writeParallel(cols []writeColumn, nrows int, out io.Writer, formatters int) error {
+	type block struct {
+		data []byte
+		err  error
+	}
+
+	nblocks := (nrows + writeBlockRows - 1) / writeBlockRows
+	results := make([]chan block, nblocks)
+	for k := range results {
+		results[k] = make(chan block, 1)
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	// window limits how far formatting may run ahead of writing
+	window := make(chan struct{}, 2*formatters)
+	jobs := make(chan int)
+	go func() {
+		defer close(jobs)
+		for k := 0; k < nblocks; k++ {
+			select {
+			case window <- struct{}{}:
+			case <-done:
+				return
+			}
+			select {
+			case jobs <- k:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < formatters; i++ {
+		go func() {
+			for k := range jobs {
+				start := k * writeBlockRows
+				end := min(start+writeBlockRows, nrows)
+
+				var buf bytes.Buffer
+				csvWriter := w.newCSVWriter(&buf)
+				err := w.writeRows(csvWriter, cols, start, end)
+				if err == nil {
+					csvWriter.Flush()
+					err = csvWriter.Error()
+				}
+				results[k] <- block{data: buf.Bytes(), err: err}
+			}
+		}()
+	}
+
+	for k := range results {
+		b := <-results[k]
+		if b.err != nil {
+			return b.err
+		}
+		if _, err := out.Write(b.data); err != nil {
+			return fmt.Errorf("failed to write CSV: %w", err)
+		}
+		<-window
+	}
+	return nil
+}
+
+// newCSVWriter returns a csv.Writer to out configured like w.
+func (w *CSVWriter) newCSVWriter(out io.Writer) *csv.Writer {
+	csvWriter := csv.NewWriter(out)
+	csvWriter.Comma = w.delimiter
+	return csvWriter
+}
+
+// writeRows writes rows [start, end) of cols as CSV records.
+func (w *CSVWriter) writeRows(csvWriter *csv.Writer, cols []writeColumn, start, end int) error {
+	record := make([]string, len(cols))
+	for i := start; i < end; i++ {
+		for j, col := range cols {
+			if col.nulls != nil && col.nulls.Test(i) {
 				record[j] = w.naValue
-			} else {
+				continue
+			}
+			switch val := col.data[i].(type) {
+			case string:
+				record[j] = val
+			case int64:
+				record[j] = strconv.FormatInt(val, 10)
+			default:
 				record[j] = fmt.Sprintf("%v", val)
 			}
 		}
@@ -93,7 +334,6 @@ func (w *CSVWriter) write(df *dataframe.DataFrame) error {
 			return fmt.Errorf("failed to write row %d: %w", i, err)
 		}
 	}
-
 	return nil
 }
 
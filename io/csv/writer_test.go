@@ -0,0 +1,186 @@
+package csv
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+)
+
+func TestWriteCSVTo(t *testing.T) {
+	df, _ := dataframe.New(map[string]any{
+		"name":  []string{"a", "b, c", "d"},
+		"score": []float64{1.5, 2, 3.25},
+	})
+	df = df.Select("name", "score")
+	score, _ := df.Column("score")
+	score.SetNull(1)
+
+	var buf bytes.Buffer
+	if err := WriteCSVTo(df, &buf); err != nil {
+		t.Fatalf("WriteCSVTo() error = %v", err)
+	}
+
+	want := "name,score\na,1.5\n\"b, c\",\nd,3.25\n"
+	if got := buf.String(); got != want {
+		t.Errorf("WriteCSVTo() wrote %q, want %q", got, want)
+	}
+
+	path := filepath.Join(t.TempDir(), "out.csv")
+	if err := WriteCSV(df, path); err != nil {
+		t.Fatalf("WriteCSV() error = %v", err)
+	}
+	fromFile, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(fromFile, buf.Bytes()) {
+		t.Errorf("WriteCSV() wrote %q, WriteCSVTo() wrote %q", fromFile, buf.Bytes())
+	}
+}
+
+func TestWriteCSVAppend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.csv")
+	batch := func(ids []int64, scores []float64) *dataframe.DataFrame {
+		df, _ := dataframe.New(map[string]any{"id": ids, "score": scores})
+		return df.Select("id", "score")
+	}
+
+	// The first batch creates the file with a header, later ones add rows
+	if err := WriteCSV(batch([]int64{1, 2}, []float64{0.5, 1.5}), path, WithAppend(true)); err != nil {
+		t.Fatalf("first WriteCSV() error = %v", err)
+	}
+	if err := WriteCSV(batch([]int64{3}, []float64{2.5}), path, WithAppend(true)); err != nil {
+		t.Fatalf("second WriteCSV() error = %v", err)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "id,score\n1,0.5\n2,1.5\n3,2.5\n"; string(got) != want {
+		t.Errorf("appended file = %q, want %q", got, want)
+	}
+
+	df, err := ReadCSV(path)
+	if err != nil {
+		t.Fatalf("ReadCSV() error = %v", err)
+	}
+	if df.Nrows() != 3 {
+		t.Errorf("read back %d rows, want 3", df.Nrows())
+	}
+
+	// Columns in another order are refused unless trusted
+	swapped := batch([]int64{4}, []float64{3.5}).Select("score", "id")
+	if err := WriteCSV(swapped, path, WithAppend(true)); !errors.Is(err, core.ErrInvalidShape) {
+		t.Errorf("expected ErrInvalidShape, got %v", err)
+	}
+	if err := WriteCSV(swapped, path, WithAppend(true), WithTrustColumns(true)); err != nil {
+		t.Errorf("trusted WriteCSV() error = %v", err)
+	}
+
+	// A headerless file is checked by column count only
+	bare := filepath.Join(t.TempDir(), "bare.csv")
+	for i := 0; i < 2; i++ {
+		if err := WriteCSV(batch([]int64{int64(i)}, []float64{1}), bare, WithAppend(true), WithWriteHeader(false)); err != nil {
+			t.Fatalf("headerless WriteCSV() error = %v", err)
+		}
+	}
+	if got, _ := os.ReadFile(bare); string(got) != "0,1\n1,1\n" {
+		t.Errorf("headerless file = %q", got)
+	}
+	wide, _ := dataframe.New(map[string]any{"a": []int64{1}, "b": []int64{2}, "c": []int64{3}})
+	if err := WriteCSV(wide, bare, WithAppend(true), WithWriteHeader(false)); !errors.Is(err, core.ErrInvalidShape) {
+		t.Errorf("expected ErrInvalidShape, got %v", err)
+	}
+
+	// The io.Writer variant leaves the header out
+	var buf bytes.Buffer
+	if err := WriteCSVTo(batch([]int64{5}, []float64{4.5}), &buf, WithAppend(true)); err != nil {
+		t.Fatalf("WriteCSVTo() error = %v", err)
+	}
+	if buf.String() != "5,4.5\n" {
+		t.Errorf("WriteCSVTo() wrote %q, want %q", buf.String(), "5,4.5\n")
+	}
+}
+
+// writeTestFrame returns a frame of n rows with values that need quoting
+// and a few nulls.
+func writeTestFrame(n int) *dataframe.DataFrame {
+	ids := make([]int64, n)
+	prices := make([]float64, n)
+	names := make([]string, n)
+	for i := 0; i < n; i++ {
+		ids[i] = int64(i)
+		prices[i] = float64(i%1000) + 0.25
+		names[i] = fmt.Sprintf("item %d, \"q\"\nline", i)
+	}
+	df, _ := dataframe.New(map[string]any{"id": ids, "price": prices, "name": names})
+	df = df.Select("id", "price", "name")
+	price, _ := df.Column("price")
+	for i := 0; i < n; i += 997 {
+		price.SetNull(i)
+	}
+	return df
+}
+
+func TestWriteCSVParallelMatchesSerial(t *testing.T) {
+	// Leave room for formatters even on a single CPU
+	core.SetParallelism(8)
+	defer core.SetParallelism(0)
+
+	df := writeTestFrame(3*writeBlockRows + 17)
+
+	var serial bytes.Buffer
+	if err := WriteCSVTo(df, &serial); err != nil {
+		t.Fatalf("serial WriteCSVTo() error = %v", err)
+	}
+	for _, n := range []int{2, 4, 8} {
+		var parallel bytes.Buffer
+		if err := WriteCSVTo(df, &parallel, WithParallel(n)); err != nil {
+			t.Fatalf("WriteCSVTo(WithParallel(%d)) error = %v", n, err)
+		}
+		if !bytes.Equal(parallel.Bytes(), serial.Bytes()) {
+			t.Errorf("WithParallel(%d) wrote %d bytes differing from the serial %d bytes",
+				n, parallel.Len(), serial.Len())
+		}
+	}
+}
+
+func BenchmarkWriteCSV(b *testing.B) {
+	df := writeTestFrame(200000)
+	for _, n := range []int{1, 4} {
+		b.Run(fmt.Sprintf("parallel=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if err := WriteCSVTo(df, io.Discard, WithParallel(n)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestWriteCSVParallelismOne(t *testing.T) {
+	df := writeTestFrame(3*writeBlockRows + 17)
+
+	var serial bytes.Buffer
+	if err := WriteCSVTo(df, &serial); err != nil {
+		t.Fatalf("serial WriteCSVTo() error = %v", err)
+	}
+
+	core.SetParallelism(1)
+	defer core.SetParallelism(0)
+	var limited bytes.Buffer
+	if err := WriteCSVTo(df, &limited, WithParallel(8)); err != nil {
+		t.Fatalf("WriteCSVTo(WithParallel(8)) error = %v", err)
+	}
+	if !bytes.Equal(limited.Bytes(), serial.Bytes()) {
+		t.Error("output with a parallelism of 1 differs from the serial output")
+	}
+}
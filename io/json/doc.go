@@ -20,4 +20,23 @@
 //
 //	// JSONL format
 //	df, err := json.ReadJSON("data.jsonl", json.Lines())
+//
+//	// Read from any io.Reader, e.g. an HTTP response body
+//	df, err = json.ReadJSONFrom(resp.Body)
+//
+//	// Write to any io.Writer, e.g. an HTTP response
+//	err = json.WriteJSONTo(df, w, json.Lines())
+//
+// Files too large for memory can be streamed in chunks with StreamRecords,
+// or StreamRecordsFrom for an io.Reader:
+//
+//	stream, err := json.StreamRecords("huge.json", json.ChunkSize(50000))
+//	defer stream.Close()
+//	for {
+//	    chunk, err := stream.Next()
+//	    if err == io.EOF {
+//	        break
+//	    }
+//	    // process chunk
+//	}
 package json
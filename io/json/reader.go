@@ -5,16 +5,21 @@ import (
 	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/internal/progress"
 )
 
 // JSONReader reads JSON files into DataFrames.
 type JSONReader struct {
-	path   string
 	orient string // "records" or "columns"
 	lines  bool   // JSONL format (one record per line)
+
+	chunkSize int // Records per chunk when streaming
+
+	progress func(bytesRead, totalBytes int64)
 }
 
 // JSONOption is a functional option for configuring JSONReader.
@@ -41,10 +46,39 @@ func Lines() JSONOption {
 	}
 }
 
+// WithProgress sets a callback that reports how much of the input has been
+// read, for ReadJSON and the streaming readers. It is called at most once
+// every 256KiB and once at the end of the input, so it may do cheap work
+// such as redrawing a progress bar. totalBytes is the file size, or -1 for
+// an io.Reader of unknown size.
+func WithProgress(fn func(bytesRead, totalBytes int64)) JSONOption {
+	return func(r *JSONReader) error {
+		r.progress = fn
+		return nil
+	}
+}
+
 // ReadJSON reads a JSON file and returns a DataFrame.
 func ReadJSON(path string, opts ...JSONOption) (*dataframe.DataFrame, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	return readJSON(file, progress.FileSize(file), opts)
+}
+
+// ReadJSONFrom reads JSON data from r, such as an HTTP response body or a
+// strings.Reader, and returns a DataFrame. It does not close r.
+func ReadJSONFrom(r io.Reader, opts ...JSONOption) (*dataframe.DataFrame, error) {
+	return readJSON(r, -1, opts)
+}
+
+// readJSON applies opts and reads from in, whose size is total (-1 if
+// unknown).
+func readJSON(in io.Reader, total int64, opts []JSONOption) (*dataframe.DataFrame, error) {
 	reader := &JSONReader{
-		path:   path,
 		orient: "records", // Default
 		lines:  false,
 	}
@@ -56,33 +90,35 @@ func ReadJSON(path string, opts ...JSONOption) (*dataframe.DataFrame, error) {
 		}
 	}
 
-	return reader.read()
+	return reader.read(reader.track(in, total))
 }
 
-// read performs the actual JSON reading.
-func (r *JSONReader) read() (*dataframe.DataFrame, error) {
-	file, err := os.Open(r.path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open file: %w", err)
+// track wraps in to report progress, if a callback is set.
+func (r *JSONReader) track(in io.Reader, total int64) io.Reader {
+	if r.progress == nil {
+		return in
 	}
-	defer func() { _ = file.Close() }()
+	return progress.NewReader(in, total, r.progress)
+}
 
+// read performs the actual JSON reading.
+func (r *JSONReader) read(in io.Reader) (*dataframe.DataFrame, error) {
 	if r.lines {
-		return r.readJSONLines(file)
+		return r.readJSONLines(in)
 	}
 
 	if r.orient == "records" {
-		return r.readRecords(file)
+		return r.readRecords(in)
 	}
 
-	return r.readColumns(file)
+	return r.readColumns(in)
 }
 
 // readRecords reads JSON in records format: [{"col": val, ...}, ...]
-func (r *JSONReader) readRecords(file *os.File) (*dataframe.DataFrame, error) {
+func (r *JSONReader) readRecords(in io.Reader) (*dataframe.DataFrame, error) {
 	var records []map[string]any
 	
-	decoder := json.NewDecoder(file)
+	decoder := json.NewDecoder(in)
 	if err := decoder.Decode(&records); err != nil {
 		return nil, fmt.Errorf("failed to decode JSON: %w", err)
 	}
@@ -95,10 +131,10 @@ func (r *JSONReader) readRecords(file *os.File) (*dataframe.DataFrame, error) {
 }
 
 // readColumns reads JSON in columns format: {"col": [val, ...], ...}
-func (r *JSONReader) readColumns(file *os.File) (*dataframe.DataFrame, error) {
+func (r *JSONReader) readColumns(in io.Reader) (*dataframe.DataFrame, error) {
 	var data map[string][]any
 	
-	decoder := json.NewDecoder(file)
+	decoder := json.NewDecoder(in)
 	if err := decoder.Decode(&data); err != nil {
 		return nil, fmt.Errorf("failed to decode JSON: %w", err)
 	}
@@ -113,10 +149,10 @@ func (r *JSONReader) readColumns(file *os.File) (*dataframe.DataFrame, error) {
 }
 
 // readJSONLines reads JSONL format (one record per line).
-func (r *JSONReader) readJSONLines(file *os.File) (*dataframe.DataFrame, error) {
+func (r *JSONReader) readJSONLines(in io.Reader) (*dataframe.DataFrame, error) {
 	var records []map[string]any
 	
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(in)
 	
 	for scanner.Scan() {
 		line := scanner.Bytes()
@@ -133,7 +169,7 @@ func (r *JSONReader) readJSONLines(file *os.File) (*dataframe.DataFrame, error)
 	}
 
 	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("error reading file: %w", err)
+		return nil, fmt.Errorf("error reading JSON lines: %w", err)
 	}
 
 	if len(records) == 0 {
@@ -0,0 +1,61 @@
+package json
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestReadJSONFrom(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		opts  []JSONOption
+	}{
+		{"records", `[{"x": 1, "y": "a"}, {"x": 2, "y": "b"}]`, nil},
+		{"columns", `{"x": [1, 2], "y": ["a", "b"]}`, []JSONOption{Orient("columns")}},
+		{"lines", "{\"x\": 1, \"y\": \"a\"}\n{\"x\": 2, \"y\": \"b\"}\n", []JSONOption{Lines()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			df, err := ReadJSONFrom(strings.NewReader(tt.input), tt.opts...)
+			if err != nil {
+				t.Fatalf("ReadJSONFrom() error = %v", err)
+			}
+			if rows, cols := df.Shape(); rows != 2 || cols != 2 {
+				t.Errorf("shape = (%d, %d), want (2, 2)", rows, cols)
+			}
+			y, err := df.Column("y")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if val, _ := y.Get(1); val != "b" {
+				t.Errorf("y[1] = %v, want b", val)
+			}
+		})
+	}
+}
+
+func TestStreamRecordsFrom(t *testing.T) {
+	stream, err := StreamRecordsFrom(strings.NewReader(`[{"x": 1}, {"x": 2}, {"x": 3}]`), ChunkSize(2))
+	if err != nil {
+		t.Fatalf("StreamRecordsFrom() error = %v", err)
+	}
+	defer func() { _ = stream.Close() }()
+
+	var sizes []int
+	for {
+		chunk, err := stream.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+		sizes = append(sizes, chunk.Nrows())
+	}
+	if len(sizes) != 2 || sizes[0] != 2 || sizes[1] != 1 {
+		t.Errorf("chunk sizes = %v, want [2 1]", sizes)
+	}
+}
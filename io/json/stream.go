@@ -0,0 +1,314 @@
+package json
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/internal/bitset"
+	"github.com/TIVerse/GopherData/internal/progress"
+	"github.com/TIVerse/GopherData/series"
+)
+
+// defaultChunkSize is the number of records per chunk when streaming.
+const defaultChunkSize = 10000
+
+// ChunkSize sets the number of records in each DataFrame returned by a
+// RecordStream. The default is 10000.
+func ChunkSize(n int) JSONOption {
+	return func(r *JSONReader) error {
+		if n <= 0 {
+			return fmt.Errorf("invalid chunk size %d: must be positive", n)
+		}
+		r.chunkSize = n
+		return nil
+	}
+}
+
+// RecordStream reads a JSON array of records (or a JSONL file) one record at
+// a time and returns it as a sequence of DataFrame chunks, so files larger
+// than memory can be processed.
+//
+// Columns are the union of the keys seen so far, in order of first
+// appearance. A column first seen in a later chunk is absent from earlier
+// chunks; within a chunk, records without a key hold null. Each column's
+// dtype is fixed by its first non-null value in the stream and kept for
+// every chunk, so a column whose values have all been null so far is also
+// absent until a chunk holds a value for it. Later values of another type
+// are converted to that dtype where possible (numbers and booleans are
+// formatted in a String column, numeric strings parsed in a Float64 column)
+// and otherwise become null.
+type RecordStream struct {
+	file      *os.File // nil if the caller owns the reader
+	decoder   *json.Decoder
+	chunkSize int
+	lines     bool
+	columns   []string
+	dtypes    map[string]core.Dtype
+	done      bool
+}
+
+// StreamRecords opens a JSON file for streaming. The file must hold an array
+// of objects, or one object per line when Lines is given. Only the "records"
+// orientation can be streamed. The caller must Close the stream.
+//
+// Example:
+//
+//	stream, err := json.StreamRecords("huge.json", json.ChunkSize(50000))
+//	if err != nil {
+//	    return err
+//	}
+//	defer stream.Close()
+//	for {
+//	    chunk, err := stream.Next()
+//	    if err == io.EOF {
+//	        break
+//	    }
+//	    if err != nil {
+//	        return err
+//	    }
+//	    // process chunk
+//	}
+func StreamRecords(path string, opts ...JSONOption) (*RecordStream, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	stream, err := streamRecords(file, progress.FileSize(file), opts)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	stream.file = file
+	return stream, nil
+}
+
+// StreamRecordsFrom streams records from r, as StreamRecords does for a
+// file. Closing the stream does not close r.
+func StreamRecordsFrom(r io.Reader, opts ...JSONOption) (*RecordStream, error) {
+	return streamRecords(r, -1, opts)
+}
+
+// streamRecords applies opts and starts a stream over in, whose size is
+// total (-1 if unknown).
+func streamRecords(in io.Reader, total int64, opts []JSONOption) (*RecordStream, error) {
+	reader := &JSONReader{
+		orient:    "records",
+		chunkSize: defaultChunkSize,
+	}
+
+	for _, opt := range opts {
+		if err := opt(reader); err != nil {
+			return nil, err
+		}
+	}
+
+	if reader.orient != "records" {
+		return nil, fmt.Errorf("cannot stream orient %q: only 'records' is supported", reader.orient)
+	}
+
+	stream := &RecordStream{
+		decoder:   json.NewDecoder(reader.track(in, total)),
+		chunkSize: reader.chunkSize,
+		lines:     reader.lines,
+		dtypes:    make(map[string]core.Dtype),
+	}
+
+	if !reader.lines {
+		tok, err := stream.decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode JSON: %w", err)
+		}
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			return nil, fmt.Errorf("failed to decode JSON: expected array of records, got %v", tok)
+		}
+	}
+
+	return stream, nil
+}
+
+// Next returns the next chunk of at most the configured chunk size.
+// It returns io.EOF when no records remain.
+func (s *RecordStream) Next() (*dataframe.DataFrame, error) {
+	if s.done {
+		return nil, io.EOF
+	}
+
+	records := make([]map[string]any, 0, s.chunkSize)
+	for len(records) < s.chunkSize && s.decoder.More() {
+		record, err := s.decodeRecord()
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	if !s.decoder.More() {
+		s.done = true
+		if !s.lines {
+			if tok, err := s.decoder.Token(); err != nil || tok != json.Delim(']') {
+				return nil, fmt.Errorf("failed to decode JSON: unterminated array of records")
+			}
+		}
+	}
+
+	if len(records) == 0 {
+		return nil, io.EOF
+	}
+
+	return s.buildChunk(records)
+}
+
+// decodeRecord reads one JSON object, adding its unseen keys to the columns
+// in the order they appear in the object.
+func (s *RecordStream) decodeRecord() (map[string]any, error) {
+	tok, err := s.decoder.Token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode JSON record: %w", err)
+	}
+	if tok != json.Delim('{') {
+		return nil, fmt.Errorf("failed to decode JSON record: expected object, got %v", tok)
+	}
+
+	record := make(map[string]any)
+	for s.decoder.More() {
+		tok, err := s.decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode JSON record: %w", err)
+		}
+		key := tok.(string) // Object keys are always strings
+
+		var val any
+		if err := s.decoder.Decode(&val); err != nil {
+			return nil, fmt.Errorf("failed to decode JSON value for %q: %w", key, err)
+		}
+		record[key] = val
+
+		if _, seen := s.dtypes[key]; !seen {
+			s.columns = append(s.columns, key)
+			s.dtypes[key] = dtypeUnknown
+		}
+		if s.dtypes[key] == dtypeUnknown && val != nil {
+			s.dtypes[key] = jsonDtype(val)
+		}
+	}
+
+	// Closing brace
+	if _, err := s.decoder.Token(); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON record: %w", err)
+	}
+
+	return record, nil
+}
+
+// Close ends the stream and closes the file opened by StreamRecords.
+func (s *RecordStream) Close() error {
+	s.done = true
+	if s.file == nil {
+		return nil
+	}
+	return s.file.Close()
+}
+
+// Columns returns the columns seen so far, in order of first appearance.
+func (s *RecordStream) Columns() []string {
+	result := make([]string, len(s.columns))
+	copy(result, s.columns)
+	return result
+}
+
+// buildChunk converts records to a DataFrame with every column seen so far.
+func (s *RecordStream) buildChunk(records []map[string]any) (*dataframe.DataFrame, error) {
+	if len(s.columns) == 0 {
+		return nil, errors.New("failed to decode JSON: records have no fields")
+	}
+
+	n := len(records)
+	df, err := dataframe.New(map[string]any{s.columns[0]: make([]any, n)})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, col := range s.columns {
+		dtype := s.dtypes[col]
+		if dtype == dtypeUnknown {
+			continue // All null so far
+		}
+
+		data := make([]any, n)
+		var nullMask *bitset.BitSet
+		for i, record := range records {
+			val, ok := coerceJSON(record[col], dtype)
+			if !ok {
+				if nullMask == nil {
+					nullMask = bitset.New(n)
+				}
+				nullMask.Set(i)
+				continue
+			}
+			data[i] = val
+		}
+
+		df = df.WithColumn(col, series.NewWithNulls(col, data, dtype, nullMask))
+	}
+
+	// Drop the placeholder column if it has no dtype yet
+	if s.dtypes[s.columns[0]] == dtypeUnknown {
+		df = df.Drop(s.columns[0])
+	}
+
+	return df, nil
+}
+
+// dtypeUnknown marks a column whose values have all been null so far.
+const dtypeUnknown core.Dtype = -1
+
+// jsonDtype maps a decoded JSON value to a Dtype. JSON numbers decode to
+// float64; objects and arrays are kept as-is in a String column.
+func jsonDtype(val any) core.Dtype {
+	switch val.(type) {
+	case float64:
+		return core.DtypeFloat64
+	case bool:
+		return core.DtypeBool
+	default:
+		return core.DtypeString
+	}
+}
+
+// coerceJSON converts a decoded JSON value to dtype, reporting false if it
+// is null or cannot be converted.
+func coerceJSON(val any, dtype core.Dtype) (any, bool) {
+	if val == nil {
+		return nil, false
+	}
+
+	switch dtype {
+	case core.DtypeFloat64:
+		switch v := val.(type) {
+		case float64:
+			return v, true
+		case string:
+			f, err := strconv.ParseFloat(v, 64)
+			return f, err == nil
+		}
+		return nil, false
+	case core.DtypeBool:
+		v, ok := val.(bool)
+		return v, ok
+	default:
+		switch v := val.(type) {
+		case float64:
+			return strconv.FormatFloat(v, 'g', -1, 64), true
+		case bool:
+			return strconv.FormatBool(v), true
+		}
+		return val, true
+	}
+}
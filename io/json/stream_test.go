@@ -0,0 +1,284 @@
+package json
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestStreamRecordsLarge(t *testing.T) {
+	const nrecords = 200000
+	const chunkSize = 1000
+
+	path := filepath.Join(t.TempDir(), "large.json")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := bufio.NewWriter(file)
+	_, _ = w.WriteString("[\n")
+	for i := 0; i < nrecords; i++ {
+		if i > 0 {
+			_, _ = w.WriteString(",\n")
+		}
+		fmt.Fprintf(w, `{"id": %d, "name": "record-%d", "score": %d.5, "active": %t}`, i, i, i%100, i%2 == 0)
+	}
+	_, _ = w.WriteString("\n]\n")
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	_ = file.Close()
+
+	info, _ := os.Stat(path)
+
+	stream, err := StreamRecords(path, ChunkSize(chunkSize))
+	if err != nil {
+		t.Fatalf("StreamRecords() error = %v", err)
+	}
+	defer func() { _ = stream.Close() }()
+
+	var before runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	total := 0
+	chunks := 0
+	var peak uint64
+	for {
+		chunk, err := stream.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+		if chunk.Nrows() > chunkSize {
+			t.Fatalf("chunk has %d rows, want at most %d", chunk.Nrows(), chunkSize)
+		}
+		total += chunk.Nrows()
+		chunks++
+
+		if chunks%20 == 0 {
+			var m runtime.MemStats
+			runtime.GC()
+			runtime.ReadMemStats(&m)
+			if m.HeapAlloc > peak {
+				peak = m.HeapAlloc
+			}
+		}
+	}
+
+	if total != nrecords {
+		t.Errorf("streamed %d rows, want %d", total, nrecords)
+	}
+	if chunks != nrecords/chunkSize {
+		t.Errorf("got %d chunks, want %d", chunks, nrecords/chunkSize)
+	}
+
+	// Only one chunk is live at a time, so the heap should stay far below
+	// the size of the file.
+	if peak > before.HeapAlloc && peak-before.HeapAlloc > uint64(info.Size())/4 {
+		t.Errorf("heap grew by %d bytes while streaming a %d byte file", peak-before.HeapAlloc, info.Size())
+	}
+
+	if _, err := stream.Next(); err != io.EOF {
+		t.Errorf("Next() after end error = %v, want io.EOF", err)
+	}
+}
+
+func TestStreamRecordsProgress(t *testing.T) {
+	var sb strings.Builder
+	for i := 0; i < 50000; i++ {
+		fmt.Fprintf(&sb, "{\"id\": %d, \"name\": \"record-%d\"}\n", i, i)
+	}
+	path := writeFile(t, "progress.jsonl", sb.String())
+
+	var reads []int64
+	stream, err := StreamRecords(path, Lines(), WithProgress(func(read, total int64) {
+		if total != int64(sb.Len()) {
+			t.Errorf("total = %d, want %d", total, sb.Len())
+		}
+		reads = append(reads, read)
+	}))
+	if err != nil {
+		t.Fatalf("StreamRecords() error = %v", err)
+	}
+	defer func() { _ = stream.Close() }()
+
+	for {
+		if _, err := stream.Next(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+	}
+
+	if len(reads) < 2 {
+		t.Fatalf("got %d progress calls, want several", len(reads))
+	}
+	for i := 1; i < len(reads); i++ {
+		if reads[i] <= reads[i-1] {
+			t.Errorf("progress went from %d to %d bytes", reads[i-1], reads[i])
+		}
+	}
+	if last := reads[len(reads)-1]; last != int64(sb.Len()) {
+		t.Errorf("last progress = %d, want %d", last, sb.Len())
+	}
+}
+
+func TestStreamRecordsHeterogeneous(t *testing.T) {
+	path := writeFile(t, "mixed.json", `[
+		{"a": 1, "b": "x"},
+		{"a": 2},
+		{"b": "z", "c": true},
+		{"a": null, "c": false}
+	]`)
+
+	stream, err := StreamRecords(path, ChunkSize(2))
+	if err != nil {
+		t.Fatalf("StreamRecords() error = %v", err)
+	}
+	defer func() { _ = stream.Close() }()
+
+	first, err := stream.Next()
+	if err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+	if cols := first.Columns(); len(cols) != 2 || cols[0] != "a" || cols[1] != "b" {
+		t.Errorf("first chunk columns = %v, want [a b]", cols)
+	}
+	b, _ := first.Column("b")
+	if !b.IsNull(1) {
+		t.Error("missing key b in record 1 should be null")
+	}
+
+	second, err := stream.Next()
+	if err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+	cols := second.Columns()
+	if len(cols) != 3 || cols[0] != "a" || cols[1] != "b" || cols[2] != "c" {
+		t.Fatalf("second chunk columns = %v, want [a b c]", cols)
+	}
+
+	// Column a is all null in this chunk but keeps the dtype of the first chunk
+	a, _ := second.Column("a")
+	if a.Dtype() != core.DtypeFloat64 {
+		t.Errorf("column a dtype = %v, want float64", a.Dtype())
+	}
+	if !a.IsNull(0) || !a.IsNull(1) {
+		t.Error("column a should be null in both records")
+	}
+	c, _ := second.Column("c")
+	if c.Dtype() != core.DtypeBool {
+		t.Errorf("column c dtype = %v, want bool", c.Dtype())
+	}
+
+	if _, err := stream.Next(); err != io.EOF {
+		t.Errorf("Next() after end error = %v, want io.EOF", err)
+	}
+}
+
+func TestStreamRecordsStableDtypes(t *testing.T) {
+	path := writeFile(t, "late.json", `[
+		{"id": 1, "score": null},
+		{"id": 2, "score": null},
+		{"id": "3", "score": 0.5},
+		{"id": "n/a", "score": "high"}
+	]`)
+
+	stream, err := StreamRecords(path, ChunkSize(2))
+	if err != nil {
+		t.Fatalf("StreamRecords() error = %v", err)
+	}
+	defer func() { _ = stream.Close() }()
+
+	// score has no value yet, so it is left out rather than typed String
+	first, err := stream.Next()
+	if err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+	if cols := first.Columns(); len(cols) != 1 || cols[0] != "id" {
+		t.Errorf("first chunk columns = %v, want [id]", cols)
+	}
+
+	second, err := stream.Next()
+	if err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+	id, _ := second.Column("id")
+	if id.Dtype() != core.DtypeFloat64 {
+		t.Fatalf("id dtype = %v, want float64 from the first chunk", id.Dtype())
+	}
+	if v, ok := id.Get(0); !ok || v != 3.0 {
+		t.Errorf("id[0] = %v, want 3 parsed from \"3\"", v)
+	}
+	if !id.IsNull(1) {
+		t.Error("id[1] = \"n/a\" should become null in a float64 column")
+	}
+
+	score, _ := second.Column("score")
+	if score.Dtype() != core.DtypeFloat64 {
+		t.Errorf("score dtype = %v, want float64", score.Dtype())
+	}
+	if !score.IsNull(1) {
+		t.Error("score[1] = \"high\" should become null in a float64 column")
+	}
+}
+
+func TestStreamRecordsLines(t *testing.T) {
+	path := writeFile(t, "data.jsonl", "{\"x\": 1}\n{\"x\": 2}\n\n{\"x\": 3}\n")
+
+	stream, err := StreamRecords(path, Lines(), ChunkSize(10))
+	if err != nil {
+		t.Fatalf("StreamRecords() error = %v", err)
+	}
+	defer func() { _ = stream.Close() }()
+
+	chunk, err := stream.Next()
+	if err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+	if chunk.Nrows() != 3 {
+		t.Errorf("got %d rows, want 3", chunk.Nrows())
+	}
+}
+
+func TestStreamRecordsErrors(t *testing.T) {
+	if _, err := StreamRecords(writeFile(t, "obj.json", `{"a": [1, 2]}`)); err == nil {
+		t.Error("expected error for a non-array document")
+	}
+
+	if _, err := StreamRecords(writeFile(t, "x.json", `[]`), Orient("columns")); err == nil {
+		t.Error("expected error for columns orientation")
+	}
+
+	if _, err := StreamRecords(writeFile(t, "x.json", `[]`), ChunkSize(0)); err == nil {
+		t.Error("expected error for zero chunk size")
+	}
+
+	stream, err := StreamRecords(writeFile(t, "truncated.json", `[{"a": 1}, {"a": 2}`))
+	if err != nil {
+		t.Fatalf("StreamRecords() error = %v", err)
+	}
+	defer func() { _ = stream.Close() }()
+	if _, err := stream.Next(); err == nil {
+		t.Error("expected error for an unterminated array")
+	}
+}
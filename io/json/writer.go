@@ -4,14 +4,14 @@ import (
 	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/TIVerse/GopherData/dataframe"
 )
 
-// JSONWriter writes DataFrames to JSON files.
+// JSONWriter writes DataFrames as JSON.
 type JSONWriter struct {
-	path   string
 	orient string // "records" or "columns"
 	lines  bool   // JSONL format
 	indent bool   // Pretty print
@@ -19,8 +19,22 @@ type JSONWriter struct {
 
 // WriteJSON writes a DataFrame to a JSON file.
 func WriteJSON(df *dataframe.DataFrame, path string, opts ...JSONOption) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+
+	if err := WriteJSONTo(df, file, opts...); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// WriteJSONTo writes a DataFrame as JSON to w, such as an HTTP response, a
+// compression writer or a bytes.Buffer. It does not close w.
+func WriteJSONTo(df *dataframe.DataFrame, w io.Writer, opts ...JSONOption) error {
 	writer := &JSONWriter{
-		path:   path,
 		orient: "records",
 		lines:  false,
 		indent: false,
@@ -38,7 +52,7 @@ func WriteJSON(df *dataframe.DataFrame, path string, opts ...JSONOption) error {
 	writer.orient = reader.orient
 	writer.lines = reader.lines
 
-	return writer.write(df)
+	return writer.write(df, w)
 }
 
 // WithIndent enables pretty-printing.
@@ -51,26 +65,20 @@ func WithIndent() JSONOption {
 }
 
 // write performs the actual JSON writing.
-func (w *JSONWriter) write(df *dataframe.DataFrame) error {
-	file, err := os.Create(w.path)
-	if err != nil {
-		return fmt.Errorf("failed to create file: %w", err)
-	}
-	defer func() { _ = file.Close() }()
-
+func (w *JSONWriter) write(df *dataframe.DataFrame, out io.Writer) error {
 	if w.lines {
-		return w.writeJSONLines(df, file)
+		return w.writeJSONLines(df, out)
 	}
 
 	if w.orient == "records" {
-		return w.writeRecords(df, file)
+		return w.writeRecords(df, out)
 	}
 
-	return w.writeColumns(df, file)
+	return w.writeColumns(df, out)
 }
 
 // writeRecords writes JSON in records format: [{"col": val, ...}, ...]
-func (w *JSONWriter) writeRecords(df *dataframe.DataFrame, file *os.File) error {
+func (w *JSONWriter) writeRecords(df *dataframe.DataFrame, out io.Writer) error {
 	nrows, _ := df.Shape()
 	cols := df.Columns()
 	
@@ -92,7 +100,7 @@ func (w *JSONWriter) writeRecords(df *dataframe.DataFrame, file *os.File) error
 		records[i] = record
 	}
 
-	encoder := json.NewEncoder(file)
+	encoder := json.NewEncoder(out)
 	if w.indent {
 		encoder.SetIndent("", "  ")
 	}
@@ -105,7 +113,7 @@ func (w *JSONWriter) writeRecords(df *dataframe.DataFrame, file *os.File) error
 }
 
 // writeColumns writes JSON in columns format: {"col": [val, ...], ...}
-func (w *JSONWriter) writeColumns(df *dataframe.DataFrame, file *os.File) error {
+func (w *JSONWriter) writeColumns(df *dataframe.DataFrame, out io.Writer) error {
 	nrows, _ := df.Shape()
 	cols := df.Columns()
 	
@@ -127,7 +135,7 @@ func (w *JSONWriter) writeColumns(df *dataframe.DataFrame, file *os.File) error
 		data[col] = values
 	}
 
-	encoder := json.NewEncoder(file)
+	encoder := json.NewEncoder(out)
 	if w.indent {
 		encoder.SetIndent("", "  ")
 	}
@@ -140,12 +148,11 @@ func (w *JSONWriter) writeColumns(df *dataframe.DataFrame, file *os.File) error
 }
 
 // writeJSONLines writes JSONL format (one record per line).
-func (w *JSONWriter) writeJSONLines(df *dataframe.DataFrame, file *os.File) error {
+func (w *JSONWriter) writeJSONLines(df *dataframe.DataFrame, out io.Writer) error {
 	nrows, _ := df.Shape()
 	cols := df.Columns()
 	
-	writer := bufio.NewWriter(file)
-	defer func() { _ = writer.Flush() }()
+	writer := bufio.NewWriter(out)
 
 	for i := 0; i < nrows; i++ {
 		record := make(map[string]any)
@@ -174,6 +181,9 @@ func (w *JSONWriter) writeJSONLines(df *dataframe.DataFrame, file *os.File) erro
 		}
 	}
 
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("failed to write JSON lines: %w", err)
+	}
 	return nil
 }
 
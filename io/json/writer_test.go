@@ -0,0 +1,53 @@
+package json
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TIVerse/GopherData/dataframe"
+)
+
+func TestWriteJSONTo(t *testing.T) {
+	df, _ := dataframe.New(map[string]any{
+		"id":   []int64{1, 2},
+		"name": []string{"x", "y"},
+	})
+	name, _ := df.Column("name")
+	name.SetNull(1)
+
+	tests := []struct {
+		label string
+		opts  []JSONOption
+		want  string
+	}{
+		{"records", nil, `[{"id":1,"name":"x"},{"id":2,"name":null}]` + "\n"},
+		{"columns", []JSONOption{Orient("columns")}, `{"id":[1,2],"name":["x",null]}` + "\n"},
+		{"lines", []JSONOption{Lines()}, `{"id":1,"name":"x"}` + "\n" + `{"id":2,"name":null}` + "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteJSONTo(df, &buf, tt.opts...); err != nil {
+				t.Fatalf("WriteJSONTo() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("WriteJSONTo() wrote %q, want %q", got, tt.want)
+			}
+
+			path := filepath.Join(t.TempDir(), "out.json")
+			if err := WriteJSON(df, path, tt.opts...); err != nil {
+				t.Fatalf("WriteJSON() error = %v", err)
+			}
+			fromFile, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(fromFile, buf.Bytes()) {
+				t.Errorf("WriteJSON() wrote %q, WriteJSONTo() wrote %q", fromFile, buf.Bytes())
+			}
+		})
+	}
+}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/models/internal/modelutil"
 	seriesPkg "github.com/TIVerse/GopherData/series"
+	"gonum.org/v1/gonum/mat"
 )
 
 // KMeans implements the K-Means clustering algorithm.
@@ -29,6 +31,9 @@ type KMeans struct {
 	// Seed for random number generator
 	Seed int64
 	
+	// featureNames stores the names of features seen during Fit
+	featureNames []string
+	
 	// centers stores the cluster centroids
 	centers [][]float64
 	
@@ -70,7 +75,7 @@ func NewKMeans(nClusters int, maxIter int, init string, seed int64) *KMeans {
 // Fit trains the K-Means model on data X.
 func (km *KMeans) Fit(X *dataframe.DataFrame) error {
 	// Extract features
-	features, err := extractNumericFeatures(X)
+	features, names, err := modelutil.ExtractFeatures(X, nil)
 	if err != nil {
 		return err
 	}
@@ -103,7 +108,7 @@ func (km *KMeans) Fit(X *dataframe.DataFrame) error {
 			bestCluster := 0
 			
 			for j, center := range km.centers {
-				dist := euclideanDistance(point, center)
+				dist := EuclideanDistance(point, center)
 				if dist < minDist {
 					minDist = dist
 					bestCluster = j
@@ -141,7 +146,7 @@ func (km *KMeans) Fit(X *dataframe.DataFrame) error {
 				}
 				
 				// Calculate shift
-				shift := euclideanDistance(km.centers[j], newCenters[j])
+				shift := EuclideanDistance(km.centers[j], newCenters[j])
 				if shift > maxShift {
 					maxShift = shift
 				}
@@ -161,10 +166,11 @@ func (km *KMeans) Fit(X *dataframe.DataFrame) error {
 	km.inertia = 0
 	for i, point := range features {
 		cluster := km.labels[i]
-		dist := euclideanDistance(point, km.centers[cluster])
+		dist := EuclideanDistance(point, km.centers[cluster])
 		km.inertia += dist * dist
 	}
 	
+	km.featureNames = names
 	km.fitted = true
 	return nil
 }
@@ -175,7 +181,7 @@ func (km *KMeans) Predict(X *dataframe.DataFrame) (*seriesPkg.Series[any], error
 		return nil, fmt.Errorf("model not fitted yet")
 	}
 	
-	features, err := extractNumericFeatures(X)
+	features, _, err := modelutil.ExtractFeatures(X, km.featureNames)
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +193,7 @@ func (km *KMeans) Predict(X *dataframe.DataFrame) (*seriesPkg.Series[any], error
 		bestCluster := 0
 		
 		for j, center := range km.centers {
-			dist := euclideanDistance(point, center)
+			dist := EuclideanDistance(point, center)
 			if dist < minDist {
 				minDist = dist
 				bestCluster = j
@@ -200,6 +206,33 @@ func (km *KMeans) Predict(X *dataframe.DataFrame) (*seriesPkg.Series[any], error
 	return seriesPkg.New("cluster", labels, core.DtypeInt64), nil
 }
 
+// Transform returns the distance from each sample of X to each cluster
+// center, in columns named cluster_0, cluster_1, and so on.
+func (km *KMeans) Transform(X *dataframe.DataFrame) (*dataframe.DataFrame, error) {
+	if !km.fitted {
+		return nil, fmt.Errorf("model not fitted yet")
+	}
+	
+	features, _, err := modelutil.ExtractFeatures(X, km.featureNames)
+	if err != nil {
+		return nil, err
+	}
+	
+	k := len(km.centers)
+	distances := make([]float64, len(features)*k)
+	for i, point := range features {
+		for j, center := range km.centers {
+			distances[i*k+j] = EuclideanDistance(point, center)
+		}
+	}
+	
+	names := make([]string, k)
+	for j := range names {
+		names[j] = fmt.Sprintf("cluster_%d", j)
+	}
+	return dataframe.FromMatrix(mat.NewDense(len(features), k, distances), names)
+}
+
 // FitPredict fits the model and returns cluster labels.
 func (km *KMeans) FitPredict(X *dataframe.DataFrame) (*seriesPkg.Series[any], error) {
 	err := km.Fit(X)
@@ -250,7 +283,7 @@ func (km *KMeans) initKMeansPlusPlus(features [][]float64, rng *rand.Rand) [][]f
 		for j, point := range features {
 			minDist := math.MaxFloat64
 			for k := 0; k < i; k++ {
-				dist := euclideanDistance(point, centers[k])
+				dist := EuclideanDistance(point, centers[k])
 				if dist < minDist {
 					minDist = dist
 				}
@@ -298,66 +331,9 @@ func (km *KMeans) initRandom(features [][]float64, rng *rand.Rand) [][]float64 {
 
 // Helper functions
 
-func extractNumericFeatures(X *dataframe.DataFrame) ([][]float64, error) {
-	n := X.Nrows()
-	cols := X.Columns()
-	
-	// Filter numeric columns
-	numericCols := make([]string, 0)
-	for _, col := range cols {
-		series, err := X.Column(col)
-		if err != nil {
-			continue
-		}
-		if isNumericDtype(series.Dtype()) {
-			numericCols = append(numericCols, col)
-		}
-	}
-	
-	if len(numericCols) == 0 {
-		return nil, fmt.Errorf("no numeric columns found")
-	}
-	
-	features := make([][]float64, n)
-	for i := range features {
-		features[i] = make([]float64, len(numericCols))
-	}
-	
-	for j, col := range numericCols {
-		series, _ := X.Column(col)
-		for i := 0; i < n; i++ {
-			val, ok := series.Get(i)
-			if ok && val != nil {
-				features[i][j] = toFloat64Cluster(val)
-			}
-		}
-	}
-	
-	return features, nil
-}
-
-func isNumericDtype(dtype core.Dtype) bool {
-	return dtype == core.DtypeFloat64 || dtype == core.DtypeInt64
-}
-
-func toFloat64Cluster(val any) float64 {
-	switch v := val.(type) {
-	case float64:
-		return v
-	case float32:
-		return float64(v)
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	case int32:
-		return float64(v)
-	default:
-		return 0
-	}
-}
-
-func euclideanDistance(p1, p2 []float64) float64 {
+// EuclideanDistance returns the straight-line distance between two points,
+// or math.MaxFloat64 if they have different dimensions.
+func EuclideanDistance(p1, p2 []float64) float64 {
 	if len(p1) != len(p2) {
 		return math.MaxFloat64
 	}
@@ -1,6 +1,7 @@
 package cluster
 
 import (
+	"math"
 	"testing"
 
 	"github.com/TIVerse/GopherData/dataframe"
@@ -139,3 +140,44 @@ func TestKMeansInvalidInput(t *testing.T) {
 		t.Error("Expected error for n_clusters > n_samples")
 	}
 }
+
+func TestKMeansTransform(t *testing.T) {
+	X, _ := dataframe.New(map[string]any{
+		"x": []float64{0, 0, 10, 10},
+		"y": []float64{0, 2, 0, 2},
+	})
+	
+	model := NewKMeans(2, 100, "k-means++", 1)
+	if err := model.Fit(X); err != nil {
+		t.Fatalf("Fit failed: %v", err)
+	}
+	
+	distances, err := model.Transform(X)
+	if err != nil {
+		t.Fatalf("Transform failed: %v", err)
+	}
+	if cols := distances.Columns(); len(cols) != 2 || cols[0] != "cluster_0" || cols[1] != "cluster_1" {
+		t.Fatalf("columns = %v, want [cluster_0 cluster_1]", cols)
+	}
+	
+	// Each point is 1 from its own center (x, 1) and sqrt(101) from the other.
+	labels, _ := model.Predict(X)
+	for i := 0; i < X.Nrows(); i++ {
+		label, _ := labels.Get(i)
+		for j, col := range distances.Columns() {
+			s, _ := distances.Column(col)
+			got, _ := s.Get(i)
+			want := math.Sqrt(101)
+			if int64(j) == label.(int64) {
+				want = 1
+			}
+			if math.Abs(got.(float64)-want) > 1e-9 {
+				t.Errorf("row %d %s = %v, want %v", i, col, got, want)
+			}
+		}
+	}
+	
+	if _, err := NewKMeans(2, 100, "random", 1).Transform(X); err == nil {
+		t.Error("expected an error transforming with an unfitted model")
+	}
+}
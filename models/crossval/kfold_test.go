@@ -1,10 +1,11 @@
-package crossval
+package crossval_test
 
 import (
 	"testing"
 
 	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/models/crossval"
 	"github.com/TIVerse/GopherData/models/linear"
 	seriesPkg "github.com/TIVerse/GopherData/series"
 )
@@ -15,7 +16,7 @@ func TestKFoldSplit(t *testing.T) {
 	}
 	df, _ := dataframe.New(data)
 	
-	kf := NewKFold(5, false, 42)
+	kf := crossval.NewKFold(5, false, 42)
 	folds := kf.Split(df)
 	
 	if len(folds) != 5 {
@@ -48,14 +49,14 @@ func TestKFoldShuffle(t *testing.T) {
 	}
 	df, _ := dataframe.New(data)
 	
-	kf1 := NewKFold(3, false, 42)
+	kf1 := crossval.NewKFold(3, false, 42)
 	folds1 := kf1.Split(df)
 	
-	kf2 := NewKFold(3, true, 42)
+	kf2 := crossval.NewKFold(3, true, 42)
 	folds2 := kf2.Split(df)
 	
 	// With same seed, shuffled splits should be deterministic
-	kf3 := NewKFold(3, true, 42)
+	kf3 := crossval.NewKFold(3, true, 42)
 	folds3 := kf3.Split(df)
 	
 	// Check that shuffled folds are reproducible
@@ -81,7 +82,7 @@ func TestCrossValScore(t *testing.T) {
 	model := linear.NewLinearRegression(true)
 	
 	// 3-fold cross-validation
-	kf := NewKFold(3, false, 42)
+	kf := crossval.NewKFold(3, false, 42)
 	
 	// Scoring function (R² score)
 	scoringFunc := func(yTrue, yPred *seriesPkg.Series[any]) float64 {
@@ -92,7 +93,8 @@ func TestCrossValScore(t *testing.T) {
 		// Calculate mean
 		for i := 0; i < yTrue.Len(); i++ {
 			val, _ := yTrue.Get(i)
-			mean += toFloat64Test(val)
+			f, _ := core.ToFloat64(val)
+			mean += f
 		}
 		mean /= float64(yTrue.Len())
 		
@@ -101,8 +103,8 @@ func TestCrossValScore(t *testing.T) {
 			trueVal, _ := yTrue.Get(i)
 			predVal, _ := yPred.Get(i)
 			
-			tv := toFloat64Test(trueVal)
-			pv := toFloat64Test(predVal)
+			tv, _ := core.ToFloat64(trueVal)
+			pv, _ := core.ToFloat64(predVal)
 			
 			ssRes += (tv - pv) * (tv - pv)
 			ssTot += (tv - mean) * (tv - mean)
@@ -114,7 +116,7 @@ func TestCrossValScore(t *testing.T) {
 		return 1 - (ssRes / ssTot)
 	}
 	
-	scores, err := CrossValScore(model, X, y, kf, scoringFunc)
+	scores, err := crossval.CrossValScore(model, X, y, kf, scoringFunc)
 	if err != nil {
 		t.Fatalf("CrossValScore failed: %v", err)
 	}
@@ -132,17 +134,3 @@ func TestCrossValScore(t *testing.T) {
 	}
 }
 
-func toFloat64Test(val any) float64 {
-	switch v := val.(type) {
-	case float64:
-		return v
-	case float32:
-		return float64(v)
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	default:
-		return 0
-	}
-}
@@ -4,8 +4,8 @@ package decomposition
 import (
 	"fmt"
 
-	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/models/internal/modelutil"
 	"gonum.org/v1/gonum/mat"
 )
 
@@ -50,7 +50,7 @@ func NewPCA(nComponents int) *PCA {
 // Fit learns the principal components from data X.
 func (pca *PCA) Fit(X *dataframe.DataFrame) error {
 	// Extract features
-	features, names, err := extractFeaturesDecomp(X)
+	features, names, err := modelutil.ExtractFeatures(X, nil)
 	if err != nil {
 		return err
 	}
@@ -155,7 +155,7 @@ func (pca *PCA) Transform(X *dataframe.DataFrame) (*dataframe.DataFrame, error)
 		return nil, fmt.Errorf("model not fitted yet")
 	}
 	
-	features, _, err := extractFeaturesDecomp(X)
+	features, _, err := modelutil.ExtractFeatures(X, pca.featureNames)
 	if err != nil {
 		return nil, err
 	}
@@ -229,61 +229,3 @@ func (pca *PCA) Components() [][]float64 {
 
 // Helper functions
 
-func extractFeaturesDecomp(X *dataframe.DataFrame) ([][]float64, []string, error) {
-	n := X.Nrows()
-	cols := X.Columns()
-	
-	// Filter numeric columns
-	numericCols := make([]string, 0)
-	for _, col := range cols {
-		series, err := X.Column(col)
-		if err != nil {
-			continue
-		}
-		if isNumericDtypeDecomp(series.Dtype()) {
-			numericCols = append(numericCols, col)
-		}
-	}
-	
-	if len(numericCols) == 0 {
-		return nil, nil, fmt.Errorf("no numeric columns found")
-	}
-	
-	features := make([][]float64, n)
-	for i := range features {
-		features[i] = make([]float64, len(numericCols))
-	}
-	
-	for j, col := range numericCols {
-		series, _ := X.Column(col)
-		for i := 0; i < n; i++ {
-			val, ok := series.Get(i)
-			if ok && val != nil {
-				features[i][j] = toFloat64Decomp(val)
-			}
-		}
-	}
-	
-	return features, numericCols, nil
-}
-
-func isNumericDtypeDecomp(dtype core.Dtype) bool {
-	return dtype == core.DtypeFloat64 || dtype == core.DtypeInt64
-}
-
-func toFloat64Decomp(val any) float64 {
-	switch v := val.(type) {
-	case float64:
-		return v
-	case float32:
-		return float64(v)
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	case int32:
-		return float64(v)
-	default:
-		return 0
-	}
-}
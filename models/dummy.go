@@ -0,0 +1,262 @@
+package models
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+	seriesPkg "github.com/TIVerse/GopherData/series"
+)
+
+// DummyClassifier makes predictions that ignore the input features.
+// It provides a baseline to compare real classifiers against.
+type DummyClassifier struct {
+	// Strategy: "most_frequent", "stratified", or "uniform"
+	Strategy string
+
+	// Seed for random number generator (stratified and uniform strategies)
+	Seed int64
+
+	// classes stores the unique class labels in sorted order
+	classes []string
+
+	// priors stores the class frequencies observed during Fit
+	priors []float64
+
+	// fitted indicates whether the model has been fitted
+	fitted bool
+}
+
+// NewDummyClassifier creates a new baseline classifier.
+// Strategy "most_frequent" always predicts the majority class, "stratified"
+// draws labels at random according to the class priors, and "uniform" draws
+// labels uniformly at random.
+func NewDummyClassifier(strategy string) *DummyClassifier {
+	if strategy == "" {
+		strategy = "most_frequent"
+	}
+
+	return &DummyClassifier{
+		Strategy: strategy,
+		fitted:   false,
+	}
+}
+
+// Fit records the class labels and their frequencies in y. X is only used to
+// validate the number of samples.
+func (dc *DummyClassifier) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[any]) error {
+	switch dc.Strategy {
+	case "most_frequent", "stratified", "uniform":
+	default:
+		return fmt.Errorf("unknown strategy: %s", dc.Strategy)
+	}
+
+	if X.Nrows() != y.Len() {
+		return fmt.Errorf("x and y must have same length")
+	}
+
+	counts := make(map[string]int)
+	for i := 0; i < y.Len(); i++ {
+		val, ok := y.Get(i)
+		if !ok || val == nil {
+			return fmt.Errorf("target contains null at index %d", i)
+		}
+		counts[fmt.Sprint(val)]++
+	}
+
+	if len(counts) == 0 {
+		return core.ErrEmptySeries
+	}
+
+	dc.classes = make([]string, 0, len(counts))
+	for class := range counts {
+		dc.classes = append(dc.classes, class)
+	}
+	sort.Strings(dc.classes)
+
+	dc.priors = make([]float64, len(dc.classes))
+	for k, class := range dc.classes {
+		dc.priors[k] = float64(counts[class]) / float64(y.Len())
+	}
+
+	dc.fitted = true
+	return nil
+}
+
+// Predict returns one baseline prediction per row of X.
+func (dc *DummyClassifier) Predict(X *dataframe.DataFrame) (*seriesPkg.Series[any], error) {
+	if !dc.fitted {
+		return nil, fmt.Errorf("model not fitted yet")
+	}
+
+	n := X.Nrows()
+	predictions := make([]any, n)
+	rng := rand.New(rand.NewSource(dc.Seed))
+
+	for i := 0; i < n; i++ {
+		switch dc.Strategy {
+		case "most_frequent":
+			predictions[i] = dc.classes[dc.majorityClass()]
+		case "stratified":
+			predictions[i] = dc.classes[sampleIndex(dc.priors, rng.Float64())]
+		case "uniform":
+			predictions[i] = dc.classes[rng.Intn(len(dc.classes))]
+		}
+	}
+
+	return seriesPkg.New("predictions", predictions, core.DtypeString), nil
+}
+
+// PredictProba returns the class probabilities implied by the strategy,
+// one column per class. Every row is identical.
+func (dc *DummyClassifier) PredictProba(X *dataframe.DataFrame) (*dataframe.DataFrame, error) {
+	if !dc.fitted {
+		return nil, fmt.Errorf("model not fitted yet")
+	}
+
+	proba := make([]float64, len(dc.classes))
+	switch dc.Strategy {
+	case "most_frequent":
+		proba[dc.majorityClass()] = 1
+	case "stratified":
+		copy(proba, dc.priors)
+	case "uniform":
+		for k := range proba {
+			proba[k] = 1 / float64(len(dc.classes))
+		}
+	}
+
+	n := X.Nrows()
+	data := make(map[string]any, len(dc.classes))
+	for k, class := range dc.classes {
+		col := make([]float64, n)
+		for i := range col {
+			col[i] = proba[k]
+		}
+		data[class] = col
+	}
+
+	return dataframe.New(data)
+}
+
+// Classes returns the unique class labels in sorted order.
+func (dc *DummyClassifier) Classes() []string {
+	return dc.classes
+}
+
+// majorityClass returns the index of the most frequent class.
+// Ties are broken by class order.
+func (dc *DummyClassifier) majorityClass() int {
+	best := 0
+	for k, p := range dc.priors {
+		if p > dc.priors[best] {
+			best = k
+		}
+	}
+	return best
+}
+
+// sampleIndex returns the index selected by u in [0, 1) under the
+// cumulative distribution of weights.
+func sampleIndex(weights []float64, u float64) int {
+	cumulative := 0.0
+	for k, w := range weights {
+		cumulative += w
+		if u < cumulative {
+			return k
+		}
+	}
+	return len(weights) - 1
+}
+
+// DummyRegressor predicts a constant that ignores the input features.
+// It provides a baseline to compare real regressors against.
+type DummyRegressor struct {
+	// Strategy: "mean" or "median"
+	Strategy string
+
+	// constant stores the value predicted for every sample
+	constant float64
+
+	// fitted indicates whether the model has been fitted
+	fitted bool
+}
+
+// NewDummyRegressor creates a new baseline regressor.
+// Strategy "mean" predicts the mean of the training target and "median"
+// predicts its median.
+func NewDummyRegressor(strategy string) *DummyRegressor {
+	if strategy == "" {
+		strategy = "mean"
+	}
+
+	return &DummyRegressor{
+		Strategy: strategy,
+		fitted:   false,
+	}
+}
+
+// Fit computes the constant prediction from y. X is only used to validate
+// the number of samples.
+func (dr *DummyRegressor) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[any]) error {
+	if X.Nrows() != y.Len() {
+		return fmt.Errorf("x and y must have same length")
+	}
+
+	values := make([]float64, 0, y.Len())
+	for i := 0; i < y.Len(); i++ {
+		val, ok := y.Get(i)
+		if !ok || val == nil {
+			return fmt.Errorf("target contains null at index %d", i)
+		}
+		f, _ := core.ToFloat64(val)
+		values = append(values, f)
+	}
+
+	if len(values) == 0 {
+		return core.ErrEmptySeries
+	}
+
+	switch dr.Strategy {
+	case "mean":
+		sum := 0.0
+		for _, v := range values {
+			sum += v
+		}
+		dr.constant = sum / float64(len(values))
+	case "median":
+		sort.Float64s(values)
+		mid := len(values) / 2
+		if len(values)%2 == 0 {
+			dr.constant = (values[mid-1] + values[mid]) / 2
+		} else {
+			dr.constant = values[mid]
+		}
+	default:
+		return fmt.Errorf("unknown strategy: %s", dr.Strategy)
+	}
+
+	dr.fitted = true
+	return nil
+}
+
+// Predict returns the constant prediction for every row of X.
+func (dr *DummyRegressor) Predict(X *dataframe.DataFrame) (*seriesPkg.Series[any], error) {
+	if !dr.fitted {
+		return nil, fmt.Errorf("model not fitted yet")
+	}
+
+	predictions := make([]any, X.Nrows())
+	for i := range predictions {
+		predictions[i] = dr.constant
+	}
+
+	return seriesPkg.New("predictions", predictions, core.DtypeFloat64), nil
+}
+
+// Constant returns the value predicted for every sample.
+func (dr *DummyRegressor) Constant() float64 {
+	return dr.constant
+}
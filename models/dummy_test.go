@@ -0,0 +1,131 @@
+package models
+
+import (
+	"math"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+	seriesPkg "github.com/TIVerse/GopherData/series"
+)
+
+func TestDummyClassifierMostFrequent(t *testing.T) {
+	X, _ := dataframe.New(map[string]any{
+		"x": []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+	})
+	y := seriesPkg.New("y", []any{"a", "b", "a", "a", "c", "a", "b", "a", "a", "c"}, core.DtypeString)
+
+	model := NewDummyClassifier("most_frequent")
+	if err := model.Fit(X, y); err != nil {
+		t.Fatalf("Fit failed: %v", err)
+	}
+
+	yPred, err := model.Predict(X)
+	if err != nil {
+		t.Fatalf("Predict failed: %v", err)
+	}
+
+	// Accuracy of the majority baseline equals the majority-class proportion
+	if acc := Accuracy(y, yPred); math.Abs(acc-0.6) > 1e-9 {
+		t.Errorf("Expected accuracy 0.6, got %f", acc)
+	}
+
+	proba, err := model.PredictProba(X)
+	if err != nil {
+		t.Fatalf("PredictProba failed: %v", err)
+	}
+	col, _ := proba.Column("a")
+	if val, _ := col.Get(0); val != 1.0 {
+		t.Errorf("Expected probability 1 for majority class, got %v", val)
+	}
+}
+
+func TestDummyClassifierRandomStrategies(t *testing.T) {
+	n := 1000
+	x := make([]float64, n)
+	labels := make([]any, n)
+	for i := range labels {
+		x[i] = float64(i)
+		labels[i] = "neg"
+		if i%4 == 0 {
+			labels[i] = "pos"
+		}
+	}
+	X, _ := dataframe.New(map[string]any{"x": x})
+	y := seriesPkg.New("y", labels, core.DtypeString)
+
+	tests := []struct {
+		strategy string
+		wantPos  float64
+	}{
+		{"stratified", 0.25},
+		{"uniform", 0.5},
+	}
+
+	for _, tt := range tests {
+		model := NewDummyClassifier(tt.strategy)
+		model.Seed = 42
+		if err := model.Fit(X, y); err != nil {
+			t.Fatalf("%s: Fit failed: %v", tt.strategy, err)
+		}
+
+		yPred, err := model.Predict(X)
+		if err != nil {
+			t.Fatalf("%s: Predict failed: %v", tt.strategy, err)
+		}
+
+		pos := 0
+		for i := 0; i < yPred.Len(); i++ {
+			if val, _ := yPred.Get(i); val == "pos" {
+				pos++
+			}
+		}
+		if frac := float64(pos) / float64(n); math.Abs(frac-tt.wantPos) > 0.05 {
+			t.Errorf("%s: expected ~%.2f positive predictions, got %.3f", tt.strategy, tt.wantPos, frac)
+		}
+	}
+
+	if err := NewDummyClassifier("bogus").Fit(X, y); err == nil {
+		t.Error("Expected error for unknown strategy")
+	}
+}
+
+func TestDummyRegressor(t *testing.T) {
+	X, _ := dataframe.New(map[string]any{
+		"x": []float64{1, 2, 3, 4, 5},
+	})
+	y := seriesPkg.New("y", []any{1.0, 2.0, 3.0, 4.0, 10.0}, core.DtypeFloat64)
+
+	tests := []struct {
+		strategy string
+		want     float64
+	}{
+		{"mean", 4.0},
+		{"median", 3.0},
+	}
+
+	for _, tt := range tests {
+		model := NewDummyRegressor(tt.strategy)
+		if err := model.Fit(X, y); err != nil {
+			t.Fatalf("%s: Fit failed: %v", tt.strategy, err)
+		}
+
+		yPred, err := model.Predict(X)
+		if err != nil {
+			t.Fatalf("%s: Predict failed: %v", tt.strategy, err)
+		}
+		for i := 0; i < yPred.Len(); i++ {
+			if val, _ := yPred.Get(i); val != tt.want {
+				t.Errorf("%s: expected %v at %d, got %v", tt.strategy, tt.want, i, val)
+			}
+		}
+	}
+
+	// The mean baseline scores an R² of exactly zero on its training data
+	model := NewDummyRegressor("mean")
+	_ = model.Fit(X, y)
+	yPred, _ := model.Predict(X)
+	if r2 := R2Score(y, yPred); math.Abs(r2) > 1e-9 {
+		t.Errorf("Expected R² of 0 for mean baseline, got %f", r2)
+	}
+}
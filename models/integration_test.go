@@ -1,11 +1,16 @@
 package models
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/internal/bitset"
+	"github.com/TIVerse/GopherData/models/cluster"
+	"github.com/TIVerse/GopherData/models/decomposition"
 	"github.com/TIVerse/GopherData/models/linear"
+	"github.com/TIVerse/GopherData/models/tree"
 	seriesPkg "github.com/TIVerse/GopherData/series"
 	"github.com/TIVerse/GopherData/stats"
 )
@@ -185,3 +190,29 @@ func TestMetricsComprehensive(t *testing.T) {
 	t.Logf("  MAE: %.4f", mae)
 	t.Logf("  R²: %.4f", r2)
 }
+
+// TestModelsRejectNullFeatures checks that every model refuses features with
+// nulls instead of silently reading them as 0.
+func TestModelsRejectNullFeatures(t *testing.T) {
+	nulls := bitset.New(6)
+	nulls.Set(2)
+	X, _ := dataframe.New(map[string]any{
+		"x1": []float64{1, 2, 3, 4, 5, 6},
+	})
+	X = X.WithColumn("x2", seriesPkg.NewWithNulls("x2", []any{1.0, 0.0, 0.0, 1.0, 0.0, 1.0}, core.DtypeFloat64, nulls))
+	yReg := seriesPkg.New("y", []any{1.0, 2.0, 3.0, 4.0, 5.0, 6.0}, core.DtypeFloat64)
+	yClf := seriesPkg.New("y", []any{"a", "a", "a", "b", "b", "b"}, core.DtypeString)
+
+	fits := map[string]func() error{
+		"LinearRegression":   func() error { return linear.NewLinearRegression(true).Fit(X, yReg) },
+		"LogisticRegression": func() error { return linear.NewLogisticRegression("l2", 1.0, 100).Fit(X, yClf) },
+		"DecisionTree":       func() error { return tree.NewDecisionTreeClassifier(3, 2, "gini").Fit(X, yClf) },
+		"KMeans":             func() error { return cluster.NewKMeans(2, 10, "k-means++", 1).Fit(X) },
+		"PCA":                func() error { return decomposition.NewPCA(1).Fit(X) },
+	}
+	for name, fit := range fits {
+		if err := fit(); !errors.Is(err, core.ErrNullValue) {
+			t.Errorf("%s: expected ErrNullValue, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/models/cluster"
+	"github.com/TIVerse/GopherData/models/decomposition"
+	"github.com/TIVerse/GopherData/models/linear"
+	"github.com/TIVerse/GopherData/models/neighbors"
+	"github.com/TIVerse/GopherData/models/tree"
+	seriesPkg "github.com/TIVerse/GopherData/series"
+)
+
+// predictor is the core.Predictor every supervised model implements.
+type predictor = core.Predictor[*dataframe.DataFrame, *seriesPkg.Series[any]]
+
+// transformer is the core.Transformer every unsupervised model implements.
+type transformer = core.Transformer[*dataframe.DataFrame]
+
+// Compile-time checks that the models conform to the core interfaces.
+var (
+	_ predictor = (*linear.LinearRegression)(nil)
+	_ predictor = (*linear.Ridge)(nil)
+	_ predictor = (*linear.Lasso)(nil)
+	_ predictor = (*linear.ElasticNet)(nil)
+	_ predictor = (*linear.RidgeCV)(nil)
+	_ predictor = (*linear.LassoCV)(nil)
+	_ predictor = (*linear.LogisticRegression)(nil)
+	_ predictor = (*tree.DecisionTree)(nil)
+	_ predictor = (*neighbors.KNeighborsClassifier)(nil)
+	_ predictor = (*neighbors.KNeighborsRegressor)(nil)
+	_ predictor = (*DummyClassifier)(nil)
+	_ predictor = (*DummyRegressor)(nil)
+
+	_ Classifier = (*linear.LogisticRegression)(nil)
+	_ Classifier = (*neighbors.KNeighborsClassifier)(nil)
+
+	_ transformer = (*cluster.KMeans)(nil)
+	_ transformer = (*decomposition.PCA)(nil)
+	_ Clusterer   = (*cluster.KMeans)(nil)
+	_ Transformer = (*decomposition.PCA)(nil)
+)
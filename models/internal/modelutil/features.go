@@ -0,0 +1,72 @@
+// Package modelutil holds helpers shared by the model packages.
+package modelutil
+
+import (
+	"fmt"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+)
+
+// ExtractFeatures returns the named columns of X as rows of float64. If
+// names is nil, every numeric column is used in order; a fitted model passes
+// its feature names instead, so X may have its columns in any order and
+// extra columns are ignored. Nulls are an error rather than being read as 0.
+func ExtractFeatures(X *dataframe.DataFrame, names []string) ([][]float64, []string, error) {
+	cols := names
+	if cols == nil {
+		cols = X.SelectDtypes(core.NumericDtypes(), nil).Columns()
+		if len(cols) == 0 {
+			return nil, nil, fmt.Errorf("no numeric columns found")
+		}
+	} else if err := CheckFeatureColumns(X, cols); err != nil {
+		return nil, nil, err
+	}
+
+	var nullCols []string
+	for _, col := range cols {
+		if s, _ := X.Column(col); s.NullCount() > 0 {
+			nullCols = append(nullCols, col)
+		}
+	}
+	if len(nullCols) > 0 {
+		return nil, nil, fmt.Errorf("features %q contain nulls; impute or drop them first: %w", nullCols, core.ErrNullValue)
+	}
+
+	n := X.Nrows()
+	if n == 0 {
+		return [][]float64{}, cols, nil
+	}
+	m, _, err := X.ToMatrix(cols...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Rows are views into the matrix's backing array
+	raw := m.RawMatrix()
+	features := make([][]float64, n)
+	for i := range features {
+		features[i] = raw.Data[i*raw.Stride : i*raw.Stride+len(cols) : i*raw.Stride+len(cols)]
+	}
+	return features, cols, nil
+}
+
+// CheckFeatureColumns returns an error if any of the named columns is
+// missing from X or not numeric.
+func CheckFeatureColumns(X *dataframe.DataFrame, names []string) error {
+	var missing []string
+	for _, col := range names {
+		s, err := X.Column(col)
+		if err != nil {
+			missing = append(missing, col)
+			continue
+		}
+		if !core.IsNumeric(s.Dtype()) {
+			return fmt.Errorf("feature %q has dtype %v: %w", col, s.Dtype(), core.ErrTypeMismatch)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("features %q missing from X: %w", missing, core.ErrColumnNotFound)
+	}
+	return nil
+}
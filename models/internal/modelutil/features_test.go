@@ -0,0 +1,51 @@
+package modelutil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+)
+
+func TestExtractFeatures(t *testing.T) {
+	X, _ := dataframe.New(map[string]any{
+		"a":     []float64{1, 2, 3},
+		"b":     []int64{4, 5, 6},
+		"label": []string{"x", "y", "z"},
+	})
+	X = X.Select("a", "label", "b")
+
+	features, names, err := ExtractFeatures(X, nil)
+	if err != nil {
+		t.Fatalf("ExtractFeatures failed: %v", err)
+	}
+	if len(names) != 2 || names[0] != "a" || names[1] != "b" {
+		t.Errorf("names = %v, want [a b]", names)
+	}
+	if len(features) != 3 || features[2][0] != 3 || features[2][1] != 6 {
+		t.Errorf("features = %v, want rows of [a b]", features)
+	}
+
+	// A fitted model's names pick the columns in its order
+	features, _, err = ExtractFeatures(X, []string{"b", "a"})
+	if err != nil {
+		t.Fatalf("ExtractFeatures failed: %v", err)
+	}
+	if features[0][0] != 4 || features[0][1] != 1 {
+		t.Errorf("row 0 = %v, want [4 1]", features[0])
+	}
+
+	if _, _, err := ExtractFeatures(X, []string{"a", "missing"}); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("expected ErrColumnNotFound, got %v", err)
+	}
+	if _, _, err := ExtractFeatures(X, []string{"label"}); !errors.Is(err, core.ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch, got %v", err)
+	}
+
+	a, _ := X.Column("a")
+	a.SetNull(1)
+	if _, _, err := ExtractFeatures(X, nil); !errors.Is(err, core.ErrNullValue) {
+		t.Errorf("expected ErrNullValue, got %v", err)
+	}
+}
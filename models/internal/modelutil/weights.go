@@ -0,0 +1,18 @@
+package modelutil
+
+// BalancedClassWeight returns n / (k * count) for each class, so every class
+// contributes equally regardless of its frequency.
+func BalancedClassWeight(labels []string) map[string]float64 {
+	counts := make(map[string]int)
+	for _, label := range labels {
+		counts[label]++
+	}
+
+	weights := make(map[string]float64, len(counts))
+	n := float64(len(labels))
+	k := float64(len(counts))
+	for label, count := range counts {
+		weights[label] = n / (k * float64(count))
+	}
+	return weights
+}
@@ -0,0 +1,15 @@
+package modelutil
+
+import "testing"
+
+func TestBalancedClassWeight(t *testing.T) {
+	weights := BalancedClassWeight([]string{"a", "a", "a", "b"})
+
+	// n / (k * count): 4 / (2 * 3) and 4 / (2 * 1)
+	if got := weights["a"]; got != 4.0/6 {
+		t.Errorf("weight of a = %v, want %v", got, 4.0/6)
+	}
+	if got := weights["b"]; got != 2 {
+		t.Errorf("weight of b = %v, want 2", got)
+	}
+}
@@ -0,0 +1,285 @@
+package linear
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/models/crossval"
+	seriesPkg "github.com/TIVerse/GopherData/series"
+)
+
+// regressor is the part of the model interface used by cross-validation.
+type regressor interface {
+	Fit(X *dataframe.DataFrame, y *seriesPkg.Series[any]) error
+	Predict(X *dataframe.DataFrame) (*seriesPkg.Series[any], error)
+}
+
+// RidgeCV is Ridge regression with Alpha chosen by K-fold cross-validation.
+// Fit scores every candidate alpha by its mean validation MSE over the
+// folds, then refits a Ridge model on all the data with the best one.
+// If several alphas tie, the first in Alphas wins.
+type RidgeCV struct {
+	// Alphas are the candidate regularization strengths.
+	Alphas []float64
+
+	// CV is the number of folds.
+	CV int
+
+	// FitIntercept determines whether to calculate the intercept.
+	FitIntercept bool
+
+	// model is the Ridge refit on all data with the chosen alpha
+	model *Ridge
+
+	// mse stores the mean validation MSE for each alpha
+	mse []float64
+}
+
+// NewRidgeCV creates a Ridge model that selects alpha from alphas by
+// cv-fold cross-validation. cv < 2 uses 5 folds. The intercept is fitted;
+// set FitIntercept to change that.
+func NewRidgeCV(alphas []float64, cv int) *RidgeCV {
+	return &RidgeCV{
+		Alphas:       alphas,
+		CV:           cv,
+		FitIntercept: true,
+	}
+}
+
+// Fit selects alpha by cross-validation and refits on all of X and y.
+func (r *RidgeCV) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[any]) error {
+	best, mse, err := selectAlpha(X, y, r.Alphas, r.CV, func(alpha float64) regressor {
+		return NewRidge(alpha, r.FitIntercept)
+	})
+	if err != nil {
+		return err
+	}
+
+	model := NewRidge(r.Alphas[best], r.FitIntercept)
+	if err := model.Fit(X, y); err != nil {
+		return err
+	}
+	r.model = model
+	r.mse = mse
+	return nil
+}
+
+// Predict makes predictions with the model refit on the chosen alpha.
+func (r *RidgeCV) Predict(X *dataframe.DataFrame) (*seriesPkg.Series[any], error) {
+	if r.model == nil {
+		return nil, fmt.Errorf("model not fitted yet")
+	}
+	return r.model.Predict(X)
+}
+
+// Score returns the R² score on test data.
+func (r *RidgeCV) Score(X *dataframe.DataFrame, y *seriesPkg.Series[any]) (float64, error) {
+	if r.model == nil {
+		return 0, fmt.Errorf("model not fitted yet")
+	}
+	return r.model.Score(X, y)
+}
+
+// Alpha returns the alpha chosen by cross-validation.
+func (r *RidgeCV) Alpha() float64 {
+	if r.model == nil {
+		return 0
+	}
+	return r.model.Alpha
+}
+
+// MSEPath returns the mean validation MSE of each alpha, in Alphas order.
+func (r *RidgeCV) MSEPath() []float64 {
+	return r.mse
+}
+
+// Coef returns the coefficients of the refit model.
+func (r *RidgeCV) Coef() []float64 {
+	if r.model == nil {
+		return nil
+	}
+	return r.model.Coef()
+}
+
+// Intercept returns the intercept of the refit model.
+func (r *RidgeCV) Intercept() float64 {
+	if r.model == nil {
+		return 0
+	}
+	return r.model.Intercept()
+}
+
+// LassoCV is Lasso regression with Alpha chosen by K-fold cross-validation.
+// It selects alpha the same way as RidgeCV.
+type LassoCV struct {
+	// Alphas are the candidate regularization strengths.
+	Alphas []float64
+
+	// CV is the number of folds.
+	CV int
+
+	// MaxIter is the maximum number of coordinate descent iterations.
+	MaxIter int
+
+	// FitIntercept determines whether to calculate the intercept.
+	FitIntercept bool
+
+	// model is the Lasso refit on all data with the chosen alpha
+	model *Lasso
+
+	// mse stores the mean validation MSE for each alpha
+	mse []float64
+}
+
+// NewLassoCV creates a Lasso model that selects alpha from alphas by
+// cv-fold cross-validation. cv < 2 uses 5 folds and maxIter <= 0 uses 1000.
+// The intercept is fitted; set FitIntercept to change that.
+func NewLassoCV(alphas []float64, cv int, maxIter int) *LassoCV {
+	return &LassoCV{
+		Alphas:       alphas,
+		CV:           cv,
+		MaxIter:      maxIter,
+		FitIntercept: true,
+	}
+}
+
+// Fit selects alpha by cross-validation and refits on all of X and y.
+func (l *LassoCV) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[any]) error {
+	best, mse, err := selectAlpha(X, y, l.Alphas, l.CV, func(alpha float64) regressor {
+		return NewLasso(alpha, l.MaxIter, l.FitIntercept)
+	})
+	if err != nil {
+		return err
+	}
+
+	model := NewLasso(l.Alphas[best], l.MaxIter, l.FitIntercept)
+	if err := model.Fit(X, y); err != nil {
+		return err
+	}
+	l.model = model
+	l.mse = mse
+	return nil
+}
+
+// Predict makes predictions with the model refit on the chosen alpha.
+func (l *LassoCV) Predict(X *dataframe.DataFrame) (*seriesPkg.Series[any], error) {
+	if l.model == nil {
+		return nil, fmt.Errorf("model not fitted yet")
+	}
+	return l.model.Predict(X)
+}
+
+// Score returns the R² score on test data.
+func (l *LassoCV) Score(X *dataframe.DataFrame, y *seriesPkg.Series[any]) (float64, error) {
+	if l.model == nil {
+		return 0, fmt.Errorf("model not fitted yet")
+	}
+	return l.model.Score(X, y)
+}
+
+// Alpha returns the alpha chosen by cross-validation.
+func (l *LassoCV) Alpha() float64 {
+	if l.model == nil {
+		return 0
+	}
+	return l.model.Alpha
+}
+
+// MSEPath returns the mean validation MSE of each alpha, in Alphas order.
+func (l *LassoCV) MSEPath() []float64 {
+	return l.mse
+}
+
+// Coef returns the coefficients of the refit model.
+func (l *LassoCV) Coef() []float64 {
+	if l.model == nil {
+		return nil
+	}
+	return l.model.Coef()
+}
+
+// Intercept returns the intercept of the refit model.
+func (l *LassoCV) Intercept() float64 {
+	if l.model == nil {
+		return 0
+	}
+	return l.model.Intercept()
+}
+
+// NIter returns the number of iterations of the final fit.
+func (l *LassoCV) NIter() int {
+	if l.model == nil {
+		return 0
+	}
+	return l.model.NIter()
+}
+
+// selectAlpha runs K-fold cross-validation for each alpha and returns the
+// index of the one with the lowest mean validation MSE, along with the mean
+// MSE of every alpha.
+func selectAlpha(X *dataframe.DataFrame, y *seriesPkg.Series[any], alphas []float64, cv int, newModel func(alpha float64) regressor) (int, []float64, error) {
+	if len(alphas) == 0 {
+		return 0, nil, fmt.Errorf("no candidate alphas: %w", core.ErrInvalidArgument)
+	}
+	for _, alpha := range alphas {
+		if alpha < 0 {
+			return 0, nil, fmt.Errorf("alpha %v must be >= 0: %w", alpha, core.ErrInvalidArgument)
+		}
+	}
+
+	target, err := extractTarget(y)
+	if err != nil {
+		return 0, nil, err
+	}
+	if X.Nrows() != len(target) {
+		return 0, nil, fmt.Errorf("X and y must have the same number of samples")
+	}
+
+	folds := crossval.NewKFold(cv, false, 0).Split(X)
+	if X.Nrows() < len(folds) {
+		return 0, nil, fmt.Errorf("%d samples is fewer than %d folds: %w", X.Nrows(), len(folds), core.ErrInvalidArgument)
+	}
+
+	mse := make([]float64, len(alphas))
+	best := 0
+	for a, alpha := range alphas {
+		total := 0.0
+		for i, fold := range folds {
+			model := newModel(alpha)
+			if err := model.Fit(X.Iloc(fold.TrainIndices...), takeTarget(y.Name(), target, fold.TrainIndices)); err != nil {
+				return 0, nil, fmt.Errorf("alpha %v, fold %d: fit failed: %w", alpha, i, err)
+			}
+			pred, err := model.Predict(X.Iloc(fold.TestIndices...))
+			if err != nil {
+				return 0, nil, fmt.Errorf("alpha %v, fold %d: predict failed: %w", alpha, i, err)
+			}
+
+			sum := 0.0
+			for j, idx := range fold.TestIndices {
+				val, _ := pred.Get(j)
+				f, _ := core.ToFloat64(val)
+				diff := target[idx] - f
+				sum += diff * diff
+			}
+			total += sum / float64(len(fold.TestIndices))
+		}
+		mse[a] = total / float64(len(folds))
+
+		if mse[a] < mse[best] || math.IsNaN(mse[best]) {
+			best = a
+		}
+	}
+
+	return best, mse, nil
+}
+
+// takeTarget returns the target values at the given rows as a float64 Series.
+func takeTarget(name string, target []float64, rows []int) *seriesPkg.Series[any] {
+	data := make([]any, len(rows))
+	for i, row := range rows {
+		data[i] = target[row]
+	}
+	return seriesPkg.New(name, data, core.DtypeFloat64)
+}
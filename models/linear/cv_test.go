@@ -0,0 +1,123 @@
+package linear
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/models/crossval"
+	seriesPkg "github.com/TIVerse/GopherData/series"
+)
+
+func cvTestData() (*dataframe.DataFrame, *seriesPkg.Series[any]) {
+	X, _ := dataframe.New(map[string]any{
+		"x1": []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+		"x2": []float64{2, 3, 1, 4, 2, 5, 3, 4, 6, 5, 7, 6},
+	})
+	X = X.Select("x1", "x2")
+
+	// y = 2*x1 + 3*x2 + 1 + noise
+	y := seriesPkg.New("y", []any{9.3, 13.8, 10.1, 19.2, 14.7, 25.4, 20.6, 27.1, 37.2, 35.8, 44.1, 42.9}, core.DtypeFloat64)
+	return X, y
+}
+
+// validationMSE computes the mean K-fold validation MSE of a model by hand.
+func validationMSE(t *testing.T, X *dataframe.DataFrame, y *seriesPkg.Series[any], cv int, model regressor) float64 {
+	t.Helper()
+	target, _ := extractTarget(y)
+
+	folds := crossval.NewKFold(cv, false, 0).Split(X)
+	total := 0.0
+	for _, fold := range folds {
+		if err := model.Fit(X.Iloc(fold.TrainIndices...), takeTarget("y", target, fold.TrainIndices)); err != nil {
+			t.Fatal(err)
+		}
+		pred, err := model.Predict(X.Iloc(fold.TestIndices...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sum := 0.0
+		for j, idx := range fold.TestIndices {
+			diff := target[idx] - pred.GetUnsafe(j).(float64)
+			sum += diff * diff
+		}
+		total += sum / float64(len(fold.TestIndices))
+	}
+	return total / float64(len(folds))
+}
+
+func TestRidgeCVChoosesLowestValidationMSE(t *testing.T) {
+	X, y := cvTestData()
+	alphas := []float64{1000, 10, 0.01, 1}
+
+	model := NewRidgeCV(alphas, 4)
+	if err := model.Fit(X, y); err != nil {
+		t.Fatalf("Fit failed: %v", err)
+	}
+
+	bestAlpha, bestMSE := 0.0, math.Inf(1)
+	for i, alpha := range alphas {
+		want := validationMSE(t, X, y, 4, NewRidge(alpha, true))
+		if got := model.MSEPath()[i]; math.Abs(got-want) > 1e-9 {
+			t.Errorf("MSEPath()[%d] = %v, want %v", i, got, want)
+		}
+		if want < bestMSE {
+			bestAlpha, bestMSE = alpha, want
+		}
+	}
+	if model.Alpha() != bestAlpha {
+		t.Errorf("Alpha() = %v, want %v with validation MSE %v", model.Alpha(), bestAlpha, bestMSE)
+	}
+	if model.Alpha() == 1000 {
+		t.Error("the heavily regularized alpha should not win on this data")
+	}
+
+	// The final model is refit on all data with the chosen alpha
+	refit := NewRidge(model.Alpha(), true)
+	_ = refit.Fit(X, y)
+	for j, c := range refit.Coef() {
+		if math.Abs(model.Coef()[j]-c) > 1e-9 {
+			t.Errorf("Coef()[%d] = %v, want %v", j, model.Coef()[j], c)
+		}
+	}
+
+	if _, err := model.Score(X, y); err != nil {
+		t.Errorf("Score failed: %v", err)
+	}
+}
+
+func TestLassoCV(t *testing.T) {
+	X, y := cvTestData()
+	alphas := []float64{0.01, 0.5, 50}
+
+	model := NewLassoCV(alphas, 3, 1000)
+	if err := model.Fit(X, y); err != nil {
+		t.Fatalf("Fit failed: %v", err)
+	}
+
+	path := model.MSEPath()
+	best := 0
+	for i := range path {
+		if path[i] < path[best] {
+			best = i
+		}
+	}
+	if model.Alpha() != alphas[best] {
+		t.Errorf("Alpha() = %v, want %v (MSE path %v)", model.Alpha(), alphas[best], path)
+	}
+	if model.Alpha() == 50 {
+		t.Errorf("Alpha() = 50, want a weaker penalty (MSE path %v)", path)
+	}
+	if model.NIter() == 0 {
+		t.Error("NIter() should report the final fit's iterations")
+	}
+
+	if _, err := NewLassoCV(nil, 3, 0).Predict(X); err == nil {
+		t.Error("expected error predicting with an unfitted model")
+	}
+	if err := NewLassoCV(nil, 3, 0).Fit(X, y); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("Fit with no alphas error = %v, want ErrInvalidArgument", err)
+	}
+}
@@ -0,0 +1,87 @@
+package linear
+
+import (
+	"math"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+	seriesPkg "github.com/TIVerse/GopherData/series"
+)
+
+func TestElasticNetLassoLimit(t *testing.T) {
+	X, _ := dataframe.New(map[string]any{
+		"x1": []float64{1, 2, 3, 4, 5, 6, 7, 8},
+		"x2": []float64{2, 3, 1, 4, 2, 5, 3, 4},
+	})
+	X = X.Select("x1", "x2")
+	y := seriesPkg.New("y", []any{9.0, 14.0, 10.0, 19.0, 15.0, 25.0, 21.0, 27.0}, core.DtypeFloat64)
+
+	for _, alpha := range []float64{0.1, 5, 50} {
+		lasso := NewLasso(alpha, 1000, true)
+		enet := NewElasticNet(alpha, 1, 1000, true)
+		if err := lasso.Fit(X, y); err != nil {
+			t.Fatalf("Lasso fit failed: %v", err)
+		}
+		if err := enet.Fit(X, y); err != nil {
+			t.Fatalf("ElasticNet fit failed: %v", err)
+		}
+
+		for j := range lasso.Coef() {
+			if math.Abs(enet.Coef()[j]-lasso.Coef()[j]) > 1e-2 {
+				t.Errorf("alpha %v: coef[%d] = %v, Lasso gives %v", alpha, j, enet.Coef()[j], lasso.Coef()[j])
+			}
+		}
+		if math.Abs(enet.Intercept()-lasso.Intercept()) > 1e-1 {
+			t.Errorf("alpha %v: intercept = %v, Lasso gives %v", alpha, enet.Intercept(), lasso.Intercept())
+		}
+		if enet.NIter() == 0 || enet.NIter() >= 1000 {
+			t.Errorf("alpha %v: NIter() = %d, want convergence within MaxIter", alpha, enet.NIter())
+		}
+	}
+}
+
+func TestElasticNetRidgeLimit(t *testing.T) {
+	// Centered features with unit root mean square, so the scaling ElasticNet
+	// applies is the identity and its L2 limit is exactly Ridge
+	X, _ := dataframe.New(map[string]any{
+		"x1": []float64{1, -1, 1, -1, 1, -1, 1, -1},
+		"x2": []float64{1, 1, -1, -1, 1, 1, -1, -1},
+	})
+	X = X.Select("x1", "x2")
+	y := seriesPkg.New("y", []any{6.1, 2.2, 0.1, -3.8, 5.9, 1.8, 0.2, -4.1}, core.DtypeFloat64)
+
+	ridge := NewRidge(4, true)
+	enet := NewElasticNet(4, 0, 1000, true)
+	enet.Tol = 1e-10
+	if err := ridge.Fit(X, y); err != nil {
+		t.Fatalf("Ridge fit failed: %v", err)
+	}
+	if err := enet.Fit(X, y); err != nil {
+		t.Fatalf("ElasticNet fit failed: %v", err)
+	}
+
+	for j := range ridge.Coef() {
+		if math.Abs(enet.Coef()[j]-ridge.Coef()[j]) > 1e-6 {
+			t.Errorf("coef[%d] = %v, Ridge gives %v", j, enet.Coef()[j], ridge.Coef()[j])
+		}
+	}
+	if math.Abs(enet.Intercept()-ridge.Intercept()) > 1e-6 {
+		t.Errorf("intercept = %v, Ridge gives %v", enet.Intercept(), ridge.Intercept())
+	}
+
+	// Unlike Lasso, the L2 penalty never zeroes a coefficient outright
+	for j, c := range enet.Coef() {
+		if c == 0 {
+			t.Errorf("coef[%d] is zero with l1Ratio = 0", j)
+		}
+	}
+
+	score, err := enet.Score(X, y)
+	if err != nil {
+		t.Fatalf("Score failed: %v", err)
+	}
+	if score < 0.8 {
+		t.Errorf("R² = %v, want a good fit", score)
+	}
+}
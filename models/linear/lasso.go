@@ -2,10 +2,10 @@ package linear
 
 import (
 	"fmt"
-	"math"
 
 	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/models/internal/modelutil"
 	seriesPkg "github.com/TIVerse/GopherData/series"
 )
 
@@ -63,7 +63,7 @@ func NewLasso(alpha float64, maxIter int, fitIntercept bool) *Lasso {
 // Fit trains the Lasso regression model using coordinate descent.
 func (l *Lasso) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[any]) error {
 	// Extract features
-	features, names, err := extractFeatures(X)
+	features, names, err := modelutil.ExtractFeatures(X, nil)
 	if err != nil {
 		return err
 	}
@@ -79,113 +79,8 @@ func (l *Lasso) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[any]) error {
 		return fmt.Errorf("x and y must have the same number of samples")
 	}
 	
-	n := len(features)
-	p := len(features[0])
-	
-	// Center data if fitting intercept
-	var meanX []float64
-	var meanY float64
-	if l.FitIntercept {
-		meanX = make([]float64, p)
-		for j := 0; j < p; j++ {
-			sum := 0.0
-			for i := 0; i < n; i++ {
-				sum += features[i][j]
-			}
-			meanX[j] = sum / float64(n)
-		}
-		
-		for i := 0; i < n; i++ {
-			meanY += target[i]
-		}
-		meanY /= float64(n)
-		
-		// Center the data
-		for i := 0; i < n; i++ {
-			for j := 0; j < p; j++ {
-				features[i][j] -= meanX[j]
-			}
-			target[i] -= meanY
-		}
-	}
-	
-	// Normalize features (important for Lasso)
-	stdX := make([]float64, p)
-	for j := 0; j < p; j++ {
-		var sumSq float64
-		for i := 0; i < n; i++ {
-			sumSq += features[i][j] * features[i][j]
-		}
-		stdX[j] = math.Sqrt(sumSq / float64(n))
-		if stdX[j] > 1e-10 {
-			for i := 0; i < n; i++ {
-				features[i][j] /= stdX[j]
-			}
-		}
-	}
-	
-	// Initialize coefficients
-	l.coef = make([]float64, p)
-	
-	// Coordinate descent
-	for iter := 0; iter < l.MaxIter; iter++ {
-		maxChange := 0.0
-		
-		for j := 0; j < p; j++ {
-			oldCoef := l.coef[j]
-			
-			// Compute residual without feature j
-			var rho float64
-			for i := 0; i < n; i++ {
-				residual := target[i]
-				for k := 0; k < p; k++ {
-					if k != j {
-						residual -= features[i][k] * l.coef[k]
-					}
-				}
-				rho += features[i][j] * residual
-			}
-			
-			// Soft thresholding
-			if rho < -l.Alpha {
-				l.coef[j] = (rho + l.Alpha) / float64(n)
-			} else if rho > l.Alpha {
-				l.coef[j] = (rho - l.Alpha) / float64(n)
-			} else {
-				l.coef[j] = 0
-			}
-			
-			// Track convergence
-			change := math.Abs(l.coef[j] - oldCoef)
-			if change > maxChange {
-				maxChange = change
-			}
-		}
-		
-		l.nIter = iter + 1
-		
-		// Check convergence
-		if maxChange < l.Tol {
-			break
-		}
-	}
-	
-	// Rescale coefficients
-	for j := 0; j < p; j++ {
-		if stdX[j] > 1e-10 {
-			l.coef[j] /= stdX[j]
-		}
-	}
-	
-	// Compute intercept if needed
-	if l.FitIntercept {
-		l.intercept = meanY
-		for j := 0; j < p; j++ {
-			l.intercept -= l.coef[j] * meanX[j]
-		}
-	} else {
-		l.intercept = 0
-	}
+	// Lasso is ElasticNet with l1Ratio = 1
+	l.coef, l.intercept, l.nIter = coordinateDescent(features, target, l.Alpha, 0, l.MaxIter, l.Tol, l.FitIntercept)
 	
 	l.fitted = true
 	return nil
@@ -197,7 +92,7 @@ func (l *Lasso) Predict(X *dataframe.DataFrame) (*seriesPkg.Series[any], error)
 		return nil, fmt.Errorf("model not fitted yet")
 	}
 	
-	features, _, err := extractFeatures(X)
+	features, _, err := modelutil.ExtractFeatures(X, l.featureNames)
 	if err != nil {
 		return nil, err
 	}
@@ -250,7 +145,7 @@ func (l *Lasso) Score(X *dataframe.DataFrame, y *seriesPkg.Series[any]) (float64
 	yPredVals := make([]float64, yPred.Len())
 	for i := 0; i < yPred.Len(); i++ {
 		val, _ := yPred.Get(i)
-		yPredVals[i] = toFloat64Linear(val)
+		yPredVals[i], _ = core.ToFloat64(val)
 	}
 	
 	// Calculate R²
@@ -199,7 +199,8 @@ func TestLogisticRegressionPredictProba(t *testing.T) {
 		for _, col := range cols {
 			series, _ := proba.Column(col)
 			val, _ := series.Get(i)
-			sum += toFloat64Linear(val)
+			f, _ := core.ToFloat64(val)
+			sum += f
 		}
 		
 		if math.Abs(sum-1.0) > 0.01 {
@@ -257,3 +258,173 @@ func TestLogisticRegressionRegularization(t *testing.T) {
 		t.Logf("Penalty %s: converged in %d iterations", config.penalty, model.NIter())
 	}
 }
+
+func TestLogisticRegressionMulticlass(t *testing.T) {
+	// Three well-separated clusters
+	data := map[string]any{
+		"x1": []float64{0, 0.5, 1, 0.5, 6, 6.5, 7, 6.5, 0, 0.5, 1, 0.5},
+		"x2": []float64{0, 0.5, 0, 1, 0, 0.5, 1, 0, 6, 6.5, 7, 6.5},
+	}
+	X, _ := dataframe.New(data)
+	
+	yData := []any{"A", "A", "A", "A", "B", "B", "B", "B", "C", "C", "C", "C"}
+	y := seriesPkg.New("y", yData, core.DtypeString)
+	
+	model := NewLogisticRegression("l2", 10.0, 1000)
+	if err := model.Fit(X, y); err != nil {
+		t.Fatalf("Fit failed: %v", err)
+	}
+	
+	classes := model.Classes()
+	expectedClasses := []string{"A", "B", "C"}
+	if len(classes) != len(expectedClasses) {
+		t.Fatalf("Expected classes %v, got %v", expectedClasses, classes)
+	}
+	for i, class := range expectedClasses {
+		if classes[i] != class {
+			t.Errorf("Expected classes %v, got %v", expectedClasses, classes)
+			break
+		}
+	}
+	
+	predictions, err := model.Predict(X)
+	if err != nil {
+		t.Fatalf("Predict failed: %v", err)
+	}
+	
+	correct := 0
+	for i := 0; i < predictions.Len(); i++ {
+		pred, _ := predictions.Get(i)
+		actual, _ := y.Get(i)
+		if pred == actual {
+			correct++
+		}
+	}
+	accuracy := float64(correct) / float64(predictions.Len())
+	if accuracy < 0.9 {
+		t.Errorf("Expected accuracy >= 0.9, got %f", accuracy)
+	}
+	
+	proba, err := model.PredictProba(X)
+	if err != nil {
+		t.Fatalf("PredictProba failed: %v", err)
+	}
+	if proba.Ncols() != 3 {
+		t.Errorf("Expected 3 probability columns, got %d", proba.Ncols())
+	}
+	for i := 0; i < proba.Nrows(); i++ {
+		var sum float64
+		for _, class := range expectedClasses {
+			s, _ := proba.Column(class)
+			val, _ := s.Get(i)
+			f, _ := core.ToFloat64(val)
+			sum += f
+		}
+		if math.Abs(sum-1.0) > 1e-9 {
+			t.Errorf("Row %d probabilities don't sum to 1: %f", i, sum)
+		}
+	}
+}
+
+func TestLogisticRegressionUnscaledFeatures(t *testing.T) {
+	// Features on very different scales; without standardization a fixed
+	// step either diverges on x1 or crawls on x2.
+	data := map[string]any{
+		"x1": []float64{1000, 1500, 2000, 2500, 3000, 5000, 5500, 6000, 6500, 7000},
+		"x2": []float64{0.001, 0.003, 0.002, 0.004, 0.003, 0.006, 0.008, 0.007, 0.009, 0.008},
+	}
+	X, _ := dataframe.New(data)
+	
+	yData := []any{"A", "A", "A", "A", "A", "B", "B", "B", "B", "B"}
+	y := seriesPkg.New("y", yData, core.DtypeString)
+	
+	model := NewLogisticRegression("l2", 1.0, 1000)
+	if err := model.Fit(X, y); err != nil {
+		t.Fatalf("Fit failed: %v", err)
+	}
+	
+	if model.NIter() >= 200 {
+		t.Errorf("Expected convergence well within MaxIter, took %d iterations", model.NIter())
+	}
+	
+	predictions, err := model.Predict(X)
+	if err != nil {
+		t.Fatalf("Predict failed: %v", err)
+	}
+	for i := 0; i < predictions.Len(); i++ {
+		pred, _ := predictions.Get(i)
+		actual, _ := y.Get(i)
+		if pred != actual {
+			t.Errorf("Row %d: expected %v, got %v", i, actual, pred)
+		}
+	}
+	
+	// Coefficients are reported in original units: the decision boundary
+	// should fall between the two groups on x1.
+	coef := model.Coef()
+	for i, name := range model.featureNames {
+		if name == "x1" && (coef[i] <= 0 || coef[i] > 1) {
+			t.Errorf("Expected small positive x1 coefficient in original units, got %f", coef[i])
+		}
+		
+		// The fitted standardization is kept on the model
+		if name == "x1" && (model.featureMeans[i] != 4000 || model.featureStds[i] <= 0) {
+			t.Errorf("Expected x1 mean 4000 and a positive std, got %v, %v", model.featureMeans[i], model.featureStds[i])
+		}
+	}
+	
+	t.Logf("Converged in %d iterations", model.NIter())
+}
+
+func TestLogisticRegressionClassWeight(t *testing.T) {
+	// 40 majority samples spread over [0, 40) and 4 minority samples that
+	// overlap the upper end of the range.
+	x := make([]float64, 0, 44)
+	yData := make([]any, 0, 44)
+	for i := 0; i < 40; i++ {
+		x = append(x, float64(i))
+		yData = append(yData, "N")
+	}
+	for _, v := range []float64{30.5, 32.5, 34.5, 36.5} {
+		x = append(x, v)
+		yData = append(yData, "F")
+	}
+	X, _ := dataframe.New(map[string]any{"x": x})
+	y := seriesPkg.New("y", yData, core.DtypeString)
+	
+	minorityRecall := func(model *LogisticRegression) float64 {
+		if err := model.Fit(X, y); err != nil {
+			t.Fatalf("Fit failed: %v", err)
+		}
+		predictions, err := model.Predict(X)
+		if err != nil {
+			t.Fatalf("Predict failed: %v", err)
+		}
+		hits := 0
+		for i := 40; i < 44; i++ {
+			if pred, _ := predictions.Get(i); pred == "F" {
+				hits++
+			}
+		}
+		return float64(hits) / 4
+	}
+	
+	unweighted := minorityRecall(NewLogisticRegression("l2", 1.0, 500))
+	
+	balanced := NewLogisticRegression("l2", 1.0, 500)
+	balanced.ClassWeightBalanced = true
+	balancedRecall := minorityRecall(balanced)
+	
+	explicit := NewLogisticRegression("l2", 1.0, 500)
+	explicit.ClassWeight = map[string]float64{"F": 10}
+	explicitRecall := minorityRecall(explicit)
+	
+	if balancedRecall <= unweighted {
+		t.Errorf("Expected balanced weighting to improve minority recall: unweighted=%.2f balanced=%.2f", unweighted, balancedRecall)
+	}
+	if explicitRecall <= unweighted {
+		t.Errorf("Expected explicit weighting to improve minority recall: unweighted=%.2f weighted=%.2f", unweighted, explicitRecall)
+	}
+	
+	t.Logf("Minority recall: unweighted=%.2f balanced=%.2f explicit=%.2f", unweighted, balancedRecall, explicitRecall)
+}
@@ -3,15 +3,20 @@ package linear
 import (
 	"fmt"
 	"math"
+	"sort"
 
 	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/models/internal/modelutil"
 	seriesPkg "github.com/TIVerse/GopherData/series"
 )
 
-// LogisticRegression implements logistic regression for binary classification.
-// Uses gradient descent with logistic loss function.
+// LogisticRegression implements logistic regression for classification.
+// Uses gradient descent with logistic loss function on internally standardized
+// features; coefficients are reported in the original feature units.
 // Supports L1, L2, or no regularization.
+// Problems with more than two classes are handled one-vs-rest: one binary model
+// is fitted per class and probabilities are normalized across classes.
 type LogisticRegression struct {
 	// Penalty specifies the regularization type: "l1", "l2", or "none"
 	Penalty string
@@ -28,19 +33,37 @@ type LogisticRegression struct {
 	// FitIntercept determines whether to calculate the intercept
 	FitIntercept bool
 	
-	// LearningRate for gradient descent
+	// LearningRate for gradient descent (initial step size when Adaptive is true)
 	LearningRate float64
 	
+	// Adaptive adjusts the step size each iteration with a backtracking line
+	// search, growing it after successful steps. Default: true
+	Adaptive bool
+	
+	// ClassWeight maps class labels to weights applied to each sample's loss
+	// and gradient. Classes not present default to weight 1.
+	ClassWeight map[string]float64
+	
+	// ClassWeightBalanced weights classes inversely proportional to their
+	// frequency in the training data, overriding ClassWeight.
+	ClassWeightBalanced bool
+	
 	// coef stores the coefficients
 	coef []float64
 	
 	// intercept stores the intercept term
 	intercept float64
 	
+	// classCoef stores one coefficient vector per class (one-vs-rest only)
+	classCoef [][]float64
+	
+	// classIntercept stores one intercept per class (one-vs-rest only)
+	classIntercept []float64
+	
 	// fitted indicates whether the model has been fitted
 	fitted bool
 	
-	// classes stores the unique class labels
+	// classes stores the unique class labels in sorted order
 	classes []string
 	
 	// nIter stores the actual number of iterations performed
@@ -48,6 +71,11 @@ type LogisticRegression struct {
 	
 	// featureNames stores the names of features
 	featureNames []string
+	
+	// featureMeans and featureStds store the standardization applied to each
+	// feature during Fit
+	featureMeans []float64
+	featureStds  []float64
 }
 
 // NewLogisticRegression creates a new logistic regression model.
@@ -66,14 +94,16 @@ func NewLogisticRegression(penalty string, C float64, maxIter int) *LogisticRegr
 		Tol:          1e-4,
 		FitIntercept: true,
 		LearningRate: 0.1, // Increased for faster convergence
+		Adaptive:     true,
 		fitted:       false,
 	}
 }
 
 // Fit trains the logistic regression model using gradient descent.
+// With more than two classes, one binary model is fitted per class (one-vs-rest).
 func (lr *LogisticRegression) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[any]) error {
 	// Extract features
-	features, names, err := extractFeatures(X)
+	features, names, err := modelutil.ExtractFeatures(X, nil)
 	if err != nil {
 		return err
 	}
@@ -89,7 +119,7 @@ func (lr *LogisticRegression) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[an
 		labels[i] = fmt.Sprint(val)
 	}
 	
-	// Get unique classes
+	// Get unique classes in a stable order
 	classSet := make(map[string]bool)
 	for _, label := range labels {
 		classSet[label] = true
@@ -99,52 +129,88 @@ func (lr *LogisticRegression) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[an
 	for class := range classSet {
 		lr.classes = append(lr.classes, class)
 	}
+	sort.Strings(lr.classes)
 	
-	if len(lr.classes) != 2 {
-		return fmt.Errorf("logistic regression requires exactly 2 classes, got %d", len(lr.classes))
+	if len(lr.classes) < 2 {
+		return fmt.Errorf("logistic regression requires at least 2 classes, got %d", len(lr.classes))
 	}
 	
-	// Encode labels as 0/1
-	target := make([]float64, len(labels))
-	for i, label := range labels {
-		if label == lr.classes[1] {
-			target[i] = 1.0
-		} else {
-			target[i] = 0.0
-		}
+	if len(features) != len(labels) {
+		return fmt.Errorf("x and y must have the same number of samples")
 	}
 	
-	if len(features) != len(target) {
-		return fmt.Errorf("x and y must have the same number of samples")
+	lr.classCoef = nil
+	lr.classIntercept = nil
+	
+	// Standardize features so a single step size suits every column
+	means, stds := standardizeFeatures(features, lr.FitIntercept)
+	lr.featureMeans, lr.featureStds = means, stds
+	weights := lr.sampleWeights(labels)
+	
+	// Binary fast path: a single model for classes[1] vs classes[0]
+	if len(lr.classes) == 2 {
+		target := encodeBinaryTarget(labels, lr.classes[1])
+		lr.coef, lr.intercept, lr.nIter = lr.fitBinary(features, target, weights)
+		lr.intercept = unscaleCoef(lr.coef, lr.intercept, means, stds)
+		lr.fitted = true
+		return nil
+	}
+	
+	// One-vs-rest: one binary model per class
+	lr.coef = nil
+	lr.intercept = 0
+	lr.nIter = 0
+	lr.classCoef = make([][]float64, len(lr.classes))
+	lr.classIntercept = make([]float64, len(lr.classes))
+	
+	for k, class := range lr.classes {
+		target := encodeBinaryTarget(labels, class)
+		coef, intercept, nIter := lr.fitBinary(features, target, weights)
+		intercept = unscaleCoef(coef, intercept, means, stds)
+		lr.classCoef[k] = coef
+		lr.classIntercept[k] = intercept
+		if nIter > lr.nIter {
+			lr.nIter = nIter
+		}
 	}
 	
+	lr.fitted = true
+	return nil
+}
+
+// fitBinary fits a single binary logistic model on a 0/1 target using gradient descent.
+// Each sample's contribution to the loss is scaled by its weight.
+// Returns the coefficients, intercept, and number of iterations performed.
+func (lr *LogisticRegression) fitBinary(features [][]float64, target, weights []float64) ([]float64, float64, int) {
 	n := len(features)
 	p := len(features[0])
 	
 	// Initialize coefficients
-	lr.coef = make([]float64, p)
-	lr.intercept = 0
+	coef := make([]float64, p)
+	intercept := 0.0
+	nIter := 0
 	
 	// Gradient descent
 	alpha := 1.0 / (lr.C * float64(n)) // Regularization strength
+	step := lr.LearningRate
+	loss := lr.objective(features, target, weights, coef, intercept, alpha)
+	
+	gradCoef := make([]float64, p)
+	newCoef := make([]float64, p)
 	
 	for iter := 0; iter < lr.MaxIter; iter++ {
-		// Compute predictions
-		predictions := make([]float64, n)
-		for i := 0; i < n; i++ {
-			z := lr.intercept
-			for j := 0; j < p; j++ {
-				z += lr.coef[j] * features[i][j]
-			}
-			predictions[i] = sigmoid(z)
-		}
-		
 		// Compute gradients
-		gradCoef := make([]float64, p)
+		for j := range gradCoef {
+			gradCoef[j] = 0
+		}
 		gradIntercept := 0.0
 		
 		for i := 0; i < n; i++ {
-			error := predictions[i] - target[i]
+			z := intercept
+			for j := 0; j < p; j++ {
+				z += coef[j] * features[i][j]
+			}
+			error := (sigmoid(z) - target[i]) * weights[i]
 			gradIntercept += error
 			for j := 0; j < p; j++ {
 				gradCoef[j] += error * features[i][j]
@@ -155,33 +221,68 @@ func (lr *LogisticRegression) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[an
 		switch lr.Penalty {
 		case "l2":
 			for j := 0; j < p; j++ {
-				gradCoef[j] += alpha * lr.coef[j]
+				gradCoef[j] += alpha * coef[j]
 			}
 		case "l1":
 			for j := 0; j < p; j++ {
-				if lr.coef[j] > 0 {
+				if coef[j] > 0 {
 					gradCoef[j] += alpha
-				} else if lr.coef[j] < 0 {
+				} else if coef[j] < 0 {
 					gradCoef[j] -= alpha
 				}
 			}
 		}
 		
-		// Update coefficients
-		maxChange := 0.0
 		for j := 0; j < p; j++ {
-			change := lr.LearningRate * gradCoef[j] / float64(n)
-			lr.coef[j] -= change
-			if math.Abs(change) > maxChange {
-				maxChange = math.Abs(change)
+			gradCoef[j] /= float64(n)
+		}
+		gradIntercept /= float64(n)
+		if !lr.FitIntercept {
+			gradIntercept = 0
+		}
+		
+		// Backtracking line search: grow the step after each accepted update
+		// and halve it until the objective satisfies the Armijo condition.
+		var newIntercept float64
+		if lr.Adaptive {
+			gradNormSq := gradIntercept * gradIntercept
+			for j := 0; j < p; j++ {
+				gradNormSq += gradCoef[j] * gradCoef[j]
+			}
+			
+			step *= 2
+			for {
+				for j := 0; j < p; j++ {
+					newCoef[j] = coef[j] - step*gradCoef[j]
+				}
+				newIntercept = intercept - step*gradIntercept
+				
+				newLoss := lr.objective(features, target, weights, newCoef, newIntercept, alpha)
+				if newLoss <= loss-1e-4*step*gradNormSq || step < 1e-10 {
+					loss = newLoss
+					break
+				}
+				step /= 2
 			}
+		} else {
+			for j := 0; j < p; j++ {
+				newCoef[j] = coef[j] - step*gradCoef[j]
+			}
+			newIntercept = intercept - step*gradIntercept
 		}
 		
-		if lr.FitIntercept {
-			lr.intercept -= lr.LearningRate * gradIntercept / float64(n)
+		// Update coefficients
+		maxChange := 0.0
+		for j := 0; j < p; j++ {
+			change := math.Abs(newCoef[j] - coef[j])
+			coef[j] = newCoef[j]
+			if change > maxChange {
+				maxChange = change
+			}
 		}
+		intercept = newIntercept
 		
-		lr.nIter = iter + 1
+		nIter = iter + 1
 		
 		// Check convergence
 		if maxChange < lr.Tol {
@@ -189,58 +290,114 @@ func (lr *LogisticRegression) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[an
 		}
 	}
 	
-	lr.fitted = true
-	return nil
+	return coef, intercept, nIter
+}
+
+// objective computes the mean weighted logistic loss plus the regularization
+// penalty whose gradient is used in fitBinary.
+func (lr *LogisticRegression) objective(features [][]float64, target, weights, coef []float64, intercept, alpha float64) float64 {
+	n := len(features)
+	loss := 0.0
+	
+	for i, row := range features {
+		z := intercept
+		for j, x := range row {
+			z += coef[j] * x
+		}
+		// log(1 + exp(z)) - y*z, computed stably
+		var l float64
+		if z > 0 {
+			l = z + math.Log1p(math.Exp(-z)) - target[i]*z
+		} else {
+			l = math.Log1p(math.Exp(z)) - target[i]*z
+		}
+		loss += l * weights[i]
+	}
+	
+	switch lr.Penalty {
+	case "l2":
+		for _, c := range coef {
+			loss += alpha * c * c / 2
+		}
+	case "l1":
+		for _, c := range coef {
+			loss += alpha * math.Abs(c)
+		}
+	}
+	
+	return loss / float64(n)
 }
 
 // Predict predicts class labels for samples in X.
+// Each sample is assigned the class with the highest probability.
 func (lr *LogisticRegression) Predict(X *dataframe.DataFrame) (*seriesPkg.Series[any], error) {
 	if !lr.fitted {
 		return nil, fmt.Errorf("model not fitted yet")
 	}
 	
-	proba, err := lr.PredictProba(X)
+	features, _, err := modelutil.ExtractFeatures(X, lr.featureNames)
 	if err != nil {
 		return nil, err
 	}
 	
-	// Get probability of class 1
-	probaCol, err := proba.Column(lr.classes[1])
-	if err != nil {
-		return nil, err
-	}
-	
-	predictions := make([]any, probaCol.Len())
-	for i := 0; i < probaCol.Len(); i++ {
-		val, _ := probaCol.Get(i)
-		prob := toFloat64Linear(val)
+	predictions := make([]any, len(features))
+	for i, row := range features {
+		proba, err := lr.rowProba(row)
+		if err != nil {
+			return nil, err
+		}
 		
-		if prob >= 0.5 {
-			predictions[i] = lr.classes[1]
-		} else {
-			predictions[i] = lr.classes[0]
+		best := 0
+		for k := 1; k < len(proba); k++ {
+			if proba[k] > proba[best] {
+				best = k
+			}
 		}
+		predictions[i] = lr.classes[best]
 	}
 	
 	return seriesPkg.New("predictions", predictions, core.DtypeString), nil
 }
 
 // PredictProba returns probability estimates for each class.
+// The result has one column per class; each row sums to 1.
 func (lr *LogisticRegression) PredictProba(X *dataframe.DataFrame) (*dataframe.DataFrame, error) {
 	if !lr.fitted {
 		return nil, fmt.Errorf("model not fitted yet")
 	}
 	
-	features, _, err := extractFeatures(X)
+	features, _, err := modelutil.ExtractFeatures(X, lr.featureNames)
 	if err != nil {
 		return nil, err
 	}
 	
 	n := len(features)
-	proba0 := make([]any, n)
-	proba1 := make([]any, n)
+	probaCols := make([][]any, len(lr.classes))
+	for k := range probaCols {
+		probaCols[k] = make([]any, n)
+	}
 	
 	for i, row := range features {
+		proba, err := lr.rowProba(row)
+		if err != nil {
+			return nil, err
+		}
+		for k, p := range proba {
+			probaCols[k][i] = p
+		}
+	}
+	
+	probaData := make(map[string]any, len(lr.classes))
+	for k, class := range lr.classes {
+		probaData[class] = probaCols[k]
+	}
+	
+	return dataframe.New(probaData)
+}
+
+// rowProba computes class probabilities for a single sample, ordered like lr.classes.
+func (lr *LogisticRegression) rowProba(row []float64) ([]float64, error) {
+	if len(lr.classes) == 2 {
 		if len(row) != len(lr.coef) {
 			return nil, fmt.Errorf("feature count mismatch")
 		}
@@ -251,31 +408,60 @@ func (lr *LogisticRegression) PredictProba(X *dataframe.DataFrame) (*dataframe.D
 		}
 		
 		p1 := sigmoid(z)
-		p0 := 1 - p1
+		return []float64{1 - p1, p1}, nil
+	}
+	
+	proba := make([]float64, len(lr.classes))
+	total := 0.0
+	for k := range lr.classes {
+		if len(row) != len(lr.classCoef[k]) {
+			return nil, fmt.Errorf("feature count mismatch")
+		}
 		
-		proba0[i] = p0
-		proba1[i] = p1
+		z := lr.classIntercept[k]
+		for j, x := range row {
+			z += x * lr.classCoef[k][j]
+		}
+		proba[k] = sigmoid(z)
+		total += proba[k]
 	}
 	
-	probaData := map[string]any{
-		lr.classes[0]: proba0,
-		lr.classes[1]: proba1,
+	// Normalize one-vs-rest scores across classes
+	for k := range proba {
+		if total > 0 {
+			proba[k] /= total
+		} else {
+			proba[k] = 1.0 / float64(len(proba))
+		}
 	}
 	
-	return dataframe.New(probaData)
+	return proba, nil
 }
 
-// Coef returns the coefficients.
+// Coef returns the coefficients of a binary model.
+// Returns nil for one-vs-rest models; use ClassCoef instead.
 func (lr *LogisticRegression) Coef() []float64 {
 	return lr.coef
 }
 
-// Intercept returns the intercept.
+// Intercept returns the intercept of a binary model.
 func (lr *LogisticRegression) Intercept() float64 {
 	return lr.intercept
 }
 
-// Classes returns the class labels.
+// ClassCoef returns one coefficient vector per class for one-vs-rest models,
+// in the same order as Classes. Returns nil for binary models.
+func (lr *LogisticRegression) ClassCoef() [][]float64 {
+	return lr.classCoef
+}
+
+// ClassIntercept returns one intercept per class for one-vs-rest models.
+// Returns nil for binary models.
+func (lr *LogisticRegression) ClassIntercept() []float64 {
+	return lr.classIntercept
+}
+
+// Classes returns the class labels in sorted order.
 func (lr *LogisticRegression) Classes() []string {
 	return lr.classes
 }
@@ -285,6 +471,81 @@ func (lr *LogisticRegression) NIter() int {
 	return lr.nIter
 }
 
+// standardizeFeatures scales each column in place to unit variance, centering
+// it first when center is true. Returns the means and scales that were applied;
+// constant columns keep a scale of 1.
+func standardizeFeatures(features [][]float64, center bool) ([]float64, []float64) {
+	n := len(features)
+	p := len(features[0])
+	means := make([]float64, p)
+	stds := make([]float64, p)
+	
+	for j := 0; j < p; j++ {
+		if center {
+			sum := 0.0
+			for i := 0; i < n; i++ {
+				sum += features[i][j]
+			}
+			means[j] = sum / float64(n)
+		}
+		
+		sumSq := 0.0
+		for i := 0; i < n; i++ {
+			diff := features[i][j] - means[j]
+			sumSq += diff * diff
+		}
+		stds[j] = math.Sqrt(sumSq / float64(n))
+		if stds[j] < 1e-12 {
+			stds[j] = 1
+		}
+		
+		for i := 0; i < n; i++ {
+			features[i][j] = (features[i][j] - means[j]) / stds[j]
+		}
+	}
+	
+	return means, stds
+}
+
+// unscaleCoef converts coefficients fitted on standardized features back to the
+// original units in place and returns the adjusted intercept.
+func unscaleCoef(coef []float64, intercept float64, means, stds []float64) float64 {
+	for j := range coef {
+		coef[j] /= stds[j]
+		intercept -= coef[j] * means[j]
+	}
+	return intercept
+}
+
+// sampleWeights derives per-sample weights from ClassWeight or
+// ClassWeightBalanced. Samples are weighted 1 when no weighting is configured.
+func (lr *LogisticRegression) sampleWeights(labels []string) []float64 {
+	classWeight := lr.ClassWeight
+	if lr.ClassWeightBalanced {
+		classWeight = modelutil.BalancedClassWeight(labels)
+	}
+	
+	weights := make([]float64, len(labels))
+	for i, label := range labels {
+		weights[i] = 1
+		if w, ok := classWeight[label]; ok {
+			weights[i] = w
+		}
+	}
+	return weights
+}
+
+// encodeBinaryTarget encodes labels as 1.0 for the positive class and 0.0 otherwise.
+func encodeBinaryTarget(labels []string, positive string) []float64 {
+	target := make([]float64, len(labels))
+	for i, label := range labels {
+		if label == positive {
+			target[i] = 1.0
+		}
+	}
+	return target
+}
+
 // sigmoid computes the logistic sigmoid function: 1 / (1 + exp(-z))
 func sigmoid(z float64) float64 {
 	if z > 20 {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/models/internal/modelutil"
 	seriesPkg "github.com/TIVerse/GopherData/series"
 	"gonum.org/v1/gonum/mat"
 )
@@ -45,7 +46,7 @@ func NewLinearRegression(fitIntercept bool) *LinearRegression {
 // Solves the normal equation: β = (X^T X)^-1 X^T y
 func (lr *LinearRegression) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[any]) error {
 	// Extract numeric features
-	features, names, err := extractFeatures(X)
+	features, names, err := modelutil.ExtractFeatures(X, nil)
 	if err != nil {
 		return err
 	}
@@ -122,7 +123,7 @@ func (lr *LinearRegression) Predict(X *dataframe.DataFrame) (*seriesPkg.Series[a
 		return nil, fmt.Errorf("model not fitted yet")
 	}
 
-	features, _, err := extractFeatures(X)
+	features, _, err := modelutil.ExtractFeatures(X, lr.featureNames)
 	if err != nil {
 		return nil, err
 	}
@@ -171,7 +172,7 @@ func (lr *LinearRegression) Score(X *dataframe.DataFrame, y *seriesPkg.Series[an
 	yPredVals := make([]float64, yPred.Len())
 	for i := 0; i < yPred.Len(); i++ {
 		val, _ := yPred.Get(i)
-		yPredVals[i] = toFloat64Linear(val)
+		yPredVals[i], _ = core.ToFloat64(val)
 	}
 
 	// Calculate mean of true values
@@ -199,44 +200,6 @@ func (lr *LinearRegression) Score(X *dataframe.DataFrame, y *seriesPkg.Series[an
 
 // Helper functions
 
-func extractFeatures(X *dataframe.DataFrame) ([][]float64, []string, error) {
-	n := X.Nrows()
-	cols := X.Columns()
-
-	// Filter numeric columns
-	numericCols := make([]string, 0)
-	for _, col := range cols {
-		series, err := X.Column(col)
-		if err != nil {
-			continue
-		}
-		if isNumeric(series.Dtype()) {
-			numericCols = append(numericCols, col)
-		}
-	}
-
-	if len(numericCols) == 0 {
-		return nil, nil, fmt.Errorf("no numeric columns found")
-	}
-
-	features := make([][]float64, n)
-	for i := range features {
-		features[i] = make([]float64, len(numericCols))
-	}
-
-	for j, col := range numericCols {
-		series, _ := X.Column(col)
-		for i := 0; i < n; i++ {
-			val, ok := series.Get(i)
-			if ok && val != nil {
-				features[i][j] = toFloat64Linear(val)
-			}
-		}
-	}
-
-	return features, numericCols, nil
-}
-
 func extractTarget(y *seriesPkg.Series[any]) ([]float64, error) {
 	n := y.Len()
 	target := make([]float64, n)
@@ -246,37 +209,8 @@ func extractTarget(y *seriesPkg.Series[any]) ([]float64, error) {
 		if !ok || val == nil {
 			return nil, fmt.Errorf("target contains null values at index %d", i)
 		}
-		target[i] = toFloat64Linear(val)
+		target[i], _ = core.ToFloat64(val)
 	}
 
 	return target, nil
 }
-
-func isNumeric(dtype core.Dtype) bool {
-	switch dtype {
-	case core.DtypeFloat64, core.DtypeInt64:
-		return true
-	}
-	return false
-}
-
-func toFloat64Linear(val any) float64 {
-	switch v := val.(type) {
-	case float64:
-		return v
-	case float32:
-		return float64(v)
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	case int32:
-		return float64(v)
-	case int16:
-		return float64(v)
-	case int8:
-		return float64(v)
-	default:
-		return 0
-	}
-}
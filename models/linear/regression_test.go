@@ -1,6 +1,7 @@
 package linear
 
 import (
+	"errors"
 	"math"
 	"testing"
 
@@ -74,7 +75,7 @@ func TestLinearRegressionPredict(t *testing.T) {
 	expected := []float64{13.0, 15.0, 17.0}
 	for i, exp := range expected {
 		val, _ := predictions.Get(i)
-		pred := toFloat64Linear(val)
+		pred, _ := core.ToFloat64(val)
 		if math.Abs(pred-exp) > 0.1 {
 			t.Errorf("Prediction %d: expected ~%f, got %f", i, exp, pred)
 		}
@@ -164,3 +165,44 @@ func TestLinearRegressionNoIntercept(t *testing.T) {
 		t.Errorf("Expected coefficient ~2.0, got %f", coef[0])
 	}
 }
+
+func TestLinearRegressionPredictAlignsFeatures(t *testing.T) {
+	X, _ := dataframe.New(map[string]any{
+		"x1": []float64{1, 2, 3, 4, 5},
+		"x2": []float64{1, 1, 2, 2, 3},
+	})
+	X = X.Select("x1", "x2")
+	y := seriesPkg.New("y", []any{6.0, 8.0, 13.0, 15.0, 20.0}, core.DtypeFloat64)
+
+	model := NewLinearRegression(true)
+	if err := model.Fit(X, y); err != nil {
+		t.Fatalf("Fit failed: %v", err)
+	}
+	want, err := model.Predict(X)
+	if err != nil {
+		t.Fatalf("Predict failed: %v", err)
+	}
+
+	// Reordered columns plus an extra numeric column must give the same predictions.
+	XTest, _ := dataframe.New(map[string]any{
+		"x1":    []float64{1, 2, 3, 4, 5},
+		"x2":    []float64{1, 1, 2, 2, 3},
+		"extra": []float64{100, 200, 300, 400, 500},
+	})
+	XTest = XTest.Select("extra", "x2", "x1")
+	got, err := model.Predict(XTest)
+	if err != nil {
+		t.Fatalf("Predict failed: %v", err)
+	}
+	for i := 0; i < want.Len(); i++ {
+		w, _ := want.Get(i)
+		g, _ := got.Get(i)
+		if math.Abs(w.(float64)-g.(float64)) > 1e-9 {
+			t.Errorf("row %d: expected %v, got %v", i, w, g)
+		}
+	}
+
+	if _, err := model.Predict(XTest.Select("x1")); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("expected ErrColumnNotFound for missing feature, got %v", err)
+	}
+}
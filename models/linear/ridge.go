@@ -6,6 +6,7 @@ import (
 
 	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/models/internal/modelutil"
 	seriesPkg "github.com/TIVerse/GopherData/series"
 	"gonum.org/v1/gonum/mat"
 )
@@ -52,7 +53,7 @@ func NewRidge(alpha float64, fitIntercept bool) *Ridge {
 // Solves: β = (X^T X + α I)^-1 X^T y
 func (r *Ridge) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[any]) error {
 	// Extract features
-	features, names, err := extractFeatures(X)
+	features, names, err := modelutil.ExtractFeatures(X, nil)
 	if err != nil {
 		return err
 	}
@@ -156,7 +157,7 @@ func (r *Ridge) Predict(X *dataframe.DataFrame) (*seriesPkg.Series[any], error)
 		return nil, fmt.Errorf("model not fitted yet")
 	}
 
-	features, _, err := extractFeatures(X)
+	features, _, err := modelutil.ExtractFeatures(X, r.featureNames)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +205,7 @@ func (r *Ridge) Score(X *dataframe.DataFrame, y *seriesPkg.Series[any]) (float64
 	yPredVals := make([]float64, yPred.Len())
 	for i := 0; i < yPred.Len(); i++ {
 		val, _ := yPred.Get(i)
-		yPredVals[i] = toFloat64Linear(val)
+		yPredVals[i], _ = core.ToFloat64(val)
 	}
 
 	// Calculate R²
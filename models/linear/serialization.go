@@ -0,0 +1,360 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ModelFormatVersion is the version of the serialized model format, as
+// "major.minor". Minor versions only add fields, so a model saved with any
+// version of the same major version can be loaded; other major versions are
+// rejected.
+const ModelFormatVersion = "1.1"
+
+// SerializedModel represents a fitted linear model in JSON format.
+type SerializedModel struct {
+	Version      string         `json:"version"`
+	Type         string         `json:"type"`
+	Params       map[string]any `json:"params"`
+	Fitted       bool           `json:"fitted"`
+	Coef         []float64      `json:"coef,omitempty"`
+	Intercept    float64        `json:"intercept"`
+	FeatureNames []string       `json:"feature_names,omitempty"`
+	Classes      []string       `json:"classes,omitempty"`
+	ClassCoef    [][]float64    `json:"class_coef,omitempty"`
+	ClassInter   []float64      `json:"class_intercept,omitempty"`
+	NIter        int            `json:"n_iter,omitempty"`
+
+	// Added in version 1.1
+	FeatureMeans []float64 `json:"feature_means,omitempty"`
+	FeatureStds  []float64 `json:"feature_stds,omitempty"`
+}
+
+// Model type names used in the serialized format.
+const (
+	typeLinearRegression   = "LinearRegression"
+	typeRidge              = "Ridge"
+	typeLasso              = "Lasso"
+	typeLogisticRegression = "LogisticRegression"
+)
+
+// MarshalJSON encodes the model parameters and fitted state as JSON.
+func (lr *LinearRegression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(SerializedModel{
+		Version: ModelFormatVersion,
+		Type:    typeLinearRegression,
+		Params: map[string]any{
+			"fit_intercept": lr.FitIntercept,
+		},
+		Fitted:       lr.fitted,
+		Coef:         lr.coef,
+		Intercept:    lr.intercept,
+		FeatureNames: lr.featureNames,
+	})
+}
+
+// UnmarshalJSON restores the model from JSON produced by MarshalJSON.
+func (lr *LinearRegression) UnmarshalJSON(data []byte) error {
+	sm, err := decodeModel(data, typeLinearRegression)
+	if err != nil {
+		return err
+	}
+
+	lr.FitIntercept = paramBool(sm.Params, "fit_intercept", true)
+	lr.fitted = sm.Fitted
+	lr.coef = sm.Coef
+	lr.intercept = sm.Intercept
+	lr.featureNames = sm.FeatureNames
+	return nil
+}
+
+// Save writes the model to a JSON file.
+func (lr *LinearRegression) Save(path string) error {
+	return saveModel(path, lr)
+}
+
+// LoadLinearRegression loads a LinearRegression model from a JSON file.
+func LoadLinearRegression(path string) (*LinearRegression, error) {
+	model := &LinearRegression{}
+	if err := loadModel(path, model); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// MarshalJSON encodes the model parameters and fitted state as JSON.
+func (r *Ridge) MarshalJSON() ([]byte, error) {
+	return json.Marshal(SerializedModel{
+		Version: ModelFormatVersion,
+		Type:    typeRidge,
+		Params: map[string]any{
+			"alpha":         r.Alpha,
+			"fit_intercept": r.FitIntercept,
+		},
+		Fitted:       r.fitted,
+		Coef:         r.coef,
+		Intercept:    r.intercept,
+		FeatureNames: r.featureNames,
+	})
+}
+
+// UnmarshalJSON restores the model from JSON produced by MarshalJSON.
+func (r *Ridge) UnmarshalJSON(data []byte) error {
+	sm, err := decodeModel(data, typeRidge)
+	if err != nil {
+		return err
+	}
+
+	r.Alpha = paramFloat(sm.Params, "alpha", 1.0)
+	r.FitIntercept = paramBool(sm.Params, "fit_intercept", true)
+	r.fitted = sm.Fitted
+	r.coef = sm.Coef
+	r.intercept = sm.Intercept
+	r.featureNames = sm.FeatureNames
+	return nil
+}
+
+// Save writes the model to a JSON file.
+func (r *Ridge) Save(path string) error {
+	return saveModel(path, r)
+}
+
+// LoadRidge loads a Ridge model from a JSON file.
+func LoadRidge(path string) (*Ridge, error) {
+	model := &Ridge{}
+	if err := loadModel(path, model); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// MarshalJSON encodes the model parameters and fitted state as JSON.
+func (l *Lasso) MarshalJSON() ([]byte, error) {
+	return json.Marshal(SerializedModel{
+		Version: ModelFormatVersion,
+		Type:    typeLasso,
+		Params: map[string]any{
+			"alpha":         l.Alpha,
+			"max_iter":      l.MaxIter,
+			"tol":           l.Tol,
+			"fit_intercept": l.FitIntercept,
+		},
+		Fitted:       l.fitted,
+		Coef:         l.coef,
+		Intercept:    l.intercept,
+		FeatureNames: l.featureNames,
+		NIter:        l.nIter,
+	})
+}
+
+// UnmarshalJSON restores the model from JSON produced by MarshalJSON.
+func (l *Lasso) UnmarshalJSON(data []byte) error {
+	sm, err := decodeModel(data, typeLasso)
+	if err != nil {
+		return err
+	}
+
+	l.Alpha = paramFloat(sm.Params, "alpha", 1.0)
+	l.MaxIter = paramInt(sm.Params, "max_iter", 1000)
+	l.Tol = paramFloat(sm.Params, "tol", 1e-4)
+	l.FitIntercept = paramBool(sm.Params, "fit_intercept", true)
+	l.fitted = sm.Fitted
+	l.coef = sm.Coef
+	l.intercept = sm.Intercept
+	l.featureNames = sm.FeatureNames
+	l.nIter = sm.NIter
+	return nil
+}
+
+// Save writes the model to a JSON file.
+func (l *Lasso) Save(path string) error {
+	return saveModel(path, l)
+}
+
+// LoadLasso loads a Lasso model from a JSON file.
+func LoadLasso(path string) (*Lasso, error) {
+	model := &Lasso{}
+	if err := loadModel(path, model); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// MarshalJSON encodes the model parameters and fitted state as JSON.
+func (lr *LogisticRegression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(SerializedModel{
+		Version: ModelFormatVersion,
+		Type:    typeLogisticRegression,
+		Params: map[string]any{
+			"penalty":       lr.Penalty,
+			"c":             lr.C,
+			"max_iter":      lr.MaxIter,
+			"tol":           lr.Tol,
+			"fit_intercept": lr.FitIntercept,
+			"learning_rate": lr.LearningRate,
+			"adaptive":      lr.Adaptive,
+			"class_weight":  lr.ClassWeight,
+			"balanced":      lr.ClassWeightBalanced,
+		},
+		Fitted:       lr.fitted,
+		Coef:         lr.coef,
+		Intercept:    lr.intercept,
+		FeatureNames: lr.featureNames,
+		Classes:      lr.classes,
+		ClassCoef:    lr.classCoef,
+		ClassInter:   lr.classIntercept,
+		NIter:        lr.nIter,
+		FeatureMeans: lr.featureMeans,
+		FeatureStds:  lr.featureStds,
+	})
+}
+
+// UnmarshalJSON restores the model from JSON produced by MarshalJSON.
+func (lr *LogisticRegression) UnmarshalJSON(data []byte) error {
+	sm, err := decodeModel(data, typeLogisticRegression)
+	if err != nil {
+		return err
+	}
+
+	lr.Penalty = paramString(sm.Params, "penalty", "l2")
+	lr.C = paramFloat(sm.Params, "c", 1.0)
+	lr.MaxIter = paramInt(sm.Params, "max_iter", 100)
+	lr.Tol = paramFloat(sm.Params, "tol", 1e-4)
+	lr.FitIntercept = paramBool(sm.Params, "fit_intercept", true)
+	lr.LearningRate = paramFloat(sm.Params, "learning_rate", 0.1)
+	lr.Adaptive = paramBool(sm.Params, "adaptive", true)
+	lr.ClassWeight = paramWeights(sm.Params, "class_weight")
+	lr.ClassWeightBalanced = paramBool(sm.Params, "balanced", false)
+	lr.fitted = sm.Fitted
+	lr.coef = sm.Coef
+	lr.intercept = sm.Intercept
+	lr.featureNames = sm.FeatureNames
+	lr.classes = sm.Classes
+	lr.classCoef = sm.ClassCoef
+	lr.classIntercept = sm.ClassInter
+	lr.nIter = sm.NIter
+	lr.featureMeans = sm.FeatureMeans
+	lr.featureStds = sm.FeatureStds
+
+	if lr.fitted && len(lr.classes) < 2 {
+		return fmt.Errorf("fitted logistic regression must have at least 2 classes, got %d", len(lr.classes))
+	}
+	if lr.fitted && len(lr.classes) > 2 && len(lr.classCoef) != len(lr.classes) {
+		return fmt.Errorf("one-vs-rest model has %d coefficient vectors for %d classes", len(lr.classCoef), len(lr.classes))
+	}
+	return nil
+}
+
+// Save writes the model to a JSON file.
+func (lr *LogisticRegression) Save(path string) error {
+	return saveModel(path, lr)
+}
+
+// LoadLogisticRegression loads a LogisticRegression model from a JSON file.
+func LoadLogisticRegression(path string) (*LogisticRegression, error) {
+	model := &LogisticRegression{}
+	if err := loadModel(path, model); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// Helper functions
+
+// decodeModel decodes a SerializedModel and checks its version and type.
+func decodeModel(data []byte, expectedType string) (*SerializedModel, error) {
+	var sm SerializedModel
+	if err := json.Unmarshal(data, &sm); err != nil {
+		return nil, fmt.Errorf("failed to decode model: %w", err)
+	}
+
+	if majorVersion(sm.Version) != majorVersion(ModelFormatVersion) {
+		return nil, fmt.Errorf("incompatible version: %q (expected %s.x)", sm.Version, majorVersion(ModelFormatVersion))
+	}
+	if sm.Type != expectedType {
+		return nil, fmt.Errorf("model type mismatch: got %q, expected %q", sm.Type, expectedType)
+	}
+
+	return &sm, nil
+}
+
+// majorVersion returns the major part of a "major.minor" version, or ""
+// if version is not of that form.
+func majorVersion(version string) string {
+	major, minor, ok := strings.Cut(version, ".")
+	if !ok || major == "" || minor == "" {
+		return ""
+	}
+	return major
+}
+
+// saveModel encodes a model as indented JSON and writes it to path.
+func saveModel(path string, model json.Marshaler) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(model); err != nil {
+		return fmt.Errorf("failed to encode model: %w", err)
+	}
+
+	return nil
+}
+
+// loadModel reads a JSON file and decodes it into model.
+func loadModel(path string, model json.Unmarshaler) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return model.UnmarshalJSON(data)
+}
+
+func paramFloat(params map[string]any, key string, def float64) float64 {
+	if v, ok := params[key].(float64); ok {
+		return v
+	}
+	return def
+}
+
+func paramInt(params map[string]any, key string, def int) int {
+	if v, ok := params[key].(float64); ok {
+		return int(v)
+	}
+	return def
+}
+
+func paramBool(params map[string]any, key string, def bool) bool {
+	if v, ok := params[key].(bool); ok {
+		return v
+	}
+	return def
+}
+
+func paramWeights(params map[string]any, key string) map[string]float64 {
+	raw, ok := params[key].(map[string]any)
+	if !ok {
+		return nil
+	}
+	weights := make(map[string]float64, len(raw))
+	for label, v := range raw {
+		if w, ok := v.(float64); ok {
+			weights[label] = w
+		}
+	}
+	return weights
+}
+
+func paramString(params map[string]any, key string, def string) string {
+	if v, ok := params[key].(string); ok {
+		return v
+	}
+	return def
+}
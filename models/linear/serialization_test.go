@@ -0,0 +1,190 @@
+package linear
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+	seriesPkg "github.com/TIVerse/GopherData/series"
+)
+
+// assertSamePredictions fails the test if the two prediction Series differ element-wise.
+func assertSamePredictions(t *testing.T, want, got *seriesPkg.Series[any]) {
+	t.Helper()
+	if want.Len() != got.Len() {
+		t.Fatalf("Expected %d predictions, got %d", want.Len(), got.Len())
+	}
+	for i := 0; i < want.Len(); i++ {
+		w, _ := want.Get(i)
+		g, _ := got.Get(i)
+		if w != g {
+			t.Errorf("Prediction %d: expected %v, got %v", i, w, g)
+		}
+	}
+}
+
+func TestModelSerializationRoundTrip(t *testing.T) {
+	X, _ := dataframe.New(map[string]any{
+		"x1": []float64{1, 2, 3, 4, 5, 6, 7, 8},
+		"x2": []float64{2, 3, 1, 4, 2, 5, 3, 4},
+	})
+	yReg := seriesPkg.New("y", []any{9.0, 14.0, 10.0, 19.0, 15.0, 25.0, 21.0, 27.0}, core.DtypeFloat64)
+	yCls := seriesPkg.New("y", []any{"a", "a", "a", "a", "b", "b", "b", "b"}, core.DtypeString)
+	dir := t.TempDir()
+
+	t.Run("LinearRegression", func(t *testing.T) {
+		model := NewLinearRegression(true)
+		if err := model.Fit(X, yReg); err != nil {
+			t.Fatalf("Fit failed: %v", err)
+		}
+		path := filepath.Join(dir, "linear.json")
+		if err := model.Save(path); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		loaded, err := LoadLinearRegression(path)
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		want, _ := model.Predict(X)
+		got, err := loaded.Predict(X)
+		if err != nil {
+			t.Fatalf("Predict on loaded model failed: %v", err)
+		}
+		assertSamePredictions(t, want, got)
+	})
+
+	t.Run("Ridge", func(t *testing.T) {
+		model := NewRidge(0.5, true)
+		if err := model.Fit(X, yReg); err != nil {
+			t.Fatalf("Fit failed: %v", err)
+		}
+		path := filepath.Join(dir, "ridge.json")
+		if err := model.Save(path); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		loaded, err := LoadRidge(path)
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if loaded.Alpha != model.Alpha {
+			t.Errorf("Expected alpha %v, got %v", model.Alpha, loaded.Alpha)
+		}
+		want, _ := model.Predict(X)
+		got, err := loaded.Predict(X)
+		if err != nil {
+			t.Fatalf("Predict on loaded model failed: %v", err)
+		}
+		assertSamePredictions(t, want, got)
+	})
+
+	t.Run("Lasso", func(t *testing.T) {
+		model := NewLasso(0.1, 1000, true)
+		if err := model.Fit(X, yReg); err != nil {
+			t.Fatalf("Fit failed: %v", err)
+		}
+		path := filepath.Join(dir, "lasso.json")
+		if err := model.Save(path); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		loaded, err := LoadLasso(path)
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if loaded.NIter() != model.NIter() {
+			t.Errorf("Expected %d iterations, got %d", model.NIter(), loaded.NIter())
+		}
+		want, _ := model.Predict(X)
+		got, err := loaded.Predict(X)
+		if err != nil {
+			t.Fatalf("Predict on loaded model failed: %v", err)
+		}
+		assertSamePredictions(t, want, got)
+	})
+
+	t.Run("LogisticRegression", func(t *testing.T) {
+		model := NewLogisticRegression("l2", 1.0, 200)
+		model.ClassWeight = map[string]float64{"b": 2.0}
+		if err := model.Fit(X, yCls); err != nil {
+			t.Fatalf("Fit failed: %v", err)
+		}
+		path := filepath.Join(dir, "logistic.json")
+		if err := model.Save(path); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		loaded, err := LoadLogisticRegression(path)
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		want, _ := model.Predict(X)
+		got, err := loaded.Predict(X)
+		if err != nil {
+			t.Fatalf("Predict on loaded model failed: %v", err)
+		}
+		assertSamePredictions(t, want, got)
+		if loaded.ClassWeight["b"] != 2.0 {
+			t.Errorf("ClassWeight not restored: %v", loaded.ClassWeight)
+		}
+		if len(loaded.featureMeans) != len(model.featureMeans) || len(loaded.featureStds) != len(model.featureStds) {
+			t.Fatalf("standardization not restored: means %v, stds %v", loaded.featureMeans, loaded.featureStds)
+		}
+		for j := range model.featureMeans {
+			if loaded.featureMeans[j] != model.featureMeans[j] || loaded.featureStds[j] != model.featureStds[j] {
+				t.Errorf("feature %d: standardization (%v, %v), want (%v, %v)", j,
+					loaded.featureMeans[j], loaded.featureStds[j], model.featureMeans[j], model.featureStds[j])
+			}
+		}
+
+		wantProba, _ := model.PredictProba(X)
+		gotProba, _ := loaded.PredictProba(X)
+		for _, class := range model.Classes() {
+			ws, _ := wantProba.Column(class)
+			gs, err := gotProba.Column(class)
+			if err != nil {
+				t.Fatalf("class %q missing from loaded probabilities", class)
+			}
+			assertSamePredictions(t, ws, gs)
+		}
+	})
+
+	t.Run("TypeMismatch", func(t *testing.T) {
+		model := NewRidge(1.0, true)
+		_ = model.Fit(X, yReg)
+		path := filepath.Join(dir, "mismatch.json")
+		_ = model.Save(path)
+		if _, err := LoadLasso(path); err == nil {
+			t.Error("Expected error loading a Ridge model as Lasso")
+		}
+	})
+}
+
+func TestModelSerializationVersions(t *testing.T) {
+	model := NewLinearRegression(true)
+	data, err := model.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON failed: %v", err)
+	}
+
+	for version, ok := range map[string]bool{
+		"1.0": true,
+		"1.7": true,
+		"2.0": false,
+		"1":   false,
+		"":    false,
+	} {
+		current := fmt.Sprintf("%q:%q", "version", ModelFormatVersion)
+		if !strings.Contains(string(data), current) {
+			t.Fatalf("serialized model lacks %s: %s", current, data)
+		}
+		edited := strings.Replace(string(data), current, fmt.Sprintf("%q:%q", "version", version), 1)
+		err := (&LinearRegression{}).UnmarshalJSON([]byte(edited))
+		if ok && err != nil {
+			t.Errorf("version %q: expected to load, got %v", version, err)
+		}
+		if !ok && err == nil {
+			t.Errorf("version %q: expected an error", version)
+		}
+	}
+}
@@ -3,7 +3,11 @@ package models
 import (
 	"fmt"
 	"math"
+	"sort"
+	"strconv"
 
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
 	seriesPkg "github.com/TIVerse/GopherData/series"
 )
 
@@ -44,14 +48,32 @@ func Accuracy(yTrue, yPred *seriesPkg.Series[any]) float64 {
 	return float64(correct) / float64(total)
 }
 
+// MetricOptions configures classification metrics.
+type MetricOptions struct {
+	posLabel string // Positive class for average "binary" ("" for the default)
+}
+
+// MetricOption is a functional option for classification metrics.
+type MetricOption func(*MetricOptions)
+
+// PosLabel sets the class treated as positive when average is "binary".
+// Labels are compared in their fmt.Sprint form, so PosLabel("1") matches int64(1).
+func PosLabel(label string) MetricOption {
+	return func(opts *MetricOptions) {
+		opts.posLabel = label
+	}
+}
+
 // Precision calculates the precision score.
 // average: "binary" (for binary classification), "micro", "macro", "weighted"
-func Precision(yTrue, yPred *seriesPkg.Series[any], average string) float64 {
+// For "binary", the positive class is set with PosLabel. It defaults to "1"
+// if present, else "true", else the largest label in sorted order.
+func Precision(yTrue, yPred *seriesPkg.Series[any], average string, opts ...MetricOption) float64 {
 	cm := confusionMatrixMap(yTrue, yPred)
 	
 	switch average {
 	case "binary":
-		return binaryPrecision(cm)
+		return binaryPrecision(cm, positiveLabel(yTrue, yPred, opts))
 	case "micro":
 		return microPrecision(cm)
 	case "macro":
@@ -64,12 +86,13 @@ func Precision(yTrue, yPred *seriesPkg.Series[any], average string) float64 {
 }
 
 // Recall calculates the recall score.
-func Recall(yTrue, yPred *seriesPkg.Series[any], average string) float64 {
+// The positive class for average "binary" is chosen as in Precision.
+func Recall(yTrue, yPred *seriesPkg.Series[any], average string, opts ...MetricOption) float64 {
 	cm := confusionMatrixMap(yTrue, yPred)
 	
 	switch average {
 	case "binary":
-		return binaryRecall(cm)
+		return binaryRecall(cm, positiveLabel(yTrue, yPred, opts))
 	case "micro":
 		return microRecall(cm)
 	case "macro":
@@ -82,9 +105,9 @@ func Recall(yTrue, yPred *seriesPkg.Series[any], average string) float64 {
 }
 
 // F1Score calculates the F1 score (harmonic mean of precision and recall).
-func F1Score(yTrue, yPred *seriesPkg.Series[any], average string) float64 {
-	p := Precision(yTrue, yPred, average)
-	r := Recall(yTrue, yPred, average)
+func F1Score(yTrue, yPred *seriesPkg.Series[any], average string, opts ...MetricOption) float64 {
+	p := Precision(yTrue, yPred, average, opts...)
+	r := Recall(yTrue, yPred, average, opts...)
 	
 	if p+r == 0 {
 		return 0
@@ -97,6 +120,44 @@ func F1Score(yTrue, yPred *seriesPkg.Series[any], average string) float64 {
 func ConfusionMatrix(yTrue, yPred *seriesPkg.Series[any]) [][]int {
 	// Get unique labels
 	labels := getUniqueLabels(yTrue, yPred)
+	return confusionMatrixFor(yTrue, yPred, labels)
+}
+
+// ConfusionMatrixDF computes the confusion matrix as a labeled DataFrame.
+// Each column holds the counts for one predicted label and the row index
+// holds the true labels, so cell (row t, column p) counts samples with true
+// label t predicted as p. The labels are returned in row and column order.
+func ConfusionMatrixDF(yTrue, yPred *seriesPkg.Series[any]) (*dataframe.DataFrame, []string, error) {
+	if yTrue.Len() != yPred.Len() {
+		return nil, nil, fmt.Errorf("y_true has length %d, y_pred has length %d: %w", yTrue.Len(), yPred.Len(), core.ErrInvalidShape)
+	}
+
+	labels := getUniqueLabels(yTrue, yPred)
+	cm := confusionMatrixFor(yTrue, yPred, labels)
+
+	data := make(map[string]any, len(labels))
+	for j, predLabel := range labels {
+		col := make([]int64, len(labels))
+		for i := range labels {
+			col[i] = int64(cm[i][j])
+		}
+		data[predLabel] = col
+	}
+
+	df, err := dataframe.New(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	df = df.Select(labels...)
+	if err := df.SetIndex(dataframe.NewStringIndex(labels)); err != nil {
+		return nil, nil, err
+	}
+
+	return df, labels, nil
+}
+
+// confusionMatrixFor counts label pairs, indexing rows and columns by labels.
+func confusionMatrixFor(yTrue, yPred *seriesPkg.Series[any], labels []string) [][]int {
 	n := len(labels)
 	
 	// Create label to index mapping
@@ -133,6 +194,71 @@ func ConfusionMatrix(yTrue, yPred *seriesPkg.Series[any]) [][]int {
 	return cm
 }
 
+// CohenKappa calculates Cohen's kappa, the agreement between yTrue and yPred
+// corrected for the agreement expected by chance. 1 is perfect agreement and
+// 0 is chance level. Returns 0 when chance agreement is already perfect.
+func CohenKappa(yTrue, yPred *seriesPkg.Series[any]) float64 {
+	cm := ConfusionMatrix(yTrue, yPred)
+	trueCounts, predCounts, correct, total := confusionTotals(cm)
+	if total == 0 {
+		return 0
+	}
+	
+	n := float64(total)
+	observed := float64(correct) / n
+	expected := 0.0
+	for k := range cm {
+		expected += float64(trueCounts[k]) * float64(predCounts[k]) / (n * n)
+	}
+	
+	if expected == 1 {
+		return 0
+	}
+	return (observed - expected) / (1 - expected)
+}
+
+// MatthewsCorrCoef calculates the Matthews correlation coefficient, a
+// balanced measure that stays informative for imbalanced classes. For two
+// classes it is (TP·TN − FP·FN) / sqrt((TP+FP)(TP+FN)(TN+FP)(TN+FN)); more
+// classes use the multiclass generalization. Returns 0 when the denominator
+// is zero, such as when only one class is predicted.
+func MatthewsCorrCoef(yTrue, yPred *seriesPkg.Series[any]) float64 {
+	cm := ConfusionMatrix(yTrue, yPred)
+	trueCounts, predCounts, correct, total := confusionTotals(cm)
+	
+	s := float64(total)
+	var sumPT, sumPP, sumTT float64
+	for k := range cm {
+		sumPT += float64(predCounts[k]) * float64(trueCounts[k])
+		sumPP += float64(predCounts[k]) * float64(predCounts[k])
+		sumTT += float64(trueCounts[k]) * float64(trueCounts[k])
+	}
+	
+	denom := math.Sqrt((s*s - sumPP) * (s*s - sumTT))
+	if denom == 0 {
+		return 0
+	}
+	return (float64(correct)*s - sumPT) / denom
+}
+
+// confusionTotals returns the row sums (true counts), column sums (predicted
+// counts), trace, and grand total of a confusion matrix.
+func confusionTotals(cm [][]int) ([]int, []int, int, int) {
+	trueCounts := make([]int, len(cm))
+	predCounts := make([]int, len(cm))
+	correct := 0
+	total := 0
+	for i := range cm {
+		for j, count := range cm[i] {
+			trueCounts[i] += count
+			predCounts[j] += count
+			total += count
+		}
+		correct += cm[i][i]
+	}
+	return trueCounts, predCounts, correct, total
+}
+
 // ClassificationReportFunc generates a comprehensive classification report.
 func ClassificationReportFunc(yTrue, yPred *seriesPkg.Series[any]) ClassificationReport {
 	labels := getUniqueLabels(yTrue, yPred)
@@ -192,6 +318,52 @@ func ClassificationReportFunc(yTrue, yPred *seriesPkg.Series[any]) Classificatio
 	return report
 }
 
+// logLossEpsilon bounds probabilities away from 0 and 1 in LogLoss.
+const logLossEpsilon = 1e-15
+
+// LogLoss calculates the average negative log-likelihood of the true labels
+// under predicted class probabilities, as returned by PredictProba.
+// yProba has one column per class, named by the class label in its
+// fmt.Sprint form, and one row per sample. Probabilities are clipped to
+// [1e-15, 1-1e-15] so confident mistakes give a large but finite loss.
+// Rows with a null true label are skipped.
+func LogLoss(yTrue *seriesPkg.Series[any], yProba *dataframe.DataFrame) (float64, error) {
+	if yTrue.Len() != yProba.Nrows() {
+		return 0, fmt.Errorf("y_true has length %d, y_proba has %d rows: %w", yTrue.Len(), yProba.Nrows(), core.ErrInvalidShape)
+	}
+	
+	sum := 0.0
+	count := 0
+	
+	for i := 0; i < yTrue.Len(); i++ {
+		trueVal, ok := yTrue.Get(i)
+		if !ok || trueVal == nil {
+			continue
+		}
+		
+		label := fmt.Sprint(trueVal)
+		col, err := yProba.Column(label)
+		if err != nil {
+			return 0, fmt.Errorf("no probability column for class %q: %w", label, err)
+		}
+		
+		probaVal, ok := col.Get(i)
+		if !ok || probaVal == nil {
+			return 0, fmt.Errorf("probability for class %q at row %d: %w", label, i, core.ErrNullValue)
+		}
+		
+		p, _ := core.ToFloat64(probaVal)
+		p = math.Max(logLossEpsilon, math.Min(1-logLossEpsilon, p))
+		sum -= math.Log(p)
+		count++
+	}
+	
+	if count == 0 {
+		return 0, core.ErrEmptySeries
+	}
+	return sum / float64(count), nil
+}
+
 // MSE calculates the Mean Squared Error.
 func MSE(yTrue, yPred *seriesPkg.Series[any]) float64 {
 	if yTrue.Len() != yPred.Len() {
@@ -209,8 +381,8 @@ func MSE(yTrue, yPred *seriesPkg.Series[any]) float64 {
 			continue
 		}
 		
-		trueFloat := toFloat64Metrics(trueVal)
-		predFloat := toFloat64Metrics(predVal)
+		trueFloat, _ := core.ToFloat64(trueVal)
+		predFloat, _ := core.ToFloat64(predVal)
 		
 		diff := trueFloat - predFloat
 		sumSq += diff * diff
@@ -245,8 +417,8 @@ func MAE(yTrue, yPred *seriesPkg.Series[any]) float64 {
 			continue
 		}
 		
-		trueFloat := toFloat64Metrics(trueVal)
-		predFloat := toFloat64Metrics(predVal)
+		trueFloat, _ := core.ToFloat64(trueVal)
+		predFloat, _ := core.ToFloat64(predVal)
 		
 		sumAbs += math.Abs(trueFloat - predFloat)
 		count++
@@ -266,7 +438,8 @@ func R2Score(yTrue, yPred *seriesPkg.Series[any]) float64 {
 	for i := 0; i < yTrue.Len(); i++ {
 		val, ok := yTrue.Get(i)
 		if ok && val != nil {
-			sum += toFloat64Metrics(val)
+			f, _ := core.ToFloat64(val)
+			sum += f
 			count++
 		}
 	}
@@ -285,8 +458,8 @@ func R2Score(yTrue, yPred *seriesPkg.Series[any]) float64 {
 			continue
 		}
 		
-		trueFloat := toFloat64Metrics(trueVal)
-		predFloat := toFloat64Metrics(predVal)
+		trueFloat, _ := core.ToFloat64(trueVal)
+		predFloat, _ := core.ToFloat64(predVal)
 		
 		diffRes := trueFloat - predFloat
 		ssRes += diffRes * diffRes
@@ -313,6 +486,110 @@ func AdjustedR2(yTrue, yPred *seriesPkg.Series[any], nFeatures int) float64 {
 	return 1 - ((1 - r2) * float64(n-1) / float64(n-p-1))
 }
 
+// MAPE calculates the Mean Absolute Percentage Error as a fraction
+// (0.1 means 10%). Pairs whose true value is zero have no defined
+// percentage error and are skipped. Returns NaN if no pairs remain.
+func MAPE(yTrue, yPred *seriesPkg.Series[any]) float64 {
+	if yTrue.Len() != yPred.Len() {
+		return math.NaN()
+	}
+	
+	sum := 0.0
+	count := 0
+	
+	for i := 0; i < yTrue.Len(); i++ {
+		trueVal, ok1 := yTrue.Get(i)
+		predVal, ok2 := yPred.Get(i)
+		
+		if !ok1 || !ok2 || trueVal == nil || predVal == nil {
+			continue
+		}
+		
+		trueFloat, _ := core.ToFloat64(trueVal)
+		if trueFloat == 0 {
+			continue
+		}
+		predFloat, _ := core.ToFloat64(predVal)
+		
+		sum += math.Abs((trueFloat - predFloat) / trueFloat)
+		count++
+	}
+	
+	if count == 0 {
+		return math.NaN()
+	}
+	return sum / float64(count)
+}
+
+// SMAPE calculates the Symmetric Mean Absolute Percentage Error as a fraction
+// in [0, 2], using the denominator (|y_true| + |y_pred|) / 2. Pairs where both
+// values are zero count as a perfect prediction.
+func SMAPE(yTrue, yPred *seriesPkg.Series[any]) float64 {
+	if yTrue.Len() != yPred.Len() {
+		return math.NaN()
+	}
+	
+	sum := 0.0
+	count := 0
+	
+	for i := 0; i < yTrue.Len(); i++ {
+		trueVal, ok1 := yTrue.Get(i)
+		predVal, ok2 := yPred.Get(i)
+		
+		if !ok1 || !ok2 || trueVal == nil || predVal == nil {
+			continue
+		}
+		
+		trueFloat, _ := core.ToFloat64(trueVal)
+		predFloat, _ := core.ToFloat64(predVal)
+		
+		denom := (math.Abs(trueFloat) + math.Abs(predFloat)) / 2
+		if denom > 0 {
+			sum += math.Abs(trueFloat-predFloat) / denom
+		}
+		count++
+	}
+	
+	if count == 0 {
+		return math.NaN()
+	}
+	return sum / float64(count)
+}
+
+// MedianAbsoluteError calculates the median of the absolute errors,
+// which is robust to outliers.
+func MedianAbsoluteError(yTrue, yPred *seriesPkg.Series[any]) float64 {
+	if yTrue.Len() != yPred.Len() {
+		return math.NaN()
+	}
+	
+	errors := make([]float64, 0, yTrue.Len())
+	
+	for i := 0; i < yTrue.Len(); i++ {
+		trueVal, ok1 := yTrue.Get(i)
+		predVal, ok2 := yPred.Get(i)
+		
+		if !ok1 || !ok2 || trueVal == nil || predVal == nil {
+			continue
+		}
+		
+		trueFloat, _ := core.ToFloat64(trueVal)
+		predFloat, _ := core.ToFloat64(predVal)
+		errors = append(errors, math.Abs(trueFloat-predFloat))
+	}
+	
+	if len(errors) == 0 {
+		return math.NaN()
+	}
+	
+	sort.Float64s(errors)
+	mid := len(errors) / 2
+	if len(errors)%2 == 0 {
+		return (errors[mid-1] + errors[mid]) / 2
+	}
+	return errors[mid]
+}
+
 // Helper functions
 
 func confusionMatrixMap(yTrue, yPred *seriesPkg.Series[any]) map[string]map[string]int {
@@ -354,26 +631,92 @@ func getUniqueLabels(series ...*seriesPkg.Series[any]) []string {
 	for label := range labelSet {
 		labels = append(labels, label)
 	}
+	sortLabels(labels)
 	
 	return labels
 }
 
-func binaryPrecision(cm map[string]map[string]int) float64 {
-	// Assumes binary classification with labels that can be converted to positive/negative
-	// Simplified: use first label as positive class
+// cmLabels returns the true labels of a confusion matrix map in sorted order.
+func cmLabels(cm map[string]map[string]int) []string {
 	labels := make([]string, 0, len(cm))
 	for label := range cm {
 		labels = append(labels, label)
 	}
-	if len(labels) < 2 {
-		return 0
+	sortLabels(labels)
+	return labels
+}
+
+// sortLabels sorts labels numerically if they all parse as numbers,
+// and lexicographically otherwise, so "2" sorts before "10".
+func sortLabels(labels []string) {
+	values := make([]float64, len(labels))
+	numeric := true
+	for i, label := range labels {
+		v, err := strconv.ParseFloat(label, 64)
+		if err != nil || math.IsNaN(v) {
+			numeric = false
+			break
+		}
+		values[i] = v
+	}
+	
+	if !numeric {
+		sort.Strings(labels)
+		return
+	}
+	
+	sort.Sort(numericLabels{labels: labels, values: values})
+}
+
+// numericLabels sorts labels by their parsed values.
+type numericLabels struct {
+	labels []string
+	values []float64
+}
+
+func (n numericLabels) Len() int { return len(n.labels) }
+
+func (n numericLabels) Less(i, j int) bool {
+	if n.values[i] != n.values[j] {
+		return n.values[i] < n.values[j]
+	}
+	return n.labels[i] < n.labels[j]
+}
+
+func (n numericLabels) Swap(i, j int) {
+	n.labels[i], n.labels[j] = n.labels[j], n.labels[i]
+	n.values[i], n.values[j] = n.values[j], n.values[i]
+}
+
+// positiveLabel returns the positive class for binary metrics.
+func positiveLabel(yTrue, yPred *seriesPkg.Series[any], opts []MetricOption) string {
+	metricOpts := &MetricOptions{}
+	for _, opt := range opts {
+		opt(metricOpts)
+	}
+	if metricOpts.posLabel != "" {
+		return metricOpts.posLabel
 	}
 	
-	posLabel := labels[0]
+	labels := getUniqueLabels(yTrue, yPred)
+	for _, preferred := range []string{"1", "true"} {
+		for _, label := range labels {
+			if label == preferred {
+				return label
+			}
+		}
+	}
+	if len(labels) == 0 {
+		return ""
+	}
+	return labels[len(labels)-1]
+}
+
+func binaryPrecision(cm map[string]map[string]int, posLabel string) float64 {
 	tp := cm[posLabel][posLabel]
 	
 	fp := 0
-	for _, label := range labels {
+	for _, label := range cmLabels(cm) {
 		if label != posLabel {
 			fp += cm[label][posLabel]
 		}
@@ -385,22 +728,13 @@ func binaryPrecision(cm map[string]map[string]int) float64 {
 	return float64(tp) / float64(tp+fp)
 }
 
-func binaryRecall(cm map[string]map[string]int) float64 {
-	labels := make([]string, 0, len(cm))
-	for label := range cm {
-		labels = append(labels, label)
-	}
-	if len(labels) < 2 {
-		return 0
-	}
-	
-	posLabel := labels[0]
+func binaryRecall(cm map[string]map[string]int, posLabel string) float64 {
 	tp := cm[posLabel][posLabel]
 	
 	fn := 0
-	for _, label := range labels {
+	for label, count := range cm[posLabel] {
 		if label != posLabel {
-			fn += cm[posLabel][label]
+			fn += count
 		}
 	}
 	
@@ -436,10 +770,7 @@ func microRecall(cm map[string]map[string]int) float64 {
 }
 
 func macroPrecision(cm map[string]map[string]int) float64 {
-	labels := make([]string, 0, len(cm))
-	for label := range cm {
-		labels = append(labels, label)
-	}
+	labels := cmLabels(cm)
 	
 	sum := 0.0
 	for _, label := range labels {
@@ -463,10 +794,7 @@ func macroPrecision(cm map[string]map[string]int) float64 {
 }
 
 func macroRecall(cm map[string]map[string]int) float64 {
-	labels := make([]string, 0, len(cm))
-	for label := range cm {
-		labels = append(labels, label)
-	}
+	labels := cmLabels(cm)
 	
 	sum := 0.0
 	for _, label := range labels {
@@ -499,10 +827,7 @@ func weightedPrecision(cm map[string]map[string]int, yTrue *seriesPkg.Series[any
 		}
 	}
 	
-	labels := make([]string, 0, len(cm))
-	for label := range cm {
-		labels = append(labels, label)
-	}
+	labels := cmLabels(cm)
 	
 	weightedSum := 0.0
 	totalSupport := 0
@@ -538,10 +863,7 @@ func weightedRecall(cm map[string]map[string]int, yTrue *seriesPkg.Series[any])
 		}
 	}
 	
-	labels := make([]string, 0, len(cm))
-	for label := range cm {
-		labels = append(labels, label)
-	}
+	labels := cmLabels(cm)
 	
 	weightedSum := 0.0
 	totalSupport := 0
@@ -568,23 +890,3 @@ func weightedRecall(cm map[string]map[string]int, yTrue *seriesPkg.Series[any])
 	return weightedSum / float64(totalSupport)
 }
 
-func toFloat64Metrics(val any) float64 {
-	switch v := val.(type) {
-	case float64:
-		return v
-	case float32:
-		return float64(v)
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	case int32:
-		return float64(v)
-	case int16:
-		return float64(v)
-	case int8:
-		return float64(v)
-	default:
-		return 0
-	}
-}
@@ -0,0 +1,245 @@
+package models
+
+import (
+	"math"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+	seriesPkg "github.com/TIVerse/GopherData/series"
+)
+
+func labelSeries(labels ...string) *seriesPkg.Series[any] {
+	data := make([]any, len(labels))
+	for i, l := range labels {
+		data[i] = l
+	}
+	return seriesPkg.New("y", data, core.DtypeString)
+}
+
+func TestConfusionMatrixDF(t *testing.T) {
+	yTrue := labelSeries("cat", "cat", "dog", "dog", "dog", "bird", "bird")
+	yPred := labelSeries("cat", "dog", "dog", "dog", "bird", "bird", "cat")
+
+	df, labels, err := ConfusionMatrixDF(yTrue, yPred)
+	if err != nil {
+		t.Fatalf("ConfusionMatrixDF failed: %v", err)
+	}
+
+	expectedLabels := []string{"bird", "cat", "dog"}
+	if len(labels) != len(expectedLabels) {
+		t.Fatalf("Expected labels %v, got %v", expectedLabels, labels)
+	}
+	for i := range expectedLabels {
+		if labels[i] != expectedLabels[i] {
+			t.Errorf("Label %d: expected %s, got %s", i, expectedLabels[i], labels[i])
+		}
+	}
+	if cols := df.Columns(); len(cols) != 3 || cols[0] != "bird" || cols[2] != "dog" {
+		t.Errorf("Expected predicted-label columns in label order, got %v", cols)
+	}
+
+	// Two dogs predicted as dog, one as bird
+	row, err := df.Loc("dog")
+	if err != nil {
+		t.Fatalf("Loc failed: %v", err)
+	}
+	expected := map[string]int64{"bird": 1, "cat": 0, "dog": 2}
+	for pred, want := range expected {
+		col, _ := row.Column(pred)
+		if v, _ := col.Get(0); v != want {
+			t.Errorf("Cell (dog, %s): expected %d, got %v", pred, want, v)
+		}
+	}
+
+	if _, _, err := ConfusionMatrixDF(yTrue, labelSeries("cat")); err == nil {
+		t.Error("Expected error for mismatched lengths")
+	}
+}
+
+func TestGetUniqueLabelsOrder(t *testing.T) {
+	numeric := labelSeries("10", "2", "1", "2", "10", "3")
+	expected := []string{"1", "2", "3", "10"}
+	for run := 0; run < 20; run++ {
+		labels := getUniqueLabels(numeric)
+		if len(labels) != len(expected) {
+			t.Fatalf("Expected %v, got %v", expected, labels)
+		}
+		for i := range expected {
+			if labels[i] != expected[i] {
+				t.Fatalf("Run %d: expected %v, got %v", run, expected, labels)
+			}
+		}
+	}
+
+	mixed := getUniqueLabels(labelSeries("b", "10", "a", "2"))
+	if mixed[0] != "10" || mixed[1] != "2" || mixed[2] != "a" || mixed[3] != "b" {
+		t.Errorf("Expected lexicographic order for non-numeric labels, got %v", mixed)
+	}
+
+	// The confusion matrix follows the same order
+	yTrue := labelSeries("2", "10", "10", "1")
+	yPred := labelSeries("2", "10", "1", "1")
+	cm := ConfusionMatrix(yTrue, yPred)
+	// Rows/columns: 1, 2, 10
+	if cm[2][0] != 1 || cm[2][2] != 1 || cm[0][0] != 1 || cm[1][1] != 1 {
+		t.Errorf("Unexpected confusion matrix %v", cm)
+	}
+}
+
+func TestBinaryPosLabel(t *testing.T) {
+	// Class "1": tp=2, fp=1, fn=1. Class "0": tp=1, fp=1, fn=1.
+	yTrue := labelSeries("1", "1", "1", "0", "0")
+	yPred := labelSeries("1", "1", "0", "1", "0")
+
+	for run := 0; run < 10; run++ {
+		if p := Precision(yTrue, yPred, "binary"); !almostEqualMetric(p, 2.0/3.0) {
+			t.Fatalf("Expected default positive class \"1\" precision 2/3, got %f", p)
+		}
+	}
+
+	if p := Precision(yTrue, yPred, "binary", PosLabel("0")); !almostEqualMetric(p, 0.5) {
+		t.Errorf("Expected precision 1/2 for positive class \"0\", got %f", p)
+	}
+	if r := Recall(yTrue, yPred, "binary", PosLabel("0")); !almostEqualMetric(r, 0.5) {
+		t.Errorf("Expected recall 1/2 for positive class \"0\", got %f", r)
+	}
+	if r := Recall(yTrue, yPred, "binary"); !almostEqualMetric(r, 2.0/3.0) {
+		t.Errorf("Expected recall 2/3 for positive class \"1\", got %f", r)
+	}
+	if f := F1Score(yTrue, yPred, "binary", PosLabel("0")); !almostEqualMetric(f, 0.5) {
+		t.Errorf("Expected F1 1/2 for positive class \"0\", got %f", f)
+	}
+
+	// Without "1" or "true", the largest label is positive
+	yTrue = labelSeries("spam", "ham", "spam")
+	yPred = labelSeries("spam", "spam", "ham")
+	if p := Precision(yTrue, yPred, "binary"); !almostEqualMetric(p, 0.5) {
+		t.Errorf("Expected precision 1/2 for positive class \"spam\", got %f", p)
+	}
+}
+
+func almostEqualMetric(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func floatSeries(values ...any) *seriesPkg.Series[any] {
+	return seriesPkg.New("y", values, core.DtypeFloat64)
+}
+
+func TestPercentageErrors(t *testing.T) {
+	yTrue := floatSeries(100.0, 200.0, 0.0, 50.0, nil)
+	yPred := floatSeries(110.0, 150.0, 5.0, 50.0, 10.0)
+
+	// Zero target skipped: (0.1 + 0.25 + 0) / 3
+	if got := MAPE(yTrue, yPred); !almostEqualMetric(got, 0.35/3) {
+		t.Errorf("MAPE: expected %f, got %f", 0.35/3, got)
+	}
+
+	// 10/105, 50/175, 5/2.5, 0
+	want := (10.0/105.0 + 50.0/175.0 + 2.0 + 0) / 4
+	if got := SMAPE(yTrue, yPred); !almostEqualMetric(got, want) {
+		t.Errorf("SMAPE: expected %f, got %f", want, got)
+	}
+	if got := SMAPE(floatSeries(0.0), floatSeries(0.0)); got != 0 {
+		t.Errorf("SMAPE of zero pair: expected 0, got %f", got)
+	}
+
+	// Absolute errors 10, 50, 5, 0 -> median 7.5
+	if got := MedianAbsoluteError(yTrue, yPred); !almostEqualMetric(got, 7.5) {
+		t.Errorf("MedianAbsoluteError: expected 7.5, got %f", got)
+	}
+
+	if got := MAPE(floatSeries(0.0, 0.0), floatSeries(1.0, 2.0)); !math.IsNaN(got) {
+		t.Errorf("MAPE with only zero targets: expected NaN, got %f", got)
+	}
+}
+
+func TestLogLoss(t *testing.T) {
+	yTrue := labelSeries("a", "b", "c", "a")
+	proba, _ := dataframe.New(map[string]any{
+		"a": []float64{0.7, 0.1, 0.2, 1.0},
+		"b": []float64{0.2, 0.8, 0.3, 0.0},
+		"c": []float64{0.1, 0.1, 0.5, 0.0},
+	})
+
+	// The last row's probability of 1 is clipped to 1-1e-15
+	want := -(math.Log(0.7) + math.Log(0.8) + math.Log(0.5) + math.Log(1-1e-15)) / 4
+	got, err := LogLoss(yTrue, proba)
+	if err != nil {
+		t.Fatalf("LogLoss failed: %v", err)
+	}
+	if math.Abs(got-want) > 1e-9 {
+		t.Errorf("Expected %f, got %f", want, got)
+	}
+
+	// A confident mistake stays finite
+	binary, _ := dataframe.New(map[string]any{
+		"0": []float64{1.0},
+		"1": []float64{0.0},
+	})
+	yBinary := seriesPkg.New("y", []any{int64(1)}, core.DtypeInt64)
+	got, err = LogLoss(yBinary, binary)
+	if err != nil {
+		t.Fatalf("LogLoss failed: %v", err)
+	}
+	if math.IsInf(got, 0) || !almostEqualMetric(got, -math.Log(1e-15)) {
+		t.Errorf("Expected clipped loss %f, got %f", -math.Log(1e-15), got)
+	}
+
+	if _, err := LogLoss(labelSeries("z"), binary); err == nil {
+		t.Error("Expected error for a class without a probability column")
+	}
+	if _, err := LogLoss(labelSeries("a", "b"), binary); err == nil {
+		t.Error("Expected error for mismatched row count")
+	}
+}
+
+// binaryLabels builds label series with the given 2x2 confusion counts,
+// using "1" as the positive class.
+func binaryLabels(tp, fn, fp, tn int) (*seriesPkg.Series[any], *seriesPkg.Series[any]) {
+	var yTrue, yPred []string
+	add := func(n int, truth, pred string) {
+		for i := 0; i < n; i++ {
+			yTrue = append(yTrue, truth)
+			yPred = append(yPred, pred)
+		}
+	}
+	add(tp, "1", "1")
+	add(fn, "1", "0")
+	add(fp, "0", "1")
+	add(tn, "0", "0")
+	return labelSeries(yTrue...), labelSeries(yPred...)
+}
+
+func TestCohenKappaAndMCC(t *testing.T) {
+	tests := []struct {
+		tp, fn, fp, tn int
+		kappa, mcc     float64
+	}{
+		// po = 0.7, pe = 0.5 -> kappa 0.4; MCC = (20*15-5*10)/sqrt(25*30*20*25) = 250/sqrt(375000)
+		{20, 10, 5, 15, 0.4, 250 / math.Sqrt(375000)},
+		// Perfect agreement
+		{5, 0, 0, 5, 1, 1},
+		// Complete disagreement
+		{0, 5, 5, 0, -1, -1},
+	}
+	for _, tt := range tests {
+		yTrue, yPred := binaryLabels(tt.tp, tt.fn, tt.fp, tt.tn)
+		if got := CohenKappa(yTrue, yPred); !almostEqualMetric(got, tt.kappa) {
+			t.Errorf("%v: expected kappa %f, got %f", tt, tt.kappa, got)
+		}
+		if got := MatthewsCorrCoef(yTrue, yPred); !almostEqualMetric(got, tt.mcc) {
+			t.Errorf("%v: expected MCC %f, got %f", tt, tt.mcc, got)
+		}
+	}
+
+	// Only one class predicted: the MCC denominator is zero
+	yTrue, yPred := binaryLabels(3, 0, 2, 0)
+	if got := MatthewsCorrCoef(yTrue, yPred); got != 0 {
+		t.Errorf("Expected MCC 0 for a constant prediction, got %f", got)
+	}
+	if got := CohenKappa(yTrue, yPred); got != 0 {
+		t.Errorf("Expected kappa 0 for a constant prediction, got %f", got)
+	}
+}
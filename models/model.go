@@ -2,17 +2,16 @@
 package models
 
 import (
+	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
 	seriesPkg "github.com/TIVerse/GopherData/series"
 )
 
-// Model is the base interface for all ML models.
+// Model is the base interface for all supervised ML models: a
+// core.Predictor over DataFrames and Series, so generic utilities written
+// against core accept any model.
 type Model interface {
-	// Fit trains the model on data X with target y
-	Fit(X *dataframe.DataFrame, y *seriesPkg.Series[any]) error
-	
-	// Predict makes predictions on new data X
-	Predict(X *dataframe.DataFrame) (*seriesPkg.Series[any], error)
+	core.Predictor[*dataframe.DataFrame, *seriesPkg.Series[any]]
 }
 
 // Classifier adds classification-specific methods.
@@ -42,12 +41,11 @@ type Clusterer interface {
 
 // Transformer is the interface for dimensionality reduction and feature extraction.
 type Transformer interface {
+	core.Transformer[*dataframe.DataFrame]
+	
 	// Fit learns the transformation from data X
 	Fit(X *dataframe.DataFrame) error
 	
-	// Transform applies the learned transformation to X
-	Transform(X *dataframe.DataFrame) (*dataframe.DataFrame, error)
-	
 	// FitTransform fits and transforms in one step
 	FitTransform(X *dataframe.DataFrame) (*dataframe.DataFrame, error)
 }
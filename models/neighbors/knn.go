@@ -0,0 +1,348 @@
+// Package neighbors provides nearest-neighbor models.
+package neighbors
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/models/cluster"
+	"github.com/TIVerse/GopherData/models/internal/modelutil"
+	seriesPkg "github.com/TIVerse/GopherData/series"
+)
+
+// KNeighborsClassifier predicts the class most common among the k nearest
+// training samples by Euclidean distance.
+//
+// Ties are broken deterministically: training samples at the same distance
+// are ranked in training order, and if several classes get the same vote
+// the one that sorts first (as a string) wins.
+type KNeighborsClassifier struct {
+	// K is the number of neighbors that vote.
+	K int
+
+	// Weights is "uniform" (each neighbor counts once) or "distance" (each
+	// neighbor counts 1/distance; neighbors at distance 0 take all of the
+	// vote).
+	Weights string
+
+	// featureNames stores the names of features seen during Fit
+	featureNames []string
+
+	// features stores the training samples
+	features [][]float64
+
+	// labels stores the training class of each sample
+	labels []string
+
+	// classes stores the sorted unique class labels
+	classes []string
+
+	// fitted indicates whether the model has been fitted
+	fitted bool
+}
+
+// NewKNeighborsClassifier creates a k-nearest-neighbors classifier.
+// k <= 0 uses 5 and an empty weights uses "uniform".
+func NewKNeighborsClassifier(k int, weights string) *KNeighborsClassifier {
+	if k <= 0 {
+		k = 5
+	}
+	if weights == "" {
+		weights = "uniform"
+	}
+	return &KNeighborsClassifier{
+		K:       k,
+		Weights: weights,
+		fitted:  false,
+	}
+}
+
+// Fit stores the training samples and their classes.
+func (kn *KNeighborsClassifier) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[any]) error {
+	if err := checkWeights(kn.Weights); err != nil {
+		return err
+	}
+
+	features, names, err := modelutil.ExtractFeatures(X, nil)
+	if err != nil {
+		return err
+	}
+	if len(features) != y.Len() {
+		return fmt.Errorf("X and y must have same length")
+	}
+
+	labels := make([]string, y.Len())
+	classSet := make(map[string]bool)
+	for i := range labels {
+		val, ok := y.Get(i)
+		if !ok || val == nil {
+			return fmt.Errorf("target contains null at index %d", i)
+		}
+		labels[i] = fmt.Sprint(val)
+		classSet[labels[i]] = true
+	}
+
+	kn.classes = make([]string, 0, len(classSet))
+	for class := range classSet {
+		kn.classes = append(kn.classes, class)
+	}
+	sort.Strings(kn.classes)
+
+	kn.features = features
+	kn.labels = labels
+	kn.featureNames = names
+	kn.fitted = true
+	return nil
+}
+
+// Predict returns the winning class of each sample's neighbors.
+func (kn *KNeighborsClassifier) Predict(X *dataframe.DataFrame) (*seriesPkg.Series[any], error) {
+	votes, err := kn.votes(X)
+	if err != nil {
+		return nil, err
+	}
+
+	predictions := make([]any, len(votes))
+	for i, vote := range votes {
+		best := 0
+		for c := range vote {
+			if vote[c] > vote[best] {
+				best = c
+			}
+		}
+		predictions[i] = kn.classes[best]
+	}
+
+	return seriesPkg.New("predictions", predictions, core.DtypeString), nil
+}
+
+// PredictProba returns each class's share of the neighbors' vote, with one
+// column per class in sorted order.
+func (kn *KNeighborsClassifier) PredictProba(X *dataframe.DataFrame) (*dataframe.DataFrame, error) {
+	votes, err := kn.votes(X)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]any, len(kn.classes))
+	for c, class := range kn.classes {
+		probs := make([]float64, len(votes))
+		for i, vote := range votes {
+			total := 0.0
+			for _, v := range vote {
+				total += v
+			}
+			probs[i] = vote[c] / total
+		}
+		data[class] = probs
+	}
+
+	df, err := dataframe.New(data)
+	if err != nil {
+		return nil, err
+	}
+	return df.Select(kn.classes...), nil
+}
+
+// Classes returns the class labels seen during Fit, sorted.
+func (kn *KNeighborsClassifier) Classes() []string {
+	return kn.classes
+}
+
+// votes returns the weighted vote for each class, per sample of X.
+func (kn *KNeighborsClassifier) votes(X *dataframe.DataFrame) ([][]float64, error) {
+	if !kn.fitted {
+		return nil, fmt.Errorf("model not fitted yet")
+	}
+
+	features, _, err := modelutil.ExtractFeatures(X, kn.featureNames)
+	if err != nil {
+		return nil, err
+	}
+
+	classIndex := make(map[string]int, len(kn.classes))
+	for c, class := range kn.classes {
+		classIndex[class] = c
+	}
+
+	votes := make([][]float64, len(features))
+	for i, sample := range features {
+		nearest, err := kNearest(kn.features, sample, kn.K)
+		if err != nil {
+			return nil, err
+		}
+		weights := neighborWeights(nearest, kn.Weights)
+
+		votes[i] = make([]float64, len(kn.classes))
+		for j, nb := range nearest {
+			votes[i][classIndex[kn.labels[nb.index]]] += weights[j]
+		}
+	}
+
+	return votes, nil
+}
+
+// KNeighborsRegressor predicts the mean target of the k nearest training
+// samples by Euclidean distance, optionally weighted by inverse distance.
+// Training samples at the same distance are ranked in training order.
+type KNeighborsRegressor struct {
+	// K is the number of neighbors averaged.
+	K int
+
+	// Weights is "uniform" or "distance", as for KNeighborsClassifier.
+	Weights string
+
+	// featureNames stores the names of features seen during Fit
+	featureNames []string
+
+	// features stores the training samples
+	features [][]float64
+
+	// targets stores the training target of each sample
+	targets []float64
+
+	// fitted indicates whether the model has been fitted
+	fitted bool
+}
+
+// NewKNeighborsRegressor creates a k-nearest-neighbors regressor.
+// k <= 0 uses 5 and an empty weights uses "uniform".
+func NewKNeighborsRegressor(k int, weights string) *KNeighborsRegressor {
+	if k <= 0 {
+		k = 5
+	}
+	if weights == "" {
+		weights = "uniform"
+	}
+	return &KNeighborsRegressor{
+		K:       k,
+		Weights: weights,
+		fitted:  false,
+	}
+}
+
+// Fit stores the training samples and their targets.
+func (kn *KNeighborsRegressor) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[any]) error {
+	if err := checkWeights(kn.Weights); err != nil {
+		return err
+	}
+
+	features, names, err := modelutil.ExtractFeatures(X, nil)
+	if err != nil {
+		return err
+	}
+	if len(features) != y.Len() {
+		return fmt.Errorf("X and y must have same length")
+	}
+
+	targets := make([]float64, y.Len())
+	for i := range targets {
+		val, ok := y.Get(i)
+		if !ok || val == nil {
+			return fmt.Errorf("target contains null at index %d", i)
+		}
+		targets[i], _ = core.ToFloat64(val)
+	}
+
+	kn.features = features
+	kn.targets = targets
+	kn.featureNames = names
+	kn.fitted = true
+	return nil
+}
+
+// Predict returns the (weighted) mean target of each sample's neighbors.
+func (kn *KNeighborsRegressor) Predict(X *dataframe.DataFrame) (*seriesPkg.Series[any], error) {
+	if !kn.fitted {
+		return nil, fmt.Errorf("model not fitted yet")
+	}
+
+	features, _, err := modelutil.ExtractFeatures(X, kn.featureNames)
+	if err != nil {
+		return nil, err
+	}
+
+	predictions := make([]any, len(features))
+	for i, sample := range features {
+		nearest, err := kNearest(kn.features, sample, kn.K)
+		if err != nil {
+			return nil, err
+		}
+		weights := neighborWeights(nearest, kn.Weights)
+
+		var sum, total float64
+		for j, nb := range nearest {
+			sum += weights[j] * kn.targets[nb.index]
+			total += weights[j]
+		}
+		predictions[i] = sum / total
+	}
+
+	return seriesPkg.New("predictions", predictions, core.DtypeFloat64), nil
+}
+
+// Helper functions
+
+// neighbor is a training sample and its distance to a query point.
+type neighbor struct {
+	index int
+	dist  float64
+}
+
+// kNearest returns the k training samples closest to sample, nearest first.
+// Samples at equal distance keep their training order.
+func kNearest(train [][]float64, sample []float64, k int) ([]neighbor, error) {
+	if len(train) > 0 && len(sample) != len(train[0]) {
+		return nil, fmt.Errorf("feature count mismatch: got %d, fitted with %d", len(sample), len(train[0]))
+	}
+
+	all := make([]neighbor, len(train))
+	for i, point := range train {
+		all[i] = neighbor{index: i, dist: cluster.EuclideanDistance(point, sample)}
+	}
+	sort.SliceStable(all, func(a, b int) bool {
+		return all[a].dist < all[b].dist
+	})
+
+	if k > len(all) {
+		k = len(all)
+	}
+	return all[:k], nil
+}
+
+// neighborWeights returns the vote weight of each neighbor. Under "distance"
+// weighting, neighbors at distance 0 share all the weight.
+func neighborWeights(nearest []neighbor, weights string) []float64 {
+	result := make([]float64, len(nearest))
+	if weights == "distance" {
+		exact := false
+		for j, nb := range nearest {
+			if nb.dist == 0 {
+				result[j] = 1
+				exact = true
+			}
+		}
+		if exact {
+			return result
+		}
+		for j, nb := range nearest {
+			result[j] = 1 / nb.dist
+		}
+		return result
+	}
+
+	for j := range result {
+		result[j] = 1
+	}
+	return result
+}
+
+// checkWeights validates a weights setting.
+func checkWeights(weights string) error {
+	if weights != "uniform" && weights != "distance" {
+		return fmt.Errorf("weights %q must be 'uniform' or 'distance': %w", weights, core.ErrInvalidArgument)
+	}
+	return nil
+}
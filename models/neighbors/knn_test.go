@@ -0,0 +1,130 @@
+package neighbors
+
+import (
+	"math"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/models"
+	"github.com/TIVerse/GopherData/models/crossval"
+	seriesPkg "github.com/TIVerse/GopherData/series"
+)
+
+var (
+	_ models.Classifier = (*KNeighborsClassifier)(nil)
+	_ models.Regressor  = (*KNeighborsRegressor)(nil)
+)
+
+func points(xs, ys []float64) *dataframe.DataFrame {
+	df, _ := dataframe.New(map[string]any{"x": xs, "y": ys})
+	return df.Select("x", "y")
+}
+
+func TestKNeighborsClassifier(t *testing.T) {
+	X := points(
+		[]float64{0, 1, 0, 1, 10, 11, 10, 11},
+		[]float64{0, 0, 1, 1, 10, 10, 11, 11},
+	)
+	y := seriesPkg.New("label", []any{"a", "a", "a", "a", "b", "b", "b", "b"}, core.DtypeString)
+
+	model := NewKNeighborsClassifier(3, "uniform")
+	if err := model.Fit(X, y); err != nil {
+		t.Fatalf("Fit failed: %v", err)
+	}
+
+	pred, err := model.Predict(points([]float64{0.5, 10.5, 4}, []float64{0.5, 10.5, 4}))
+	if err != nil {
+		t.Fatalf("Predict failed: %v", err)
+	}
+	for i, want := range []string{"a", "b", "a"} {
+		if got := pred.GetUnsafe(i); got != want {
+			t.Errorf("prediction %d = %v, want %v", i, got, want)
+		}
+	}
+
+	proba, err := model.PredictProba(points([]float64{0.5}, []float64{0.5}))
+	if err != nil {
+		t.Fatalf("PredictProba failed: %v", err)
+	}
+	if cols := proba.Columns(); len(cols) != 2 || cols[0] != "a" || cols[1] != "b" {
+		t.Errorf("PredictProba columns = %v, want [a b]", cols)
+	}
+	if a, _ := proba.Column("a"); a.GetUnsafe(0) != 1.0 {
+		t.Errorf("P(a) = %v, want 1", a.GetUnsafe(0))
+	}
+}
+
+func TestKNeighborsClassifierTiesAndWeights(t *testing.T) {
+	X := points([]float64{0, 3, 4}, []float64{0, 0, 0})
+	y := seriesPkg.New("label", []any{"b", "a", "a"}, core.DtypeString)
+	query := points([]float64{1}, []float64{0})
+
+	// The two nearest are b (distance 1) and a (distance 2): a 1-1 vote,
+	// won by the class that sorts first
+	uniform := NewKNeighborsClassifier(2, "uniform")
+	_ = uniform.Fit(X, y)
+	pred, _ := uniform.Predict(query)
+	if got := pred.GetUnsafe(0); got != "a" {
+		t.Errorf("uniform tie = %v, want a", got)
+	}
+
+	// Weighted by 1/distance, b's vote (1) beats a's (0.5)
+	weighted := NewKNeighborsClassifier(2, "distance")
+	_ = weighted.Fit(X, y)
+	pred, _ = weighted.Predict(query)
+	if got := pred.GetUnsafe(0); got != "b" {
+		t.Errorf("distance-weighted = %v, want b", got)
+	}
+
+	if err := NewKNeighborsClassifier(2, "gaussian").Fit(X, y); err == nil {
+		t.Error("expected an error for unknown weights")
+	}
+}
+
+func TestKNeighborsRegressor(t *testing.T) {
+	X := points([]float64{0, 1, 2, 10}, []float64{0, 0, 0, 0})
+	y := seriesPkg.New("target", []any{1.0, 2.0, 3.0, 100.0}, core.DtypeFloat64)
+
+	uniform := NewKNeighborsRegressor(2, "uniform")
+	if err := uniform.Fit(X, y); err != nil {
+		t.Fatalf("Fit failed: %v", err)
+	}
+	pred, err := uniform.Predict(points([]float64{0.25, 2}, []float64{0, 0}))
+	if err != nil {
+		t.Fatalf("Predict failed: %v", err)
+	}
+	if got := pred.GetUnsafe(0); got != 1.5 {
+		t.Errorf("uniform prediction = %v, want 1.5", got)
+	}
+
+	weighted := NewKNeighborsRegressor(2, "distance")
+	_ = weighted.Fit(X, y)
+	pred, _ = weighted.Predict(points([]float64{0.25, 2}, []float64{0, 0}))
+	// Weights 1/0.25 and 1/0.75: (4*1 + 4/3*2) / (4 + 4/3) = 1.25
+	if got := pred.GetUnsafe(0).(float64); math.Abs(got-1.25) > 1e-12 {
+		t.Errorf("distance-weighted prediction = %v, want 1.25", got)
+	}
+	// An exact match takes all the weight
+	if got := pred.GetUnsafe(1); got != 3.0 {
+		t.Errorf("exact-match prediction = %v, want 3", got)
+	}
+}
+
+func TestKNeighborsCrossValidation(t *testing.T) {
+	X := points(
+		[]float64{0, 10, 1, 11, 0, 10, 1, 11, 0.5},
+		[]float64{0, 10, 0, 10, 1, 11, 1, 11, 0.5},
+	)
+	y := seriesPkg.New("label", []any{"a", "b", "a", "b", "a", "b", "a", "b", "a"}, core.DtypeString)
+
+	scores, err := crossval.CrossValScore(NewKNeighborsClassifier(1, "uniform"), X, y, crossval.NewKFold(3, false, 0), models.Accuracy)
+	if err != nil {
+		t.Fatalf("CrossValScore failed: %v", err)
+	}
+	for i, score := range scores {
+		if score != 1 {
+			t.Errorf("fold %d accuracy = %v, want 1", i, score)
+		}
+	}
+}
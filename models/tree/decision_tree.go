@@ -8,6 +8,7 @@ import (
 
 	"github.com/TIVerse/GopherData/core"
 	"github.com/TIVerse/GopherData/dataframe"
+	"github.com/TIVerse/GopherData/models/internal/modelutil"
 	seriesPkg "github.com/TIVerse/GopherData/series"
 )
 
@@ -22,6 +23,14 @@ type DecisionTree struct {
 	// Criterion for splitting: "gini", "entropy" (classification), "mse" (regression)
 	Criterion string
 	
+	// ClassWeight maps class labels to weights applied to impurity counts
+	// (classification only). Classes not present default to weight 1.
+	ClassWeight map[string]float64
+	
+	// ClassWeightBalanced weights classes inversely proportional to their
+	// frequency in the training data, overriding ClassWeight.
+	ClassWeightBalanced bool
+	
 	// root is the root node of the tree
 	root *treeNode
 	
@@ -34,8 +43,14 @@ type DecisionTree struct {
 	// classes stores unique class labels (for classification)
 	classes []string
 	
+	// featureNames stores the names of features seen during Fit
+	featureNames []string
+	
 	// featureImportances stores the importance of each feature
 	featureImportances []float64
+	
+	// weights stores per-sample weights while fitting (nil = unweighted)
+	weights []float64
 }
 
 // treeNode represents a node in the decision tree.
@@ -102,7 +117,7 @@ func NewDecisionTreeRegressor(maxDepth, minSamples int) *DecisionTree {
 // Fit trains the decision tree.
 func (dt *DecisionTree) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[any]) error {
 	// Extract features and target
-	features, err := extractFeaturesTree(X)
+	features, names, err := modelutil.ExtractFeatures(X, nil)
 	if err != nil {
 		return err
 	}
@@ -130,6 +145,10 @@ func (dt *DecisionTree) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[any]) er
 		for class := range classSet {
 			dt.classes = append(dt.classes, class)
 		}
+		sort.Strings(dt.classes)
+		
+		dt.weights = dt.sampleWeights(target)
+		defer func() { dt.weights = nil }()
 	}
 	
 	// Build tree
@@ -145,6 +164,7 @@ func (dt *DecisionTree) Fit(X *dataframe.DataFrame, y *seriesPkg.Series[any]) er
 	dt.featureImportances = make([]float64, nFeatures)
 	dt.calculateImportances(dt.root, len(features))
 	
+	dt.featureNames = names
 	dt.fitted = true
 	return nil
 }
@@ -226,9 +246,9 @@ func (dt *DecisionTree) findBestSplit(features [][]float64, target []any, indice
 			leftImpurity := dt.calculateImpurity(target, leftIndices)
 			rightImpurity := dt.calculateImpurity(target, rightIndices)
 			
-			n := float64(len(indices))
-			nLeft := float64(len(leftIndices))
-			nRight := float64(len(rightIndices))
+			n := dt.weightSum(indices)
+			nLeft := dt.weightSum(leftIndices)
+			nRight := dt.weightSum(rightIndices)
 			
 			gain := currentImpurity - (nLeft/n)*leftImpurity - (nRight/n)*rightImpurity
 			
@@ -265,17 +285,12 @@ func (dt *DecisionTree) calculateImpurity(target []any, indices []int) float64 {
 
 // giniImpurity calculates Gini impurity for classification.
 func (dt *DecisionTree) giniImpurity(target []any, indices []int) float64 {
-	counts := make(map[string]int)
-	for _, idx := range indices {
-		label := fmt.Sprint(target[idx])
-		counts[label]++
-	}
-	
-	n := float64(len(indices))
+	counts := dt.classCounts(target, indices)
+	n := dt.weightSum(indices)
 	gini := 1.0
 	
 	for _, count := range counts {
-		p := float64(count) / n
+		p := count / n
 		gini -= p * p
 	}
 	
@@ -284,18 +299,13 @@ func (dt *DecisionTree) giniImpurity(target []any, indices []int) float64 {
 
 // entropyImpurity calculates entropy for classification.
 func (dt *DecisionTree) entropyImpurity(target []any, indices []int) float64 {
-	counts := make(map[string]int)
-	for _, idx := range indices {
-		label := fmt.Sprint(target[idx])
-		counts[label]++
-	}
-	
-	n := float64(len(indices))
+	counts := dt.classCounts(target, indices)
+	n := dt.weightSum(indices)
 	entropy := 0.0
 	
 	for _, count := range counts {
 		if count > 0 {
-			p := float64(count) / n
+			p := count / n
 			entropy -= p * math.Log2(p)
 		}
 	}
@@ -312,20 +322,78 @@ func (dt *DecisionTree) mseImpurity(target []any, indices []int) float64 {
 	// Calculate mean
 	sum := 0.0
 	for _, idx := range indices {
-		sum += toFloat64Tree(target[idx])
+		f, _ := core.ToFloat64(target[idx])
+		sum += f
 	}
 	mean := sum / float64(len(indices))
 	
 	// Calculate MSE
 	mse := 0.0
 	for _, idx := range indices {
-		diff := toFloat64Tree(target[idx]) - mean
+		f, _ := core.ToFloat64(target[idx])
+		diff := f - mean
 		mse += diff * diff
 	}
 	
 	return mse / float64(len(indices))
 }
 
+// classCounts returns the weighted count of each class among indices.
+func (dt *DecisionTree) classCounts(target []any, indices []int) map[string]float64 {
+	counts := make(map[string]float64)
+	for _, idx := range indices {
+		counts[fmt.Sprint(target[idx])] += dt.weight(idx)
+	}
+	return counts
+}
+
+// weightSum returns the total sample weight of indices.
+func (dt *DecisionTree) weightSum(indices []int) float64 {
+	if dt.weights == nil {
+		return float64(len(indices))
+	}
+	sum := 0.0
+	for _, idx := range indices {
+		sum += dt.weights[idx]
+	}
+	return sum
+}
+
+// weight returns the weight of a single sample.
+func (dt *DecisionTree) weight(idx int) float64 {
+	if dt.weights == nil {
+		return 1
+	}
+	return dt.weights[idx]
+}
+
+// sampleWeights derives per-sample weights from ClassWeight or
+// ClassWeightBalanced. Returns nil when no weighting is configured.
+func (dt *DecisionTree) sampleWeights(target []any) []float64 {
+	if !dt.ClassWeightBalanced && len(dt.ClassWeight) == 0 {
+		return nil
+	}
+	
+	labels := make([]string, len(target))
+	for i, val := range target {
+		labels[i] = fmt.Sprint(val)
+	}
+	
+	classWeight := dt.ClassWeight
+	if dt.ClassWeightBalanced {
+		classWeight = modelutil.BalancedClassWeight(labels)
+	}
+	
+	weights := make([]float64, len(labels))
+	for i, label := range labels {
+		weights[i] = 1
+		if w, ok := classWeight[label]; ok {
+			weights[i] = w
+		}
+	}
+	return weights
+}
+
 // splitData splits indices based on feature and threshold.
 func (dt *DecisionTree) splitData(features [][]float64, indices []int, feature int, threshold float64) ([]int, []int) {
 	left := make([]int, 0)
@@ -360,17 +428,13 @@ func (dt *DecisionTree) isHomogeneous(target []any, indices []int) bool {
 // leafValue determines the prediction value for a leaf node.
 func (dt *DecisionTree) leafValue(target []any, indices []int) any {
 	if dt.isClassification {
-		// Return most common class
-		counts := make(map[string]int)
-		for _, idx := range indices {
-			label := fmt.Sprint(target[idx])
-			counts[label]++
-		}
+		// Return most common class (by weight), ties broken by class order
+		counts := dt.classCounts(target, indices)
 		
-		maxCount := 0
+		maxCount := 0.0
 		var mostCommon string
-		for label, count := range counts {
-			if count > maxCount {
+		for _, label := range dt.classes {
+			if count := counts[label]; count > maxCount {
 				maxCount = count
 				mostCommon = label
 			}
@@ -380,7 +444,8 @@ func (dt *DecisionTree) leafValue(target []any, indices []int) any {
 		// Return mean for regression
 		sum := 0.0
 		for _, idx := range indices {
-			sum += toFloat64Tree(target[idx])
+			f, _ := core.ToFloat64(target[idx])
+			sum += f
 		}
 		return sum / float64(len(indices))
 	}
@@ -392,7 +457,7 @@ func (dt *DecisionTree) Predict(X *dataframe.DataFrame) (*seriesPkg.Series[any],
 		return nil, fmt.Errorf("model not fitted yet")
 	}
 	
-	features, err := extractFeaturesTree(X)
+	features, _, err := modelutil.ExtractFeatures(X, dt.featureNames)
 	if err != nil {
 		return nil, err
 	}
@@ -461,62 +526,6 @@ func (dt *DecisionTree) calculateImportances(node *treeNode, totalSamples int) {
 
 // Helper functions
 
-func extractFeaturesTree(X *dataframe.DataFrame) ([][]float64, error) {
-	n := X.Nrows()
-	cols := X.Columns()
-	
-	numericCols := make([]string, 0)
-	for _, col := range cols {
-		series, err := X.Column(col)
-		if err != nil {
-			continue
-		}
-		if isNumericTree(series.Dtype()) {
-			numericCols = append(numericCols, col)
-		}
-	}
-	
-	if len(numericCols) == 0 {
-		return nil, fmt.Errorf("no numeric columns found")
-	}
-	
-	features := make([][]float64, n)
-	for i := range features {
-		features[i] = make([]float64, len(numericCols))
-	}
-	
-	for j, col := range numericCols {
-		series, _ := X.Column(col)
-		for i := 0; i < n; i++ {
-			val, ok := series.Get(i)
-			if ok && val != nil {
-				features[i][j] = toFloat64Tree(val)
-			}
-		}
-	}
-	
-	return features, nil
-}
-
-func isNumericTree(dtype core.Dtype) bool {
-	return dtype == core.DtypeFloat64 || dtype == core.DtypeInt64
-}
-
-func toFloat64Tree(val any) float64 {
-	switch v := val.(type) {
-	case float64:
-		return v
-	case float32:
-		return float64(v)
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	default:
-		return 0
-	}
-}
-
 func getUniqueSorted(values []float64) []float64 {
 	uniqueMap := make(map[float64]bool)
 	for _, v := range values {
@@ -1,6 +1,7 @@
 package tree
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/TIVerse/GopherData/core"
@@ -127,3 +128,84 @@ func TestDecisionTreeEntropy(t *testing.T) {
 	
 	t.Log("Entropy criterion test passed")
 }
+
+func TestDecisionTreeClassWeight(t *testing.T) {
+	// 40 majority samples spread over [0, 40) and 4 minority samples that
+	// overlap the upper end of the range. A depth-1 tree cannot isolate them.
+	x := make([]float64, 0, 44)
+	yData := make([]any, 0, 44)
+	for i := 0; i < 40; i++ {
+		x = append(x, float64(i))
+		yData = append(yData, "N")
+	}
+	for _, v := range []float64{30.5, 32.5, 34.5, 36.5} {
+		x = append(x, v)
+		yData = append(yData, "F")
+	}
+	X, _ := dataframe.New(map[string]any{"x": x})
+	y := seriesPkg.New("y", yData, core.DtypeString)
+	
+	minorityRecall := func(model *DecisionTree) float64 {
+		if err := model.Fit(X, y); err != nil {
+			t.Fatalf("Fit failed: %v", err)
+		}
+		predictions, err := model.Predict(X)
+		if err != nil {
+			t.Fatalf("Predict failed: %v", err)
+		}
+		hits := 0
+		for i := 40; i < 44; i++ {
+			if pred, _ := predictions.Get(i); pred == "F" {
+				hits++
+			}
+		}
+		return float64(hits) / 4
+	}
+	
+	unweighted := minorityRecall(NewDecisionTreeClassifier(1, 2, "gini"))
+	
+	balanced := NewDecisionTreeClassifier(1, 2, "gini")
+	balanced.ClassWeightBalanced = true
+	balancedRecall := minorityRecall(balanced)
+	
+	if balancedRecall <= unweighted {
+		t.Errorf("Expected balanced weighting to improve minority recall: unweighted=%.2f balanced=%.2f", unweighted, balancedRecall)
+	}
+	
+	t.Logf("Minority recall: unweighted=%.2f balanced=%.2f", unweighted, balancedRecall)
+}
+
+func TestDecisionTreePredictAlignsFeatures(t *testing.T) {
+	X, _ := dataframe.New(map[string]any{
+		"x1": []float64{0, 0, 0, 0, 1, 1, 1, 1},
+		"x2": []float64{0, 1, 0, 1, 0, 1, 0, 1},
+	})
+	X = X.Select("x1", "x2")
+	y := seriesPkg.New("y", []any{"A", "A", "A", "A", "B", "B", "B", "B"}, core.DtypeString)
+
+	model := NewDecisionTreeClassifier(3, 2, "gini")
+	if err := model.Fit(X, y); err != nil {
+		t.Fatalf("Fit failed: %v", err)
+	}
+
+	// x1 decides the class; swapping column order must not change that.
+	XTest, _ := dataframe.New(map[string]any{
+		"x1":    []float64{0, 1},
+		"x2":    []float64{1, 0},
+		"extra": []float64{5, 5},
+	})
+	predictions, err := model.Predict(XTest.Select("x2", "extra", "x1"))
+	if err != nil {
+		t.Fatalf("Predict failed: %v", err)
+	}
+	for i, want := range []string{"A", "B"} {
+		got, _ := predictions.Get(i)
+		if got != want {
+			t.Errorf("row %d: expected %s, got %v", i, want, got)
+		}
+	}
+
+	if _, err := model.Predict(XTest.Select("x2")); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("expected ErrColumnNotFound for missing feature, got %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+package series
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+// categorical is the dictionary encoding of a categorical Series.
+// codes[i] indexes categories, or is -1 when element i is null.
+type categorical[T any] struct {
+	codes      []int32
+	categories []T
+	lookup     map[any]int32 // category key → code
+}
+
+// AsCategorical returns a copy of the Series encoded as a categorical column.
+// Each distinct non-null value is stored once in a dictionary, ordered by
+// first appearance, and every element refers to it by an int32 code. Element
+// values share the dictionary's storage, so repeated strings are held once.
+// The result has dtype Category; Get and Data still return the original values.
+func (s *Series[T]) AsCategorical() *Series[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cat := &categorical[T]{
+		codes:  make([]int32, len(s.data)),
+		lookup: make(map[any]int32),
+	}
+	result := &Series[T]{
+		name:  s.name,
+		data:  make([]T, len(s.data)),
+		dtype: core.DtypeCategory,
+		index: s.index,
+		cat:   cat,
+	}
+	if s.nullMask != nil {
+		result.nullMask = s.nullMask.Clone()
+	}
+
+	for i, v := range s.data {
+		if s.nullMask != nil && s.nullMask.Test(i) {
+			cat.codes[i] = -1
+			continue
+		}
+		code := cat.encode(v)
+		cat.codes[i] = code
+		result.data[i] = cat.categories[code]
+	}
+
+	return result
+}
+
+// IsCategorical reports whether the Series is dictionary encoded.
+func (s *Series[T]) IsCategorical() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cat != nil
+}
+
+// Categories returns a copy of the dictionary of distinct values in code
+// order, or nil if the Series is not categorical.
+func (s *Series[T]) Categories() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.cat == nil {
+		return nil
+	}
+	result := make([]T, len(s.cat.categories))
+	copy(result, s.cat.categories)
+	return result
+}
+
+// Codes returns a copy of the category code of each element, with -1 for
+// nulls, or nil if the Series is not categorical.
+func (s *Series[T]) Codes() []int32 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.cat == nil {
+		return nil
+	}
+	result := make([]int32, len(s.cat.codes))
+	copy(result, s.cat.codes)
+	return result
+}
+
+// CategoryCode returns the code of value in the dictionary.
+// Returns false if the Series is not categorical or value is not a category.
+func (s *Series[T]) CategoryCode(value T) (int32, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.cat == nil {
+		return 0, false
+	}
+	code, ok := s.cat.lookup[categoryKey(value)]
+	return code, ok
+}
+
+// encode returns the code of v, adding it to the dictionary if it is new.
+func (c *categorical[T]) encode(v T) int32 {
+	key := categoryKey(v)
+	if code, ok := c.lookup[key]; ok {
+		return code
+	}
+	code := int32(len(c.categories))
+	c.categories = append(c.categories, v)
+	c.lookup[key] = code
+	return code
+}
+
+// slice returns a copy of the encoding for elements [start, end).
+// The dictionary is copied too, so later Sets on either Series stay independent.
+func (c *categorical[T]) slice(start, end int) *categorical[T] {
+	return c.withCodes(append([]int32(nil), c.codes[start:end]...))
+}
+
+// withCodes returns an encoding with a copy of c's dictionary and the given
+// codes, which it takes ownership of.
+func (c *categorical[T]) withCodes(codes []int32) *categorical[T] {
+	result := &categorical[T]{
+		codes:      codes,
+		categories: make([]T, len(c.categories)),
+		lookup:     make(map[any]int32, len(c.lookup)),
+	}
+	copy(result.categories, c.categories)
+	for key, code := range c.lookup {
+		result.lookup[key] = code
+	}
+	return result
+}
+
+// categoryKey returns a map key for v. Values of incomparable types, such as
+// slices, are keyed by their type and formatted form.
+func categoryKey(v any) any {
+	if v == nil || reflect.TypeOf(v).Comparable() {
+		return v
+	}
+	return fmt.Sprintf("%T:%v", v, v)
+}
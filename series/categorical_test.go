@@ -0,0 +1,155 @@
+package series
+
+import (
+	"fmt"
+	"testing"
+	"unsafe"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func TestAsCategorical(t *testing.T) {
+	s := New("status", []any{"open", "closed", "open", nil, "pending", "closed"}, core.DtypeString)
+	s.SetNull(3)
+
+	c := s.AsCategorical()
+	if !c.IsCategorical() || s.IsCategorical() {
+		t.Fatal("Expected only the converted Series to be categorical")
+	}
+	if c.Dtype() != core.DtypeCategory {
+		t.Errorf("Expected dtype Category, got %s", c.Dtype())
+	}
+
+	cats := c.Categories()
+	wantCats := []any{"open", "closed", "pending"}
+	if len(cats) != len(wantCats) {
+		t.Fatalf("Expected %d categories, got %v", len(wantCats), cats)
+	}
+	for i := range wantCats {
+		if cats[i] != wantCats[i] {
+			t.Errorf("Category %d: expected %v, got %v", i, wantCats[i], cats[i])
+		}
+	}
+
+	wantCodes := []int32{0, 1, 0, -1, 2, 1}
+	codes := c.Codes()
+	for i := range wantCodes {
+		if codes[i] != wantCodes[i] {
+			t.Errorf("Code %d: expected %d, got %d", i, wantCodes[i], codes[i])
+		}
+	}
+
+	// Values are unchanged
+	for i := 0; i < s.Len(); i++ {
+		want, wantOK := s.Get(i)
+		got, gotOK := c.Get(i)
+		if want != got || wantOK != gotOK {
+			t.Errorf("Get(%d): expected (%v, %v), got (%v, %v)", i, want, wantOK, got, gotOK)
+		}
+	}
+
+	if code, ok := c.CategoryCode("pending"); !ok || code != 2 {
+		t.Errorf("Expected code 2 for pending, got %d (%v)", code, ok)
+	}
+	if _, ok := c.CategoryCode("missing"); ok {
+		t.Error("Expected no code for a value outside the dictionary")
+	}
+}
+
+func TestCategoricalMutation(t *testing.T) {
+	c := New("x", []any{"a", "b", "a"}, core.DtypeString).AsCategorical()
+	sliced := c.Slice(1, 3)
+	copied := c.Copy()
+
+	if err := c.Set(0, "b"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Set(1, "z"); err != nil {
+		t.Fatal(err)
+	}
+	c.SetNull(2)
+
+	codes := c.Codes()
+	if codes[0] != 1 || codes[1] != 2 || codes[2] != -1 {
+		t.Errorf("Expected codes [1 2 -1], got %v", codes)
+	}
+	if len(c.Categories()) != 3 {
+		t.Errorf("Expected Set to add a category, got %v", c.Categories())
+	}
+
+	// Copies and slices keep their own encoding
+	if codes := copied.Codes(); codes[0] != 0 || codes[1] != 1 || codes[2] != 0 {
+		t.Errorf("Copy changed with the original: %v", codes)
+	}
+	if len(copied.Categories()) != 2 {
+		t.Errorf("Copy dictionary changed with the original: %v", copied.Categories())
+	}
+	if codes := sliced.Codes(); len(codes) != 2 || codes[0] != 1 || codes[1] != 0 {
+		t.Errorf("Expected slice codes [1 0], got %v", codes)
+	}
+}
+
+func TestCategoricalSharesStorage(t *testing.T) {
+	// Build each value separately so every element has its own string bytes
+	n := 1000
+	data := make([]any, n)
+	for i := range data {
+		data[i] = fmt.Sprintf("country-%d", i%4)
+	}
+	s := New("country", data, core.DtypeString)
+
+	distinct := func(values []any) int {
+		ptrs := make(map[*byte]bool)
+		for _, v := range values {
+			ptrs[unsafe.StringData(v.(string))] = true
+		}
+		return len(ptrs)
+	}
+
+	if got := distinct(s.Data()); got != n {
+		t.Fatalf("Expected %d separate strings before conversion, got %d", n, got)
+	}
+	if got := distinct(s.AsCategorical().Data()); got != 4 {
+		t.Errorf("Expected categorical values to share 4 strings, got %d", got)
+	}
+}
+
+func TestCategoricalApplyFilter(t *testing.T) {
+	c := New("x", []any{"a", "b", "a", "c"}, core.DtypeString).AsCategorical()
+	c.SetNull(3)
+
+	filtered := c.Filter(func(v any) bool { return v == "b" || v == "a" })
+	if filtered.Dtype() != core.DtypeCategory || !filtered.IsCategorical() {
+		t.Fatalf("Filter lost the encoding: dtype %v", filtered.Dtype())
+	}
+	if cats := filtered.Categories(); len(cats) != 3 || cats[0] != "a" || cats[1] != "b" || cats[2] != "c" {
+		t.Errorf("Expected filtered categories [a b c], got %v", cats)
+	}
+	if codes := filtered.Codes(); len(codes) != 3 || codes[0] != 0 || codes[1] != 1 || codes[2] != 0 {
+		t.Errorf("Expected filtered codes [0 1 0], got %v", codes)
+	}
+
+	upper := c.Apply(func(v any) any {
+		if v == "a" {
+			return "A"
+		}
+		return v
+	})
+	if !upper.IsCategorical() {
+		t.Fatal("Apply lost the encoding")
+	}
+	if codes := upper.Codes(); codes[0] != 3 || codes[1] != 1 || codes[2] != 3 || codes[3] != -1 {
+		t.Errorf("Expected applied codes [3 1 3 -1], got %v", codes)
+	}
+	if code, ok := upper.CategoryCode("A"); !ok || code != 3 {
+		t.Errorf("Expected A to be added as code 3, got %v, %v", code, ok)
+	}
+	if v, _ := upper.Get(0); v != "A" {
+		t.Errorf("Expected A, got %v", v)
+	}
+
+	// The original keeps its own dictionary
+	if len(c.Categories()) != 3 {
+		t.Errorf("Apply changed the original dictionary: %v", c.Categories())
+	}
+}
@@ -0,0 +1,123 @@
+package series
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/internal/bitset"
+)
+
+// DatetimeAccessor provides vectorized datetime operations on a Series.
+// Every method returns a new Series[any]. Null elements stay null, and
+// elements that are not time.Time values also produce null.
+type DatetimeAccessor struct {
+	name   string
+	values []any
+	valid  []bool
+}
+
+// Dt returns a DatetimeAccessor over a snapshot of the Series values.
+func (s *Series[T]) Dt() *DatetimeAccessor {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	acc := &DatetimeAccessor{
+		name:   s.name,
+		values: make([]any, len(s.data)),
+		valid:  make([]bool, len(s.data)),
+	}
+	for i, v := range s.data {
+		acc.values[i] = v
+		acc.valid[i] = s.nullMask == nil || !s.nullMask.Test(i)
+	}
+	return acc
+}
+
+// Year returns the year of each timestamp.
+func (a *DatetimeAccessor) Year() *Series[any] {
+	return a.apply(core.DtypeInt64, func(t time.Time) any { return int64(t.Year()) })
+}
+
+// Month returns the month of each timestamp (1-12).
+func (a *DatetimeAccessor) Month() *Series[any] {
+	return a.apply(core.DtypeInt64, func(t time.Time) any { return int64(t.Month()) })
+}
+
+// Day returns the day of the month of each timestamp (1-31).
+func (a *DatetimeAccessor) Day() *Series[any] {
+	return a.apply(core.DtypeInt64, func(t time.Time) any { return int64(t.Day()) })
+}
+
+// Weekday returns the day of the week of each timestamp, with Monday=0
+// through Sunday=6.
+func (a *DatetimeAccessor) Weekday() *Series[any] {
+	return a.apply(core.DtypeInt64, func(t time.Time) any { return int64((t.Weekday() + 6) % 7) })
+}
+
+// Hour returns the hour of each timestamp (0-23).
+func (a *DatetimeAccessor) Hour() *Series[any] {
+	return a.apply(core.DtypeInt64, func(t time.Time) any { return int64(t.Hour()) })
+}
+
+// Minute returns the minute of each timestamp (0-59).
+func (a *DatetimeAccessor) Minute() *Series[any] {
+	return a.apply(core.DtypeInt64, func(t time.Time) any { return int64(t.Minute()) })
+}
+
+// Floor rounds each timestamp down to a multiple of freq since the zero time,
+// as time.Time.Truncate does. Use Truncate for calendar units such as months.
+func (a *DatetimeAccessor) Floor(freq time.Duration) *Series[any] {
+	return a.apply(core.DtypeTime, func(t time.Time) any { return t.Truncate(freq) })
+}
+
+// Truncate sets every field smaller than unit to its zero value, keeping the
+// timestamp's location. unit is one of "year", "month", "day", "hour",
+// "minute", or "second".
+func (a *DatetimeAccessor) Truncate(unit string) (*Series[any], error) {
+	var fn func(t time.Time) time.Time
+	switch unit {
+	case "year":
+		fn = func(t time.Time) time.Time { return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location()) }
+	case "month":
+		fn = func(t time.Time) time.Time { return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()) }
+	case "day":
+		fn = func(t time.Time) time.Time { return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()) }
+	case "hour":
+		fn = func(t time.Time) time.Time {
+			return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+		}
+	case "minute":
+		fn = func(t time.Time) time.Time {
+			return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, t.Location())
+		}
+	case "second":
+		fn = func(t time.Time) time.Time {
+			return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, t.Location())
+		}
+	default:
+		return nil, fmt.Errorf("unknown truncation unit %q: %w", unit, core.ErrInvalidArgument)
+	}
+
+	return a.apply(core.DtypeTime, func(t time.Time) any { return fn(t) }), nil
+}
+
+// apply maps fn over the time elements, marking nulls and non-times as null.
+func (a *DatetimeAccessor) apply(dtype core.Dtype, fn func(time.Time) any) *Series[any] {
+	data := make([]any, len(a.values))
+	var nullMask *bitset.BitSet
+
+	for i, v := range a.values {
+		t, ok := v.(time.Time)
+		if !a.valid[i] || !ok {
+			if nullMask == nil {
+				nullMask = bitset.New(len(a.values))
+			}
+			nullMask.Set(i)
+			continue
+		}
+		data[i] = fn(t)
+	}
+
+	return NewWithNulls(a.name, data, dtype, nullMask)
+}
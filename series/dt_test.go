@@ -0,0 +1,68 @@
+package series
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func TestDatetimeAccessorYearWeekday(t *testing.T) {
+	s := New[any]("ts", []any{
+		time.Date(2024, 3, 15, 14, 30, 0, 0, time.UTC), // Friday
+		time.Date(2023, 12, 31, 8, 0, 0, 0, time.UTC),  // Sunday
+		nil,
+		time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), // Monday
+	}, core.DtypeTime)
+	s.SetNull(2)
+
+	years := s.Dt().Year()
+	expectedYears := []int64{2024, 2023, 0, 2025}
+	for i, want := range expectedYears {
+		if i == 2 {
+			if !years.IsNull(i) {
+				t.Error("Expected null timestamp to produce null year")
+			}
+			continue
+		}
+		if val, _ := years.Get(i); val != want {
+			t.Errorf("Index %d: expected year %d, got %v", i, want, val)
+		}
+	}
+
+	weekdays := s.Dt().Weekday()
+	if weekdays.Dtype() != core.DtypeInt64 {
+		t.Errorf("Expected dtype Int64, got %s", weekdays.Dtype())
+	}
+	expectedDays := map[int]int64{0: 4, 1: 6, 3: 0}
+	for i, want := range expectedDays {
+		if val, _ := weekdays.Get(i); val != want {
+			t.Errorf("Index %d: expected weekday %d, got %v", i, want, val)
+		}
+	}
+	if !weekdays.IsNull(2) {
+		t.Error("Expected null timestamp to produce null weekday")
+	}
+}
+
+func TestDatetimeAccessorTruncate(t *testing.T) {
+	ts := time.Date(2024, 3, 15, 14, 37, 12, 0, time.UTC)
+	s := New[any]("ts", []any{ts}, core.DtypeTime)
+
+	month, err := s.Dt().Truncate("month")
+	if err != nil {
+		t.Fatalf("Truncate failed: %v", err)
+	}
+	if val, _ := month.Get(0); val != time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("Expected start of month, got %v", val)
+	}
+
+	floored := s.Dt().Floor(15 * time.Minute)
+	if val, _ := floored.Get(0); val != time.Date(2024, 3, 15, 14, 30, 0, 0, time.UTC) {
+		t.Errorf("Expected 14:30, got %v", val)
+	}
+
+	if _, err := s.Dt().Truncate("fortnight"); err == nil {
+		t.Error("Expected error for unknown unit")
+	}
+}
@@ -0,0 +1,110 @@
+package series
+
+import (
+	"math"
+	"reflect"
+	"time"
+)
+
+// EqualsOptions configures Equals.
+type EqualsOptions struct {
+	tolerance float64
+	nanEqual  bool
+}
+
+// EqualsOption is a functional option for Equals.
+type EqualsOption func(*EqualsOptions)
+
+// WithTolerance sets the absolute tolerance for comparing float values (default: 0).
+func WithTolerance(tol float64) EqualsOption {
+	return func(opts *EqualsOptions) {
+		opts.tolerance = tol
+	}
+}
+
+// NaNEqual sets whether NaN compares equal to NaN (default: true).
+func NaNEqual(equal bool) EqualsOption {
+	return func(opts *EqualsOptions) {
+		opts.nanEqual = equal
+	}
+}
+
+// Equals reports whether two Series have the same length, dtype, null
+// positions and values. Names and indexes are not compared, and Series of
+// different dtypes are never equal. Times are compared with time.Time.Equal
+// and floats within WithTolerance; other values must be deeply equal.
+func (s *Series[T]) Equals(other *Series[T], opts ...EqualsOption) bool {
+	if s == other {
+		return true
+	}
+	if s == nil || other == nil {
+		return false
+	}
+
+	eqOpts := &EqualsOptions{
+		nanEqual: true,
+	}
+	for _, opt := range opts {
+		opt(eqOpts)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	other.mu.RLock()
+	defer other.mu.RUnlock()
+
+	if len(s.data) != len(other.data) || s.dtype != other.dtype {
+		return false
+	}
+
+	for i := range s.data {
+		nullA := s.nullMask != nil && s.nullMask.Test(i)
+		nullB := other.nullMask != nil && other.nullMask.Test(i)
+		if nullA != nullB {
+			return false
+		}
+		if !nullA && !valuesEqual(s.data[i], other.data[i], eqOpts) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValuesEqual reports whether two non-null values are equal as Equals
+// compares them: times with time.Time.Equal, floats within WithTolerance,
+// and other values deeply.
+func ValuesEqual(a, b any, opts ...EqualsOption) bool {
+	eqOpts := &EqualsOptions{
+		nanEqual: true,
+	}
+	for _, opt := range opts {
+		opt(eqOpts)
+	}
+	return valuesEqual(a, b, eqOpts)
+}
+
+// valuesEqual compares two non-null values.
+func valuesEqual(a, b any, opts *EqualsOptions) bool {
+	switch va := a.(type) {
+	case float64:
+		vb, ok := b.(float64)
+		return ok && floatsEqual(va, vb, opts)
+	case float32:
+		vb, ok := b.(float32)
+		return ok && floatsEqual(float64(va), float64(vb), opts)
+	case time.Time:
+		vb, ok := b.(time.Time)
+		return ok && va.Equal(vb)
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// floatsEqual compares two floats within the tolerance of opts.
+func floatsEqual(a, b float64, opts *EqualsOptions) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return opts.nanEqual && math.IsNaN(a) && math.IsNaN(b)
+	}
+	return a == b || math.Abs(a-b) <= opts.tolerance
+}
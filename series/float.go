@@ -0,0 +1,84 @@
+package series
+
+import "github.com/TIVerse/GopherData/core"
+
+// Float64s returns the non-null elements converted to float64, in order.
+// Elements that are not numeric convert to 0, as nil values do.
+//
+// The whole scan holds the read lock once. Typed float64 and int64 data is
+// read from the concrete slice; for Series[any] with a numeric dtype each
+// element is asserted to that dtype's type, falling back to a full
+// conversion only when the assertion fails.
+func (s *Series[T]) Float64s() []float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	n := len(s.data)
+	mask := s.nullMask
+	if mask != nil && !mask.Any() {
+		mask = nil
+	}
+	count := n
+	if mask != nil {
+		count -= mask.Count()
+	}
+	result := make([]float64, 0, count)
+
+	switch data := any(s.data).(type) {
+	case []float64:
+		for i, v := range data {
+			if mask == nil || !mask.Test(i) {
+				result = append(result, v)
+			}
+		}
+	case []int64:
+		for i, v := range data {
+			if mask == nil || !mask.Test(i) {
+				result = append(result, float64(v))
+			}
+		}
+	case []any:
+		switch s.dtype {
+		case core.DtypeFloat64:
+			for i, v := range data {
+				if mask != nil && mask.Test(i) {
+					continue
+				}
+				if f, ok := v.(float64); ok {
+					result = append(result, f)
+				} else {
+					f, _ := core.ToFloat64(v)
+					result = append(result, f)
+				}
+			}
+		case core.DtypeInt64:
+			for i, v := range data {
+				if mask != nil && mask.Test(i) {
+					continue
+				}
+				if x, ok := v.(int64); ok {
+					result = append(result, float64(x))
+				} else {
+					f, _ := core.ToFloat64(v)
+					result = append(result, f)
+				}
+			}
+		default:
+			for i, v := range data {
+				if mask == nil || !mask.Test(i) {
+					f, _ := core.ToFloat64(v)
+					result = append(result, f)
+				}
+			}
+		}
+	default:
+		for i, v := range s.data {
+			if mask == nil || !mask.Test(i) {
+				f, _ := core.ToFloat64(any(v))
+				result = append(result, f)
+			}
+		}
+	}
+
+	return result
+}
@@ -31,6 +31,10 @@ func (s *Series[T]) SetNull(i int) {
 	}
 
 	s.nullMask.Set(i)
+
+	if s.cat != nil {
+		s.cat.codes[i] = -1
+	}
 }
 
 // NullCount returns the number of null values in the Series.
@@ -109,6 +113,63 @@ func (s *Series[T]) FillNA(value T) *Series[T] {
 	return result
 }
 
+// FillNAMethod returns a new Series with null values filled from their
+// neighbors. method is "ffill" (propagate the last valid value forward) or
+// "bfill" (use the next valid value). limit caps how many consecutive nulls
+// are filled after (or before) each valid value; limit <= 0 means no limit.
+// Leading nulls under ffill and trailing nulls under bfill stay null.
+// An unknown method returns an unchanged copy.
+func (s *Series[T]) FillNAMethod(method string, limit int) *Series[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := &Series[T]{
+		name:  s.name,
+		data:  make([]T, len(s.data)),
+		dtype: s.dtype,
+		index: s.index,
+	}
+	copy(result.data, s.data)
+	if s.cat != nil {
+		result.cat = s.cat.slice(0, len(s.data))
+	}
+	if s.nullMask == nil || s.nullMask.None() {
+		return result
+	}
+	result.nullMask = s.nullMask.Clone()
+
+	n := len(s.data)
+	start, end, step := 0, n, 1
+	switch method {
+	case "ffill":
+	case "bfill":
+		start, end, step = n-1, -1, -1
+	default:
+		return result
+	}
+
+	src := -1 // Position of the last valid value seen
+	filled := 0
+	for i := start; i != end; i += step {
+		if !s.nullMask.Test(i) {
+			src = i
+			filled = 0
+			continue
+		}
+		if src < 0 || (limit > 0 && filled >= limit) {
+			continue
+		}
+		result.data[i] = s.data[src]
+		result.nullMask.Clear(i)
+		if result.cat != nil {
+			result.cat.codes[i] = result.cat.codes[src]
+		}
+		filled++
+	}
+
+	return result
+}
+
 // NullMask returns a copy of the null mask, or nil if no nulls exist.
 func (s *Series[T]) NullMask() *bitset.BitSet {
 	s.mu.RLock()
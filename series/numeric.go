@@ -3,8 +3,10 @@ package series
 import (
 	"math"
 	"sort"
+	"time"
 
 	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/internal/bitset"
 )
 
 // Sum returns the sum of all non-null values in a numeric Series.
@@ -213,6 +215,203 @@ func Quantile[T core.NumericType](s *Series[T], q float64) float64 {
 	return values[lower]*(1-fraction) + values[upper]*fraction
 }
 
+// Clip returns a copy of s with values below lo raised to lo and values
+// above hi lowered to hi. A NaN bound leaves that side unbounded, and nulls
+// stay null. An Int64 Series stays Int64 when both bounds are whole numbers
+// or infinite; otherwise a numeric Series becomes Float64. A non-numeric
+// Series is returned as an unchanged copy.
+func Clip(s *Series[any], lo, hi float64) *Series[any] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var nullMask *bitset.BitSet
+	if s.nullMask != nil {
+		nullMask = s.nullMask.Clone()
+	}
+	if !core.IsNumeric(s.dtype) {
+		return NewWithNulls(s.name, s.data, s.dtype, nullMask)
+	}
+
+	dtype := core.DtypeFloat64
+	if s.dtype == core.DtypeInt64 && wholeOrInf(lo) && wholeOrInf(hi) {
+		dtype = core.DtypeInt64
+	}
+
+	data := make([]any, len(s.data))
+	for i, val := range s.data {
+		if (nullMask != nil && nullMask.Test(i)) || val == nil {
+			continue
+		}
+		v, _ := core.ToFloat64(val)
+		if v < lo {
+			v = lo
+		} else if v > hi {
+			v = hi
+		}
+		if dtype == core.DtypeInt64 {
+			if x, ok := val.(int64); ok && float64(x) == v {
+				data[i] = x // unclipped, so keep the exact value
+			} else {
+				data[i] = int64(v)
+			}
+		} else {
+			data[i] = v
+		}
+	}
+	return NewWithNulls(s.name, data, dtype, nullMask)
+}
+
+// wholeOrInf reports whether a Clip bound can be stored in an Int64 Series
+// without changing it: a whole number, an infinity, or NaN (no bound).
+func wholeOrInf(bound float64) bool {
+	return math.IsNaN(bound) || math.IsInf(bound, 0) || bound == math.Trunc(bound)
+}
+
+// Normalize rescales a numeric Series: "zscore" subtracts the mean and
+// divides by the sample standard deviation, and "minmax" maps the minimum
+// to 0 and the maximum to 1. Nulls stay null and non-numeric values are
+// null. As with Quantile, values that cannot be rescaled are NaN: all of
+// them for an unknown method, or when the Series has no spread.
+func Normalize(s *Series[any], method string) *Series[float64] {
+	s.mu.RLock()
+	n := len(s.data)
+	data := make([]float64, n)
+	nullMask := bitset.New(n)
+	values := make([]float64, 0, n)
+	for i, val := range s.data {
+		v, ok := core.ToFloat64(val)
+		if !ok || val == nil || (s.nullMask != nil && s.nullMask.Test(i)) {
+			nullMask.Set(i)
+			continue
+		}
+		data[i] = v
+		values = append(values, v)
+	}
+	name := s.name
+	s.mu.RUnlock()
+
+	var shift, scale float64
+	switch method {
+	case "zscore":
+		shift = Mean(New("", values, core.DtypeFloat64))
+		scale = Std(New("", values, core.DtypeFloat64))
+	case "minmax":
+		if len(values) > 0 {
+			lo, hi := values[0], values[0]
+			for _, v := range values[1:] {
+				lo, hi = math.Min(lo, v), math.Max(hi, v)
+			}
+			shift, scale = lo, hi-lo
+		}
+	default:
+		shift, scale = math.NaN(), math.NaN()
+	}
+
+	for i := range data {
+		if !nullMask.Test(i) {
+			data[i] = (data[i] - shift) / scale
+		}
+	}
+	if !nullMask.Any() {
+		nullMask = nil
+	}
+	return NewWithNulls(name, data, core.DtypeFloat64, nullMask)
+}
+
+// Rank returns the rank of each value of s, starting at 1, ordered
+// ascending or descending. method decides the rank of tied values:
+// "average" gives each the mean of their positions, "min" and "max" the
+// lowest and highest, "first" their positions in order of appearance, and
+// "dense" the lowest, with no gaps between groups of ties. Numeric, string
+// and category Series rank by value and DtypeTime Series by time. Nulls,
+// NaN, values of another type and every value of a Series of another dtype
+// are null; for an unknown method every other value is NaN.
+func Rank(s *Series[any], method string, ascending bool) *Series[float64] {
+	s.mu.RLock()
+	n := len(s.data)
+	nullMask := bitset.New(n)
+	floats := make([]float64, n)
+	strs := make([]string, n)
+	times := make([]time.Time, n)
+	positions := make([]int, 0, n)
+	for i, val := range s.data {
+		ok := val != nil && (s.nullMask == nil || !s.nullMask.Test(i))
+		if ok {
+			switch {
+			case core.IsNumeric(s.dtype):
+				floats[i], ok = core.ToFloat64(val)
+				ok = ok && !math.IsNaN(floats[i])
+			case s.dtype == core.DtypeString || s.dtype == core.DtypeCategory:
+				strs[i], ok = val.(string)
+			case s.dtype == core.DtypeTime:
+				times[i], ok = val.(time.Time)
+			default:
+				ok = false
+			}
+		}
+		if !ok {
+			nullMask.Set(i)
+			continue
+		}
+		positions = append(positions, i)
+	}
+	name, dtype := s.name, s.dtype
+	s.mu.RUnlock()
+
+	cmp := func(a, b int) int {
+		switch {
+		case core.IsNumeric(dtype):
+			return compare(floats[a], floats[b])
+		case dtype == core.DtypeTime:
+			return times[a].Compare(times[b])
+		default:
+			return compare(strs[a], strs[b])
+		}
+	}
+	sort.SliceStable(positions, func(a, b int) bool {
+		c := cmp(positions[a], positions[b])
+		if !ascending {
+			c = -c
+		}
+		return c < 0
+	})
+
+	data := make([]float64, n)
+	dense := 0.0
+	for i := 0; i < len(positions); {
+		// positions[i:j] are tied
+		j := i + 1
+		for j < len(positions) && cmp(positions[i], positions[j]) == 0 {
+			j++
+		}
+		dense++
+		for k := i; k < j; k++ {
+			var rank float64
+			switch method {
+			case "average":
+				rank = float64(i+j+1) / 2
+			case "min":
+				rank = float64(i + 1)
+			case "max":
+				rank = float64(j)
+			case "first":
+				rank = float64(k + 1)
+			case "dense":
+				rank = dense
+			default:
+				rank = math.NaN()
+			}
+			data[positions[k]] = rank
+		}
+		i = j
+	}
+
+	if !nullMask.Any() {
+		nullMask = nil
+	}
+	return NewWithNulls(name, data, core.DtypeFloat64, nullMask)
+}
+
 // compare is a helper function to compare comparable values.
 // Returns -1 if a < b, 0 if a == b, 1 if a > b.
 // This is a simplified version - production code would use type switches or constraints.
@@ -17,6 +17,7 @@ type Series[T any] struct {
 	dtype    core.Dtype
 	nullMask *bitset.BitSet // nil if no nulls present
 	index    core.Index
+	cat      *categorical[T] // nil unless the Series is categorical
 	mu       sync.RWMutex
 }
 
@@ -127,6 +128,11 @@ func (s *Series[T]) Set(i int, value T) error {
 	}
 
 	s.data[i] = value
+	if s.cat != nil {
+		code := s.cat.encode(value)
+		s.cat.codes[i] = code
+		s.data[i] = s.cat.categories[code]
+	}
 
 	// Clear null bit if it exists
 	if s.nullMask != nil {
@@ -137,6 +143,7 @@ func (s *Series[T]) Set(i int, value T) error {
 }
 
 // Apply applies a function to each non-null element and returns a new Series.
+// A categorical result keeps the dictionary, with new values added to it.
 func (s *Series[T]) Apply(fn func(T) T) *Series[T] {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -153,11 +160,24 @@ func (s *Series[T]) Apply(fn func(T) T) *Series[T] {
 		result.nullMask = s.nullMask.Clone()
 	}
 
+	// A categorical result keeps the dictionary, extended with new values
+	if s.cat != nil {
+		result.cat = s.cat.withCodes(make([]int32, len(s.data)))
+	}
+
 	for i := 0; i < len(s.data); i++ {
 		if s.nullMask == nil || !s.nullMask.Test(i) {
 			result.data[i] = fn(s.data[i])
+			if result.cat != nil {
+				code := result.cat.encode(result.data[i])
+				result.cat.codes[i] = code
+				result.data[i] = result.cat.categories[code]
+			}
 		} else {
 			result.data[i] = s.data[i] // Keep original (zero value)
+			if result.cat != nil {
+				result.cat.codes[i] = -1
+			}
 		}
 	}
 
@@ -165,13 +185,15 @@ func (s *Series[T]) Apply(fn func(T) T) *Series[T] {
 }
 
 // Filter returns a new Series containing only elements for which fn returns true.
-// Null values are excluded by default.
+// Null values are excluded by default. A categorical result keeps the whole
+// dictionary, including categories no kept element uses.
 func (s *Series[T]) Filter(fn func(T) bool) *Series[T] {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	filtered := make([]T, 0, len(s.data)/2) // Allocate half capacity as estimate
 	var newNullMask *bitset.BitSet
+	var codes []int32
 
 	for i := 0; i < len(s.data); i++ {
 		if s.nullMask != nil && s.nullMask.Test(i) {
@@ -179,16 +201,26 @@ func (s *Series[T]) Filter(fn func(T) bool) *Series[T] {
 		}
 		if fn(s.data[i]) {
 			filtered = append(filtered, s.data[i])
+			if s.cat != nil {
+				codes = append(codes, s.cat.codes[i])
+			}
 		}
 	}
 
-	return &Series[T]{
+	result := &Series[T]{
 		name:     s.name,
 		data:     filtered,
 		dtype:    s.dtype,
 		nullMask: newNullMask,
 		index:    nil, // Index is invalidated by filtering
 	}
+
+	// A categorical result keeps the whole dictionary and the kept codes
+	if s.cat != nil {
+		result.cat = s.cat.withCodes(codes)
+	}
+
+	return result
 }
 
 // Copy returns a deep copy of the Series.
@@ -209,6 +241,10 @@ func (s *Series[T]) Copy() *Series[T] {
 		result.nullMask = s.nullMask.Clone()
 	}
 
+	if s.cat != nil {
+		result.cat = s.cat.slice(0, len(s.data))
+	}
+
 	return result
 }
 
@@ -288,5 +324,9 @@ func (s *Series[T]) Slice(start, end int) *Series[T] {
 		result.nullMask = s.nullMask.Slice(start, end)
 	}
 
+	if s.cat != nil {
+		result.cat = s.cat.slice(start, end)
+	}
+
 	return result
 }
@@ -1,6 +1,7 @@
 package series
 
 import (
+	"math"
 	"testing"
 
 	"github.com/TIVerse/GopherData/core"
@@ -153,3 +154,243 @@ func TestSeriesNumericWithNulls(t *testing.T) {
 		t.Errorf("Expected mean 30.0 (90/3), got %f", mean)
 	}
 }
+
+func TestSeriesClip(t *testing.T) {
+	s := New("x", []any{int64(-5), int64(3), nil, int64(12)}, core.DtypeInt64)
+	s.SetNull(2)
+
+	clipped := Clip(s, 0, 10)
+	if clipped.Dtype() != core.DtypeInt64 {
+		t.Errorf("Expected Int64 with whole bounds, got %v", clipped.Dtype())
+	}
+	for i, want := range []any{int64(0), int64(3), nil, int64(10)} {
+		got, ok := clipped.Get(i)
+		if want == nil {
+			if ok {
+				t.Errorf("Expected null at %d, got %v", i, got)
+			}
+		} else if got != want {
+			t.Errorf("clipped[%d] = %v, want %v", i, got, want)
+		}
+	}
+
+	clipped = Clip(s, -0.5, math.NaN())
+	if clipped.Dtype() != core.DtypeFloat64 {
+		t.Errorf("Expected Float64 with a fractional bound, got %v", clipped.Dtype())
+	}
+	if got, _ := clipped.Get(0); got != -0.5 {
+		t.Errorf("clipped[0] = %v, want -0.5", got)
+	}
+	if got, _ := clipped.Get(3); got != 12.0 {
+		t.Errorf("NaN upper bound should not clip, got %v", got)
+	}
+}
+
+func TestSeriesNormalize(t *testing.T) {
+	s := New("x", []any{2.0, 4.0, nil, 4.0, 5.0, 7.0, 9.0}, core.DtypeFloat64)
+	s.SetNull(2)
+
+	z := Normalize(s, "zscore")
+	if !z.IsNull(2) {
+		t.Error("Expected null to pass through zscore")
+	}
+	if mean := Mean(z); math.Abs(mean) > 1e-12 {
+		t.Errorf("Expected zscore mean ~0, got %v", mean)
+	}
+	if std := Std(z); math.Abs(std-1) > 1e-12 {
+		t.Errorf("Expected zscore std ~1, got %v", std)
+	}
+
+	m := Normalize(s, "minmax")
+	if !m.IsNull(2) {
+		t.Error("Expected null to pass through minmax")
+	}
+	lo, _ := Min(m)
+	hi, _ := Max(m)
+	if lo != 0 || hi != 1 {
+		t.Errorf("Expected minmax range [0, 1], got [%v, %v]", lo, hi)
+	}
+	if got, _ := m.Get(3); math.Abs(got-2.0/7) > 1e-12 {
+		t.Errorf("minmax[3] = %v, want %v", got, 2.0/7)
+	}
+
+	if got, _ := Normalize(s, "unit").Get(0); !math.IsNaN(got) {
+		t.Errorf("Expected NaN for an unknown method, got %v", got)
+	}
+}
+
+func TestSeriesFloat64s(t *testing.T) {
+	equal := func(a, b []float64) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	floats := New("f", []float64{1.5, 2.5, 3.5}, core.DtypeFloat64)
+	floats.SetNull(1)
+	if got := floats.Float64s(); !equal(got, []float64{1.5, 3.5}) {
+		t.Errorf("float64 Series: got %v", got)
+	}
+
+	ints := New("i", []int64{4, 5, 6}, core.DtypeInt64)
+	if got := ints.Float64s(); !equal(got, []float64{4, 5, 6}) {
+		t.Errorf("int64 Series: got %v", got)
+	}
+
+	// Mixed widths in an Int64 column still convert; nil counts as 0
+	mixed := New("m", []any{int64(1), int32(2), nil, int8(4), "x"}, core.DtypeInt64)
+	mixed.SetNull(4)
+	if got := mixed.Float64s(); !equal(got, []float64{1, 2, 0, 4}) {
+		t.Errorf("mixed Series: got %v", got)
+	}
+
+	anyFloats := New("a", []any{0.5, float32(1.5), 2.5}, core.DtypeFloat64)
+	anyFloats.SetNull(2)
+	if got := anyFloats.Float64s(); !equal(got, []float64{0.5, 1.5}) {
+		t.Errorf("any float Series: got %v", got)
+	}
+}
+
+func TestSeriesFillNAMethod(t *testing.T) {
+	// null, 1, null, null, null, 5, null
+	s := New("test", []int64{0, 1, 0, 0, 0, 5, 0}, core.DtypeInt64)
+	for _, i := range []int{0, 2, 3, 4, 6} {
+		s.SetNull(i)
+	}
+
+	check := func(name string, got *Series[int64], expected []any) {
+		t.Helper()
+		for i, want := range expected {
+			val, ok := got.Get(i)
+			if want == nil {
+				if ok {
+					t.Errorf("%s[%d]: expected null, got %v", name, i, val)
+				}
+				continue
+			}
+			if !ok || val != want {
+				t.Errorf("%s[%d]: expected %v, got %v (valid=%v)", name, i, want, val, ok)
+			}
+		}
+	}
+
+	// Leading null has nothing to carry forward
+	check("ffill", s.FillNAMethod("ffill", 0), []any{nil, int64(1), int64(1), int64(1), int64(1), int64(5), int64(5)})
+	// Trailing null has nothing to carry backward
+	check("bfill", s.FillNAMethod("bfill", 0), []any{int64(1), int64(1), int64(5), int64(5), int64(5), int64(5), nil})
+
+	check("ffill limit", s.FillNAMethod("ffill", 2), []any{nil, int64(1), int64(1), int64(1), nil, int64(5), int64(5)})
+	check("bfill limit", s.FillNAMethod("bfill", 1), []any{int64(1), int64(1), nil, nil, int64(5), int64(5), nil})
+
+	if s.NullCount() != 5 {
+		t.Error("Original series should be unchanged")
+	}
+	if got := s.FillNAMethod("nearest", 0); got.NullCount() != 5 {
+		t.Error("Unknown method should return an unchanged copy")
+	}
+}
+
+func TestSeriesFillNAMethodCategorical(t *testing.T) {
+	s := New("color", []string{"red", "", "blue", ""}, core.DtypeString)
+	s.SetNull(1)
+	s.SetNull(3)
+
+	filled := s.AsCategorical().FillNAMethod("ffill", 0)
+	codes := filled.Codes()
+	expected := []int32{0, 0, 1, 1}
+	for i := range expected {
+		if codes[i] != expected[i] {
+			t.Errorf("Expected codes %v, got %v", expected, codes)
+			break
+		}
+	}
+	if val, _ := filled.Get(3); val != "blue" {
+		t.Errorf("Expected blue, got %v", val)
+	}
+}
+
+func TestSeriesEquals(t *testing.T) {
+	a := New("a", []any{int64(1), int64(2), int64(3)}, core.DtypeInt64)
+	b := New("b", []any{int64(1), int64(2), int64(3)}, core.DtypeInt64)
+	if !a.Equals(b) {
+		t.Error("Expected series with the same values to be equal")
+	}
+
+	// Differing only in null positions
+	a.SetNull(1)
+	b.SetNull(2)
+	if a.Equals(b) {
+		t.Error("Expected series with different null positions to differ")
+	}
+	b = a.Copy()
+	if !a.Equals(b) {
+		t.Error("Expected a copy to be equal")
+	}
+
+	f := New("f", []any{int64(1), nil, int64(3)}, core.DtypeFloat64)
+	f.SetNull(1)
+	if a.Equals(f) {
+		t.Error("Expected series of different dtypes to differ")
+	}
+	if a.Equals(a.Slice(0, 2)) {
+		t.Error("Expected series of different lengths to differ")
+	}
+
+	x := New("x", []float64{1.0, math.NaN(), 3.0}, core.DtypeFloat64)
+	y := New("y", []float64{1.0 + 1e-9, math.NaN(), 3.0}, core.DtypeFloat64)
+	if x.Equals(y) {
+		t.Error("Expected a difference without tolerance")
+	}
+	if !x.Equals(y, WithTolerance(1e-6)) {
+		t.Error("Expected a match within tolerance")
+	}
+	if x.Equals(y, WithTolerance(1e-6), NaNEqual(false)) {
+		t.Error("Expected NaN to differ from NaN with NaNEqual(false)")
+	}
+}
+
+func TestSeriesRank(t *testing.T) {
+	s := New("x", []any{3.0, 1.0, 3.0, nil, 2.0, 3.0}, core.DtypeFloat64)
+	s.SetNull(3)
+
+	cases := map[string][]float64{
+		"average": {4, 1, 4, math.NaN(), 2, 4},
+		"min":     {3, 1, 3, math.NaN(), 2, 3},
+		"max":     {5, 1, 5, math.NaN(), 2, 5},
+		"first":   {3, 1, 4, math.NaN(), 2, 5},
+		"dense":   {3, 1, 3, math.NaN(), 2, 3},
+	}
+	for method, want := range cases {
+		ranks := Rank(s, method, true)
+		for i, w := range want {
+			got, ok := ranks.Get(i)
+			if math.IsNaN(w) {
+				if ok {
+					t.Errorf("%s: rank[%d] = %v, want null", method, i, got)
+				}
+				continue
+			}
+			if !ok || got != w {
+				t.Errorf("%s: rank[%d] = %v, want %v", method, i, got, w)
+			}
+		}
+	}
+
+	// Descending ranks of strings, ties in order of appearance
+	names := New("name", []any{"b", "a", "c", "b"}, core.DtypeString)
+	for i, want := range []float64{2, 4, 1, 3} {
+		if got, _ := Rank(names, "first", false).Get(i); got != want {
+			t.Errorf("descending rank[%d] = %v, want %v", i, got, want)
+		}
+	}
+
+	if got, _ := Rank(s, "median", true).Get(0); !math.IsNaN(got) {
+		t.Errorf("unknown method rank = %v, want NaN", got)
+	}
+}
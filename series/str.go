@@ -0,0 +1,102 @@
+package series
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/internal/bitset"
+)
+
+// StringAccessor provides vectorized string operations on a Series.
+// Every method returns a new Series[any]. Null elements stay null, and
+// elements that are not strings (for example numbers in a mixed column)
+// also produce null rather than an error.
+type StringAccessor struct {
+	name   string
+	values []any
+	valid  []bool
+}
+
+// Str returns a StringAccessor over a snapshot of the Series values.
+func (s *Series[T]) Str() *StringAccessor {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	acc := &StringAccessor{
+		name:   s.name,
+		values: make([]any, len(s.data)),
+		valid:  make([]bool, len(s.data)),
+	}
+	for i, v := range s.data {
+		acc.values[i] = v
+		acc.valid[i] = s.nullMask == nil || !s.nullMask.Test(i)
+	}
+	return acc
+}
+
+// Lower returns the elements converted to lower case.
+func (a *StringAccessor) Lower() *Series[any] {
+	return a.apply(core.DtypeString, func(v string) any { return strings.ToLower(v) })
+}
+
+// Upper returns the elements converted to upper case.
+func (a *StringAccessor) Upper() *Series[any] {
+	return a.apply(core.DtypeString, func(v string) any { return strings.ToUpper(v) })
+}
+
+// Contains reports whether each element contains substr.
+func (a *StringAccessor) Contains(substr string) *Series[any] {
+	return a.apply(core.DtypeBool, func(v string) any { return strings.Contains(v, substr) })
+}
+
+// StartsWith reports whether each element begins with prefix.
+func (a *StringAccessor) StartsWith(prefix string) *Series[any] {
+	return a.apply(core.DtypeBool, func(v string) any { return strings.HasPrefix(v, prefix) })
+}
+
+// EndsWith reports whether each element ends with suffix.
+func (a *StringAccessor) EndsWith(suffix string) *Series[any] {
+	return a.apply(core.DtypeBool, func(v string) any { return strings.HasSuffix(v, suffix) })
+}
+
+// Replace replaces all occurrences of old with new in each element.
+func (a *StringAccessor) Replace(old, new string) *Series[any] {
+	return a.apply(core.DtypeString, func(v string) any { return strings.ReplaceAll(v, old, new) })
+}
+
+// Strip removes leading and trailing white space from each element.
+func (a *StringAccessor) Strip() *Series[any] {
+	return a.apply(core.DtypeString, func(v string) any { return strings.TrimSpace(v) })
+}
+
+// Len returns the number of characters (runes) in each element.
+func (a *StringAccessor) Len() *Series[any] {
+	return a.apply(core.DtypeInt64, func(v string) any { return int64(utf8.RuneCountInString(v)) })
+}
+
+// Split splits each element around sep. Each element of the result holds a
+// []string; the Series dtype is String.
+func (a *StringAccessor) Split(sep string) *Series[any] {
+	return a.apply(core.DtypeString, func(v string) any { return strings.Split(v, sep) })
+}
+
+// apply maps fn over the string elements, marking nulls and non-strings as null.
+func (a *StringAccessor) apply(dtype core.Dtype, fn func(string) any) *Series[any] {
+	data := make([]any, len(a.values))
+	var nullMask *bitset.BitSet
+
+	for i, v := range a.values {
+		str, ok := v.(string)
+		if !a.valid[i] || !ok {
+			if nullMask == nil {
+				nullMask = bitset.New(len(a.values))
+			}
+			nullMask.Set(i)
+			continue
+		}
+		data[i] = fn(str)
+	}
+
+	return NewWithNulls(a.name, data, dtype, nullMask)
+}
@@ -0,0 +1,78 @@
+package series
+
+import (
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+)
+
+func TestStringAccessorContains(t *testing.T) {
+	s := New[any]("name", []any{"Apple pie", "banana", "apple", 42, ""}, core.DtypeString)
+	s.SetNull(4)
+
+	result := s.Str().Contains("pp")
+
+	if result.Dtype() != core.DtypeBool {
+		t.Errorf("Expected dtype Bool, got %s", result.Dtype())
+	}
+
+	expected := []any{true, false, true}
+	for i, want := range expected {
+		if val, ok := result.Get(i); !ok || val != want {
+			t.Errorf("Index %d: expected %v, got %v (ok=%v)", i, want, val, ok)
+		}
+	}
+
+	// Non-string and null elements become null
+	if !result.IsNull(3) {
+		t.Error("Expected non-string element to be null")
+	}
+	if !result.IsNull(4) {
+		t.Error("Expected null element to stay null")
+	}
+}
+
+func TestStringAccessorLower(t *testing.T) {
+	s := New[any]("city", []any{"Paris", "NEW York", "ÅLESUND"}, core.DtypeString)
+
+	result := s.Str().Lower()
+
+	if result.Dtype() != core.DtypeString {
+		t.Errorf("Expected dtype String, got %s", result.Dtype())
+	}
+	if result.Name() != "city" {
+		t.Errorf("Expected name 'city', got %s", result.Name())
+	}
+
+	expected := []string{"paris", "new york", "ålesund"}
+	for i, want := range expected {
+		if val, _ := result.Get(i); val != want {
+			t.Errorf("Index %d: expected %q, got %v", i, want, val)
+		}
+	}
+
+	// Character length counts runes, not bytes
+	if val, _ := s.Str().Len().Get(2); val != int64(7) {
+		t.Errorf("Expected length 7, got %v", val)
+	}
+
+	// Original series is untouched
+	if val, _ := s.Get(0); val != "Paris" {
+		t.Errorf("Original series modified: %v", val)
+	}
+}
+
+func TestStringAccessorTypedSeries(t *testing.T) {
+	s := New("words", []string{"a-b", "c"}, core.DtypeString)
+
+	parts := s.Str().Split("-")
+	val, _ := parts.Get(0)
+	if got, ok := val.([]string); !ok || len(got) != 2 || got[1] != "b" {
+		t.Errorf("Expected [a b], got %v", val)
+	}
+
+	replaced := s.Str().Replace("-", "+")
+	if val, _ := replaced.Get(0); val != "a+b" {
+		t.Errorf("Expected a+b, got %v", val)
+	}
+}
@@ -0,0 +1,35 @@
+package stats
+
+import "math/rand"
+
+// Bootstrap estimates a confidence interval for statistic by resampling
+// data with replacement nResamples times and taking the percentile interval
+// of the resampled statistics. point is the statistic of data itself. The
+// same seed gives the same interval.
+//
+// nResamples <= 0 uses 1000 and a confidence outside (0, 1) uses 0.95. For
+// empty data all three results are 0.
+func Bootstrap(data []float64, statistic func([]float64) float64, nResamples int, confidence float64, seed int64) (lower, upper, point float64) {
+	if len(data) == 0 {
+		return 0, 0, 0
+	}
+	if nResamples <= 0 {
+		nResamples = 1000
+	}
+	if confidence <= 0 || confidence >= 1 {
+		confidence = 0.95
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	sample := make([]float64, len(data))
+	estimates := make([]float64, nResamples)
+	for r := range estimates {
+		for i := range sample {
+			sample[i] = data[rng.Intn(len(data))]
+		}
+		estimates[r] = statistic(sample)
+	}
+
+	alpha := (1 - confidence) / 2
+	return Quantile(estimates, alpha), Quantile(estimates, 1-alpha), statistic(data)
+}
@@ -0,0 +1,57 @@
+package stats
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestBootstrapMean(t *testing.T) {
+	data := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	lower, upper, point := Bootstrap(data, Mean, 2000, 0.95, 42)
+	if point != 5 {
+		t.Errorf("point = %v, want 5", point)
+	}
+	if !(lower < point && point < upper) {
+		t.Errorf("interval [%v, %v] does not contain point %v", lower, upper, point)
+	}
+
+	l2, u2, _ := Bootstrap(data, Mean, 2000, 0.95, 42)
+	if l2 != lower || u2 != upper {
+		t.Errorf("same seed gave [%v, %v] and [%v, %v]", lower, upper, l2, u2)
+	}
+
+	narrowL, narrowU, _ := Bootstrap(data, Mean, 2000, 0.5, 42)
+	if narrowU-narrowL >= upper-lower {
+		t.Errorf("50%% interval [%v, %v] not narrower than 95%% interval [%v, %v]", narrowL, narrowU, lower, upper)
+	}
+}
+
+func TestBootstrapCoverage(t *testing.T) {
+	// A 95% interval for the mean of N(10, 2) samples should cover 10 in
+	// roughly 95% of trials; percentile intervals run a little narrow on
+	// small samples, so allow some slack.
+	const trueMean, trials = 10.0, 100
+	rng := rand.New(rand.NewSource(1))
+	covered := 0
+	for trial := 0; trial < trials; trial++ {
+		data := make([]float64, 50)
+		for i := range data {
+			data[i] = trueMean + 2*rng.NormFloat64()
+		}
+		lower, upper, _ := Bootstrap(data, Mean, 500, 0.95, int64(trial))
+		if lower <= trueMean && trueMean <= upper {
+			covered++
+		}
+	}
+	if covered < 85 {
+		t.Errorf("interval covered the true mean in %d of %d trials, want >= 85", covered, trials)
+	}
+}
+
+func TestBootstrapEmpty(t *testing.T) {
+	lower, upper, point := Bootstrap(nil, Mean, 100, 0.95, 1)
+	if lower != 0 || upper != 0 || point != 0 {
+		t.Errorf("Bootstrap(nil) = %v, %v, %v, want zeros", lower, upper, point)
+	}
+}
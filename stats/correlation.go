@@ -1,6 +1,7 @@
 package stats
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"sort"
@@ -170,7 +171,6 @@ func CorrMatrix(df *dataframe.DataFrame, method string) (*dataframe.DataFrame, e
 	// Compute correlations
 	for _, col1 := range numCols {
 		series1, _ := df.Column(col1)
-		vals1 := seriesToFloat64(series1)
 		
 		colData := make([]any, n)
 		for j, col2 := range numCols {
@@ -180,20 +180,9 @@ func CorrMatrix(df *dataframe.DataFrame, method string) (*dataframe.DataFrame, e
 			}
 			
 			series2, _ := df.Column(col2)
-			vals2 := seriesToFloat64(series2)
-			
-			var corr float64
-			var err error
-			
-			switch method {
-			case "pearson":
-				corr, err = Pearson(vals1, vals2)
-			case "spearman":
-				corr, err = Spearman(vals1, vals2)
-			case "kendall":
-				corr, err = Kendall(vals1, vals2)
-			default:
-				return nil, fmt.Errorf("unknown method: %s", method)
+			corr, err := seriesCorr(series1, series2, method)
+			if errors.Is(err, core.ErrInvalidArgument) {
+				return nil, err
 			}
 			
 			if err != nil {
@@ -209,6 +198,119 @@ func CorrMatrix(df *dataframe.DataFrame, method string) (*dataframe.DataFrame, e
 	return dataframe.New(corrData)
 }
 
+// CorrWithTarget ranks the numeric columns of df by their correlation with
+// the target column. The result has columns "feature" and "correlation",
+// sorted by absolute correlation, strongest first. Each correlation uses the
+// rows where both values are present. Columns whose correlation is undefined
+// (for example, constant columns) come last with a null correlation.
+// method is "pearson", "spearman", or "kendall".
+func CorrWithTarget(df *dataframe.DataFrame, target string, method string) (*dataframe.DataFrame, error) {
+	targetSeries, err := df.Column(target)
+	if err != nil {
+		return nil, err
+	}
+	if !core.IsNumeric(targetSeries.Dtype()) {
+		return nil, fmt.Errorf("target column %q has dtype %s: %w", target, targetSeries.Dtype(), core.ErrTypeMismatch)
+	}
+	
+	type ranked struct {
+		feature string
+		corr    float64
+		valid   bool
+	}
+	results := make([]ranked, 0)
+	
+	for _, col := range getNumericColumns(df) {
+		if col == target {
+			continue
+		}
+		s, _ := df.Column(col)
+		corr, err := seriesCorr(s, targetSeries, method)
+		if errors.Is(err, core.ErrInvalidArgument) {
+			return nil, err
+		}
+		results = append(results, ranked{feature: col, corr: corr, valid: err == nil && !math.IsNaN(corr)})
+	}
+	
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].valid != results[j].valid {
+			return results[i].valid
+		}
+		return math.Abs(results[i].corr) > math.Abs(results[j].corr)
+	})
+	
+	features := make([]string, len(results))
+	corrs := make([]any, len(results))
+	for i, r := range results {
+		features[i] = r.feature
+		if r.valid {
+			corrs[i] = r.corr
+		}
+	}
+	
+	corrSeries := seriesPkg.New("correlation", corrs, core.DtypeFloat64)
+	for i, r := range results {
+		if !r.valid {
+			corrSeries.SetNull(i)
+		}
+	}
+	
+	result, err := dataframe.New(map[string]any{"feature": features})
+	if err != nil {
+		return nil, err
+	}
+	return result.WithColumn("correlation", corrSeries), nil
+}
+
+// Corrwith correlates each numeric column of df with the same-named numeric
+// column of other, like pandas DataFrame.corrwith. Rows are paired by
+// position, so both frames must have the same number of rows; each
+// correlation uses the rows where both values are present. Columns missing
+// from either frame are skipped, and a correlation that is undefined (for
+// example, for a constant column) is NaN. method is "pearson", "spearman",
+// or "kendall".
+func Corrwith(df, other *dataframe.DataFrame, method string) (map[string]float64, error) {
+	if df.Nrows() != other.Nrows() {
+		return nil, fmt.Errorf("frames have %d and %d rows: %w", df.Nrows(), other.Nrows(), core.ErrInvalidShape)
+	}
+	
+	result := make(map[string]float64)
+	for _, col := range getNumericColumns(df) {
+		otherSeries, err := other.Column(col)
+		if err != nil || !core.IsNumeric(otherSeries.Dtype()) {
+			continue
+		}
+		s, _ := df.Column(col)
+		corr, err := seriesCorr(s, otherSeries, method)
+		if errors.Is(err, core.ErrInvalidArgument) {
+			return nil, err
+		}
+		if err != nil {
+			corr = math.NaN()
+		}
+		result[col] = corr
+	}
+	return result, nil
+}
+
+// seriesCorr computes the correlation of two series over the rows where both
+// values are present. Returns an error wrapping core.ErrInvalidArgument for
+// an unknown method.
+func seriesCorr(s1, s2 *seriesPkg.Series[any], method string) (float64, error) {
+	x, y := pairwiseComplete(s1, s2)
+	
+	switch method {
+	case "pearson":
+		return Pearson(x, y)
+	case "spearman":
+		return Spearman(x, y)
+	case "kendall":
+		return Kendall(x, y)
+	default:
+		return 0, fmt.Errorf("unknown method %q: %w", method, core.ErrInvalidArgument)
+	}
+}
+
 // CovMatrix computes the covariance matrix for all numeric columns.
 func CovMatrix(df *dataframe.DataFrame) (*dataframe.DataFrame, error) {
 	numCols := getNumericColumns(df)
@@ -240,30 +342,38 @@ func CovMatrix(df *dataframe.DataFrame) (*dataframe.DataFrame, error) {
 // Helper functions
 
 func getNumericColumns(df *dataframe.DataFrame) []string {
-	numCols := make([]string, 0)
+	return df.SelectDtypes(core.NumericDtypes(), nil).Columns()
+}
+
+// pairwiseComplete returns the values of s1 and s2 at the rows where both are
+// present and numeric, keeping the pairs aligned.
+func pairwiseComplete(s1, s2 *seriesPkg.Series[any]) ([]float64, []float64) {
+	n := s1.Len()
+	if s2.Len() < n {
+		n = s2.Len()
+	}
 	
-	for _, col := range df.Columns() {
-		series, err := df.Column(col)
-		if err != nil {
-			continue
-		}
-		
-		// Check if column is numeric
-		if isNumericSeriesStats(series) {
-			numCols = append(numCols, col)
+	x := make([]float64, 0, n)
+	y := make([]float64, 0, n)
+	for i := 0; i < n; i++ {
+		v1, ok1 := numericValueStats(s1.Get(i))
+		v2, ok2 := numericValueStats(s2.Get(i))
+		if ok1 && ok2 {
+			x = append(x, v1)
+			y = append(y, v2)
 		}
 	}
-	
-	return numCols
+	return x, y
 }
 
-func isNumericSeriesStats(s interface{ Len() int; Get(int) (any, bool); Dtype() core.Dtype }) bool {
-	dtype := s.Dtype()
-	switch dtype {
-	case core.DtypeFloat64, core.DtypeInt64:
-		return true
+// numericValueStats converts the result of a Series Get to float64.
+// It reports false for nulls, nil values, non-numeric values, and NaN.
+func numericValueStats(val any, ok bool) (float64, bool) {
+	if !ok {
+		return 0, false
 	}
-	return false
+	f, isNum := core.ToFloat64(val)
+	return f, isNum && !math.IsNaN(f)
 }
 
 func seriesToFloat64(s *seriesPkg.Series[any]) []float64 {
@@ -0,0 +1,154 @@
+package stats
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/TIVerse/GopherData/core"
+	"github.com/TIVerse/GopherData/dataframe"
+)
+
+func TestCorrWithTarget(t *testing.T) {
+	df, err := dataframe.New(map[string]any{
+		"target": []float64{1, 2, 3, 4, 5, 6},
+		"strong": []float64{2.1, 3.9, 6.2, 7.8, 10.1, 12.0},
+		"weak":   []float64{3, 1, 4, 1, 5, 9},
+		"neg":    []float64{6, 5, 4.5, 2, 2, 1},
+		"name":   []string{"a", "b", "c", "d", "e", "f"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := CorrWithTarget(df, "target", "pearson")
+	if err != nil {
+		t.Fatalf("CorrWithTarget() error = %v", err)
+	}
+
+	cols := result.Columns()
+	if len(cols) != 2 || cols[0] != "feature" || cols[1] != "correlation" {
+		t.Fatalf("columns = %v, want [feature correlation]", cols)
+	}
+
+	features, _ := result.Column("feature")
+	want := []string{"strong", "neg", "weak"}
+	if features.Len() != len(want) {
+		t.Fatalf("got %d features, want %d", features.Len(), len(want))
+	}
+	for i, name := range want {
+		if got, _ := features.Get(i); got != name {
+			t.Errorf("feature[%d] = %v, want %v", i, got, name)
+		}
+	}
+
+	corrs, _ := result.Column("correlation")
+	prev := math.Inf(1)
+	for i := 0; i < corrs.Len(); i++ {
+		val, _ := corrs.Get(i)
+		abs := math.Abs(val.(float64))
+		if abs > prev {
+			t.Errorf("correlation[%d] = %v, not sorted by absolute value", i, val)
+		}
+		prev = abs
+	}
+	if neg, _ := corrs.Get(1); neg.(float64) >= 0 {
+		t.Errorf("correlation of neg = %v, want negative", neg)
+	}
+}
+
+func TestCorrWithTargetPairwise(t *testing.T) {
+	df, err := dataframe.New(map[string]any{
+		"target":   []float64{1, 2, 3, 4, 5},
+		"withNull": []float64{10, 0, 30, 40, 50},
+		"constant": []float64{7, 7, 7, 7, 7},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := df.Column("withNull")
+	s.SetNull(1)
+
+	result, err := CorrWithTarget(df, "target", "pearson")
+	if err != nil {
+		t.Fatalf("CorrWithTarget() error = %v", err)
+	}
+
+	features, _ := result.Column("feature")
+	corrs, _ := result.Column("correlation")
+
+	// The null row is dropped from both columns, leaving a perfect fit
+	if name, _ := features.Get(0); name != "withNull" {
+		t.Errorf("feature[0] = %v, want withNull", name)
+	}
+	if val, ok := corrs.Get(0); !ok || math.Abs(val.(float64)-1) > 1e-9 {
+		t.Errorf("correlation of withNull = %v, want 1", val)
+	}
+
+	// A constant column has no defined correlation and comes last as null
+	if name, _ := features.Get(1); name != "constant" {
+		t.Errorf("feature[1] = %v, want constant", name)
+	}
+	if !corrs.IsNull(1) {
+		t.Error("correlation of constant column should be null")
+	}
+}
+
+func TestCorrWithTargetErrors(t *testing.T) {
+	df, _ := dataframe.New(map[string]any{
+		"x":    []float64{1, 2, 3},
+		"y":    []float64{2, 4, 7},
+		"name": []string{"a", "b", "c"},
+	})
+
+	if _, err := CorrWithTarget(df, "missing", "pearson"); !errors.Is(err, core.ErrColumnNotFound) {
+		t.Errorf("missing target error = %v, want ErrColumnNotFound", err)
+	}
+	if _, err := CorrWithTarget(df, "name", "pearson"); !errors.Is(err, core.ErrTypeMismatch) {
+		t.Errorf("string target error = %v, want ErrTypeMismatch", err)
+	}
+	if _, err := CorrWithTarget(df, "x", "cosine"); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("unknown method error = %v, want ErrInvalidArgument", err)
+	}
+}
+
+func TestCorrwith(t *testing.T) {
+	df, _ := dataframe.New(map[string]any{
+		"a":     []float64{1, 2, 3, 4, 5},
+		"b":     []float64{1, 2, 3, 4, 5},
+		"c":     []float64{1, 2, 3, 4, 5},
+		"const": []float64{1, 2, 3, 4, 5},
+		"only":  []float64{1, 2, 3, 4, 5},
+	})
+	other, _ := dataframe.New(map[string]any{
+		"a":     []float64{2, 4, 6, 8, 10},
+		"b":     []float64{5, 4, 3, 2, 1},
+		"c":     []float64{1, 3, 2, 5, 4},
+		"const": []float64{7, 7, 7, 7, 7},
+		"name":  []string{"v", "w", "x", "y", "z"},
+	})
+
+	got, err := Corrwith(df, other, "pearson")
+	if err != nil {
+		t.Fatalf("Corrwith() error = %v", err)
+	}
+	want := map[string]float64{"a": 1, "b": -1, "c": 0.8}
+	for col, w := range want {
+		if math.Abs(got[col]-w) > 1e-9 {
+			t.Errorf("corr[%s] = %v, want %v", col, got[col], w)
+		}
+	}
+	if !math.IsNaN(got["const"]) {
+		t.Errorf("corr[const] = %v, want NaN", got["const"])
+	}
+	if len(got) != 4 {
+		t.Errorf("got columns %v, want a, b, c and const only", got)
+	}
+
+	if _, err := Corrwith(df, other.SliceRows(0, 3), "pearson"); !errors.Is(err, core.ErrInvalidShape) {
+		t.Errorf("expected ErrInvalidShape for mismatched rows, got %v", err)
+	}
+	if _, err := Corrwith(df, other, "bogus"); !errors.Is(err, core.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for unknown method, got %v", err)
+	}
+}